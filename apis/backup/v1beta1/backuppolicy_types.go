@@ -0,0 +1,138 @@
+/*
+Copyright 2025 Ross Golder.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+)
+
+// BackupPolicyParameters are the configurable fields of a Hostinger VPS
+// backup policy.
+type BackupPolicyParameters struct {
+	// InstanceID is the ID of the VPS instance to back up.
+	// +crossplane:generate:reference:type=github.com/rossigee/provider-hostinger/apis/instance/v1beta1.Instance
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="instanceId is immutable"
+	InstanceID *string `json:"instanceId,omitempty"`
+
+	// InstanceIDRef references an Instance in the same namespace to retrieve
+	// its ID.
+	// +kubebuilder:validation:Optional
+	InstanceIDRef *xpv1.Reference `json:"instanceIdRef,omitempty"`
+
+	// InstanceIDSelector selects an Instance in the same namespace to
+	// retrieve its ID.
+	// +kubebuilder:validation:Optional
+	InstanceIDSelector *xpv1.Selector `json:"instanceIdSelector,omitempty"`
+
+	// Schedule is how often a backup is created. Backups are created once
+	// the newest backup of the instance is older than the schedule
+	// interval. With manual, no backups are created but old ones are still
+	// pruned.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Enum=manual;daily;weekly;monthly
+	Schedule BackupScheduleType `json:"schedule"`
+
+	// KeepLast is the number of most recent backups to keep.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Minimum=1
+	KeepLast *int32 `json:"keepLast,omitempty"`
+
+	// KeepDays is the number of days backups are kept for. The newest
+	// backup is always kept.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Minimum=1
+	KeepDays *int32 `json:"keepDays,omitempty"`
+}
+
+// BackupPolicyObservation are the observable fields of a Hostinger VPS
+// backup policy.
+type BackupPolicyObservation struct {
+	// CurrentSchedule is the schedule currently being enforced.
+	CurrentSchedule *BackupScheduleType `json:"currentSchedule,omitempty"`
+
+	// LastSuccessfulRun is when the last backup created by the policy was
+	// made. It is not advanced by backup actions that fail.
+	LastSuccessfulRun *metav1.Time `json:"lastSuccessfulRun,omitempty"`
+
+	// NextScheduledRun is when the next backup is due.
+	NextScheduledRun *metav1.Time `json:"nextScheduledRun,omitempty"`
+
+	// BackupCount is the number of backups the instance currently has.
+	BackupCount int32 `json:"backupCount,omitempty"`
+
+	// BackupLimit is the number of backups the plan of the instance allows,
+	// or zero if the plan does not advertise a limit.
+	BackupLimit int32 `json:"backupLimit,omitempty"`
+
+	// CreateActionID is the ID of the backup action currently in progress,
+	// if any.
+	CreateActionID *int64 `json:"createActionId,omitempty"`
+
+	// LastRunState is the final state of the last backup action.
+	LastRunState string `json:"lastRunState,omitempty"`
+
+	// CreatedBackupIDs are the IDs of the backups the policy created. Only
+	// these are pruned; other backups of the instance are left alone.
+	CreatedBackupIDs []string `json:"createdBackupIds,omitempty"`
+}
+
+// BackupPolicySpec defines the desired state of a Hostinger VPS backup
+// policy.
+type BackupPolicySpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       BackupPolicyParameters `json:"forProvider"`
+}
+
+// BackupPolicyStatus defines the observed state of a Hostinger VPS backup
+// policy.
+type BackupPolicyStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          BackupPolicyObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Namespaced,categories={crossplane,managed,hostinger}
+// +kubebuilder:printcolumn:name="READY",type=string,JSONPath=.status.conditions[?(@.type=='Ready')].status
+// +kubebuilder:printcolumn:name="SYNCED",type=string,JSONPath=.status.conditions[?(@.type=='Synced')].status
+// +kubebuilder:printcolumn:name="SCHEDULE",type=string,JSONPath=.spec.forProvider.schedule
+// +kubebuilder:printcolumn:name="NEXT-RUN",type=date,JSONPath=.status.atProvider.nextScheduledRun
+// +kubebuilder:printcolumn:name="AGE",type=date,JSONPath=.metadata.creationTimestamp
+// +genclient
+
+// BackupPolicy is the CRD type for scheduling and pruning the backups of a
+// Hostinger VPS instance. The external name is the instance ID; deleting a
+// BackupPolicy leaves existing backups in place.
+type BackupPolicy struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   BackupPolicySpec   `json:"spec,omitempty"`
+	Status BackupPolicyStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// BackupPolicyList contains a list of BackupPolicy resources.
+type BackupPolicyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []BackupPolicy `json:"items"`
+}
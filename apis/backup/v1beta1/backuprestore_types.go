@@ -0,0 +1,115 @@
+/*
+Copyright 2025 Ross Golder.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+)
+
+// BackupRestoreParameters are the configurable fields of a Hostinger VPS
+// backup restore.
+type BackupRestoreParameters struct {
+	// InstanceID is the ID of the VPS instance to restore.
+	// +crossplane:generate:reference:type=github.com/rossigee/provider-hostinger/apis/instance/v1beta1.Instance
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="instanceId is immutable"
+	InstanceID *string `json:"instanceId,omitempty"`
+
+	// InstanceIDRef references an Instance in the same namespace to retrieve
+	// its ID.
+	// +kubebuilder:validation:Optional
+	InstanceIDRef *xpv1.Reference `json:"instanceIdRef,omitempty"`
+
+	// InstanceIDSelector selects an Instance in the same namespace to
+	// retrieve its ID.
+	// +kubebuilder:validation:Optional
+	InstanceIDSelector *xpv1.Selector `json:"instanceIdSelector,omitempty"`
+
+	// BackupID is the ID of the backup to restore onto the instance.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="backupId is immutable"
+	BackupID string `json:"backupId"`
+
+	// ConfirmDataLoss acknowledges that restoring replaces all data currently
+	// on the instance disk. The restore is only started when this is true.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:XValidation:rule="self == true",message="restoring a backup destroys the current disk state; set confirmDataLoss to true"
+	ConfirmDataLoss bool `json:"confirmDataLoss"`
+}
+
+// BackupRestoreObservation are the observable fields of a Hostinger VPS
+// backup restore.
+type BackupRestoreObservation struct {
+	// ActionID is the ID of the restore action.
+	ActionID *int64 `json:"actionId,omitempty"`
+
+	// State is the state of the restore action (initiated, sent, delayed,
+	// success or error).
+	State string `json:"state,omitempty"`
+
+	// StartedAt is when the restore was started.
+	StartedAt *metav1.Time `json:"startedAt,omitempty"`
+
+	// CompletedAt is when the restore finished, successfully or not.
+	CompletedAt *metav1.Time `json:"completedAt,omitempty"`
+}
+
+// BackupRestoreSpec defines the desired state of a Hostinger VPS backup
+// restore.
+type BackupRestoreSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       BackupRestoreParameters `json:"forProvider"`
+}
+
+// BackupRestoreStatus defines the observed state of a Hostinger VPS backup
+// restore.
+type BackupRestoreStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          BackupRestoreObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Namespaced,categories={crossplane,managed,hostinger}
+// +kubebuilder:printcolumn:name="READY",type=string,JSONPath=.status.conditions[?(@.type=='Ready')].status
+// +kubebuilder:printcolumn:name="SYNCED",type=string,JSONPath=.status.conditions[?(@.type=='Synced')].status
+// +kubebuilder:printcolumn:name="STATE",type=string,JSONPath=.status.atProvider.state
+// +kubebuilder:printcolumn:name="AGE",type=date,JSONPath=.metadata.creationTimestamp
+// +genclient
+
+// BackupRestore is the CRD type for restoring a Hostinger VPS backup onto
+// its instance. Each BackupRestore runs the restore once; deleting it does
+// not undo the restore.
+type BackupRestore struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   BackupRestoreSpec   `json:"spec,omitempty"`
+	Status BackupRestoreStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// BackupRestoreList contains a list of BackupRestore resources.
+type BackupRestoreList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []BackupRestore `json:"items"`
+}
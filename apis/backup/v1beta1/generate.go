@@ -16,4 +16,5 @@ limitations under the License.
 
 // Package v1beta1 contains the Backup resource API types.
 // +kubebuilder:object:generate=true
+// +groupName=backup.m.hostinger.crossplane.io
 package v1beta1
@@ -1,21 +1,42 @@
-/*
-Copyright 2025 Ross Golder.
+package v1beta1
 
-Licensed under the Apache License, Version 2.0 (the "License");
-you may not use this file except in compliance with the License.
-You may obtain a copy of the License at
+import (
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
 
-    http://www.apache.org/licenses/LICENSE-2.0
+const (
+	// BackupKind is the kind of Backup resource.
+	BackupKind = "Backup"
 
-Unless required by applicable law or agreed to in writing, software
-distributed under the License is distributed on an "AS IS" BASIS,
-WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
-See the License for the specific language governing permissions and
-limitations under the License.
-*/
+	// BackupPolicyKind is the kind of BackupPolicy resource.
+	BackupPolicyKind = "BackupPolicy"
 
-package v1beta1
+	// BackupRestoreKind is the kind of BackupRestore resource.
+	BackupRestoreKind = "BackupRestore"
+)
+
+var (
+	// BackupGroupKind is the GroupKind for Backup resources.
+	BackupGroupKind = schema.GroupKind{Group: Group, Kind: BackupKind}.String()
+
+	// BackupGroupVersionKind is the GroupVersionKind for Backup resources.
+	BackupGroupVersionKind = SchemeGroupVersion.WithKind(BackupKind)
+
+	// BackupPolicyGroupKind is the GroupKind for BackupPolicy resources.
+	BackupPolicyGroupKind = schema.GroupKind{Group: Group, Kind: BackupPolicyKind}.String()
+
+	// BackupPolicyGroupVersionKind is the GroupVersionKind for BackupPolicy resources.
+	BackupPolicyGroupVersionKind = SchemeGroupVersion.WithKind(BackupPolicyKind)
+
+	// BackupRestoreGroupKind is the GroupKind for BackupRestore resources.
+	BackupRestoreGroupKind = schema.GroupKind{Group: Group, Kind: BackupRestoreKind}.String()
+
+	// BackupRestoreGroupVersionKind is the GroupVersionKind for BackupRestore resources.
+	BackupRestoreGroupVersionKind = SchemeGroupVersion.WithKind(BackupRestoreKind)
+)
 
 func init() {
 	SchemeBuilder.Register(&Backup{}, &BackupList{})
+	SchemeBuilder.Register(&BackupPolicy{}, &BackupPolicyList{})
+	SchemeBuilder.Register(&BackupRestore{}, &BackupRestoreList{})
 }
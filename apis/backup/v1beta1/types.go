@@ -62,6 +62,9 @@ type BackupObservation struct {
 	// Status is the current status of the backup (pending, completed, failed, etc.).
 	Status string `json:"status,omitempty"`
 
+	// Location is the data center the backup is stored in.
+	Location string `json:"location,omitempty"`
+
 	// CreatedDate is when the backup was created.
 	CreatedDate *metav1.Time `json:"createdDate,omitempty"`
 
@@ -71,7 +74,8 @@ type BackupObservation struct {
 	// ExpiryDate is when the backup will expire.
 	ExpiryDate *metav1.Time `json:"expiryDate,omitempty"`
 
-	// CurrentSchedule is the current backup schedule.
+	// CurrentSchedule is the schedule of the BackupPolicy that applies to
+	// the instance, if any.
 	CurrentSchedule *BackupScheduleType `json:"currentSchedule,omitempty"`
 }
 
@@ -95,7 +99,10 @@ type BackupStatus struct {
 // +kubebuilder:printcolumn:name="AGE",type=date,JSONPath=.metadata.creationTimestamp
 // +genclient
 
-// Backup is the CRD type for Hostinger VPS backups.
+// Backup is the CRD type for Hostinger VPS backups. With the Observe
+// management policy a Backup only observes the backup named by its external
+// name; with full management policies it creates a backup and deletes it with
+// the Backup. Use a BackupPolicy to create and prune backups on a schedule.
 type Backup struct {
 	metav1.TypeMeta   `json:",inline"`
 	metav1.ObjectMeta `json:"metadata,omitempty"`
@@ -28,3 +28,21 @@ func (l *BackupList) GetItems() []resource.Managed {
 	}
 	return items
 }
+
+// GetItems of this BackupPolicyList.
+func (l *BackupPolicyList) GetItems() []resource.Managed {
+	items := make([]resource.Managed, len(l.Items))
+	for i := range l.Items {
+		items[i] = &l.Items[i]
+	}
+	return items
+}
+
+// GetItems of this BackupRestoreList.
+func (l *BackupRestoreList) GetItems() []resource.Managed {
+	items := make([]resource.Managed, len(l.Items))
+	for i := range l.Items {
+		items[i] = &l.Items[i]
+	}
+	return items
+}
@@ -0,0 +1,139 @@
+/*
+Copyright 2025 Ross Golder.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+)
+
+// DNSZoneRecordFilter selects record sets of a DNS zone.
+type DNSZoneRecordFilter struct {
+	// Name is the record name relative to the zone, or "@" for the zone
+	// apex.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	Name string `json:"name"`
+
+	// Type is the record type. All types match when unset.
+	// +kubebuilder:validation:Optional
+	Type *string `json:"type,omitempty"`
+}
+
+// DNSZoneReset configures the reset of a zone to the Hostinger defaults.
+type DNSZoneReset struct {
+	// ResetEmailRecords also resets the email records, such as MX and SPF.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default=true
+	ResetEmailRecords *bool `json:"resetEmailRecords,omitempty"`
+
+	// KeepRecordTypes are record types that are kept as they are.
+	// +kubebuilder:validation:Optional
+	KeepRecordTypes []RecordType `json:"keepRecordTypes,omitempty"`
+}
+
+// DNSZoneParameters are the configurable fields of a Hostinger DNS zone.
+type DNSZoneParameters struct {
+	// Zone is the domain of the DNS zone.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="zone is immutable"
+	Zone string `json:"zone"`
+
+	// Authoritative makes the zone own its full record set: records not
+	// declared by a Record resource for the zone, and not excluded, are
+	// removed. Otherwise they are only counted. Records for the zone in any
+	// namespace count as declared, so a zone shared between namespaces never
+	// loses the values of another namespace's Records.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default=false
+	Authoritative *bool `json:"authoritative,omitempty"`
+
+	// Exclude selects record sets that are never removed. The NS and SOA
+	// records of the zone apex are always kept.
+	// +kubebuilder:validation:Optional
+	Exclude []DNSZoneRecordFilter `json:"exclude,omitempty"`
+
+	// ResetOnCreate resets the zone to the Hostinger defaults when the
+	// resource is created, before any records are removed.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="resetOnCreate is immutable"
+	ResetOnCreate *DNSZoneReset `json:"resetOnCreate,omitempty"`
+}
+
+// DNSZoneObservation are the observable fields of a Hostinger DNS zone.
+type DNSZoneObservation struct {
+	// ManagedRecords is the number of record values declared by Record
+	// resources.
+	ManagedRecords int32 `json:"managedRecords"`
+
+	// UnmanagedRecords is the number of record values that are neither
+	// declared nor excluded.
+	UnmanagedRecords int32 `json:"unmanagedRecords"`
+
+	// ExcludedRecords is the number of record values kept by the exclusion
+	// list.
+	ExcludedRecords int32 `json:"excludedRecords"`
+
+	// UnmanagedRecordSets are the record sets, as name/type, holding
+	// unmanaged values.
+	UnmanagedRecordSets []string `json:"unmanagedRecordSets,omitempty"`
+}
+
+// DNSZoneSpec defines the desired state of a Hostinger DNS zone.
+type DNSZoneSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       DNSZoneParameters `json:"forProvider"`
+}
+
+// DNSZoneStatus defines the observed state of a Hostinger DNS zone.
+type DNSZoneStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          DNSZoneObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Namespaced,categories={crossplane,managed,hostinger}
+// +kubebuilder:printcolumn:name="READY",type=string,JSONPath=.status.conditions[?(@.type=='Ready')].status
+// +kubebuilder:printcolumn:name="SYNCED",type=string,JSONPath=.status.conditions[?(@.type=='Synced')].status
+// +kubebuilder:printcolumn:name="ZONE",type=string,JSONPath=.spec.forProvider.zone
+// +kubebuilder:printcolumn:name="MANAGED",type=integer,JSONPath=.status.atProvider.managedRecords
+// +kubebuilder:printcolumn:name="UNMANAGED",type=integer,JSONPath=.status.atProvider.unmanagedRecords
+// +kubebuilder:printcolumn:name="AGE",type=date,JSONPath=.metadata.creationTimestamp
+// +genclient
+
+// DNSZone is the CRD type for a Hostinger DNS zone. The zone belongs to its
+// domain, so deleting the resource leaves the zone and its records in place.
+// The external name is the zone.
+type DNSZone struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   DNSZoneSpec   `json:"spec,omitempty"`
+	Status DNSZoneStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// DNSZoneList contains a list of DNSZone resources.
+type DNSZoneList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []DNSZone `json:"items"`
+}
@@ -0,0 +1,127 @@
+/*
+Copyright 2025 Ross Golder.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+)
+
+// DNSZoneSnapshotParameters are the configurable fields of the snapshots of
+// a Hostinger DNS zone.
+// +kubebuilder:validation:XValidation:rule="!has(self.restoreGeneration) || has(self.restoreSnapshotId)",message="restoreSnapshotId is required to restore the zone"
+type DNSZoneSnapshotParameters struct {
+	// Zone is the domain whose DNS zone snapshots are listed.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="zone is immutable"
+	Zone string `json:"zone"`
+
+	// RestoreSnapshotID is the snapshot the zone is restored to when
+	// RestoreGeneration is increased.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:MinLength=1
+	RestoreSnapshotID *string `json:"restoreSnapshotId,omitempty"`
+
+	// RestoreGeneration restores the zone to RestoreSnapshotID whenever it
+	// is increased above status.atProvider.restoredGeneration. Restoring
+	// replaces all records of the zone.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Minimum=0
+	RestoreGeneration *int64 `json:"restoreGeneration,omitempty"`
+}
+
+// DNSZoneSnapshotInfo describes a snapshot of a DNS zone.
+type DNSZoneSnapshotInfo struct {
+	// ID is the snapshot ID.
+	ID string `json:"id"`
+
+	// Reason is why Hostinger took the snapshot.
+	Reason string `json:"reason,omitempty"`
+
+	// CreatedAt is when the snapshot was taken.
+	CreatedAt *metav1.Time `json:"createdAt,omitempty"`
+}
+
+// DNSZoneSnapshotObservation are the observable fields of the snapshots of a
+// Hostinger DNS zone.
+type DNSZoneSnapshotObservation struct {
+	// Snapshots are the available snapshots of the zone, newest first.
+	Snapshots []DNSZoneSnapshotInfo `json:"snapshots,omitempty"`
+
+	// LatestSnapshotID is the ID of the newest snapshot.
+	LatestSnapshotID string `json:"latestSnapshotId,omitempty"`
+
+	// RestoredSnapshotID is the ID of the last snapshot the zone was
+	// restored to.
+	RestoredSnapshotID string `json:"restoredSnapshotId,omitempty"`
+
+	// RestoredAt is when the zone was last restored.
+	RestoredAt *metav1.Time `json:"restoredAt,omitempty"`
+
+	// RestoredGeneration is the last restoreGeneration a restore was made
+	// for. It is set to the current restoreGeneration when the zone is
+	// first observed, so that creating or re-applying the resource never
+	// restores the zone.
+	RestoredGeneration *int64 `json:"restoredGeneration,omitempty"`
+}
+
+// DNSZoneSnapshotSpec defines the desired state of the snapshots of a DNS
+// zone.
+type DNSZoneSnapshotSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       DNSZoneSnapshotParameters `json:"forProvider"`
+}
+
+// DNSZoneSnapshotStatus defines the observed state of the snapshots of a DNS
+// zone.
+type DNSZoneSnapshotStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          DNSZoneSnapshotObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Namespaced,categories={crossplane,managed,hostinger}
+// +kubebuilder:printcolumn:name="READY",type=string,JSONPath=.status.conditions[?(@.type=='Ready')].status
+// +kubebuilder:printcolumn:name="SYNCED",type=string,JSONPath=.status.conditions[?(@.type=='Synced')].status
+// +kubebuilder:printcolumn:name="ZONE",type=string,JSONPath=.spec.forProvider.zone
+// +kubebuilder:printcolumn:name="LATEST",type=string,JSONPath=.status.atProvider.latestSnapshotId
+// +kubebuilder:printcolumn:name="RESTORED",type=string,JSONPath=.status.atProvider.restoredSnapshotId
+// +kubebuilder:printcolumn:name="AGE",type=date,JSONPath=.metadata.creationTimestamp
+// +genclient
+
+// DNSZoneSnapshot is the CRD type for the snapshots Hostinger keeps of a DNS
+// zone. Hostinger takes the snapshots itself, so the resource lists them
+// and restores the zone to one on request. The external name is the zone.
+type DNSZoneSnapshot struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   DNSZoneSnapshotSpec   `json:"spec,omitempty"`
+	Status DNSZoneSnapshotStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// DNSZoneSnapshotList contains a list of DNSZoneSnapshot resources.
+type DNSZoneSnapshotList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []DNSZoneSnapshot `json:"items"`
+}
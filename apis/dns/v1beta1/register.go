@@ -0,0 +1,58 @@
+/*
+Copyright 2025 Ross Golder.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+const (
+	// RecordKind is the kind of Record resource.
+	RecordKind = "Record"
+
+	// DNSZoneKind is the kind of DNSZone resource.
+	DNSZoneKind = "DNSZone"
+
+	// DNSZoneSnapshotKind is the kind of DNSZoneSnapshot resource.
+	DNSZoneSnapshotKind = "DNSZoneSnapshot"
+)
+
+var (
+	// RecordGroupKind is the GroupKind for Record resources.
+	RecordGroupKind = schema.GroupKind{Group: Group, Kind: RecordKind}.String()
+
+	// RecordGroupVersionKind is the GroupVersionKind for Record resources.
+	RecordGroupVersionKind = SchemeGroupVersion.WithKind(RecordKind)
+
+	// DNSZoneGroupKind is the GroupKind for DNSZone resources.
+	DNSZoneGroupKind = schema.GroupKind{Group: Group, Kind: DNSZoneKind}.String()
+
+	// DNSZoneGroupVersionKind is the GroupVersionKind for DNSZone resources.
+	DNSZoneGroupVersionKind = SchemeGroupVersion.WithKind(DNSZoneKind)
+
+	// DNSZoneSnapshotGroupKind is the GroupKind for DNSZoneSnapshot resources.
+	DNSZoneSnapshotGroupKind = schema.GroupKind{Group: Group, Kind: DNSZoneSnapshotKind}.String()
+
+	// DNSZoneSnapshotGroupVersionKind is the GroupVersionKind for DNSZoneSnapshot resources.
+	DNSZoneSnapshotGroupVersionKind = SchemeGroupVersion.WithKind(DNSZoneSnapshotKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&Record{}, &RecordList{})
+	SchemeBuilder.Register(&DNSZone{}, &DNSZoneList{})
+	SchemeBuilder.Register(&DNSZoneSnapshot{}, &DNSZoneSnapshotList{})
+}
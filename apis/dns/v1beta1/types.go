@@ -0,0 +1,147 @@
+/*
+Copyright 2025 Ross Golder.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+)
+
+// RecordType is the type of a DNS record
+// +kubebuilder:validation:Enum=A;AAAA;CNAME;MX;TXT;SRV;CAA;NS
+type RecordType string
+
+const (
+	// RecordTypeA is an IPv4 address record
+	RecordTypeA RecordType = "A"
+	// RecordTypeAAAA is an IPv6 address record
+	RecordTypeAAAA RecordType = "AAAA"
+	// RecordTypeCNAME is a canonical name record
+	RecordTypeCNAME RecordType = "CNAME"
+	// RecordTypeMX is a mail exchange record
+	RecordTypeMX RecordType = "MX"
+	// RecordTypeTXT is a text record
+	RecordTypeTXT RecordType = "TXT"
+	// RecordTypeSRV is a service locator record
+	RecordTypeSRV RecordType = "SRV"
+	// RecordTypeCAA is a certification authority authorization record
+	RecordTypeCAA RecordType = "CAA"
+	// RecordTypeNS is a name server record
+	RecordTypeNS RecordType = "NS"
+)
+
+// RecordParameters are the configurable fields of a DNS record.
+// +kubebuilder:validation:XValidation:rule="has(self.values) != has(self.instanceRef)",message="exactly one of values or instanceRef must be set"
+// +kubebuilder:validation:XValidation:rule="!has(self.instanceRef) || self.type == 'A' || self.type == 'AAAA'",message="instanceRef can only be used for A and AAAA records"
+// +kubebuilder:validation:XValidation:rule="self.type != 'CNAME' || !has(self.values) || size(self.values) == 1",message="a CNAME record must have exactly one value"
+type RecordParameters struct {
+	// Zone is the domain whose DNS zone holds the record.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="zone is immutable"
+	Zone string `json:"zone"`
+
+	// Name is the record name relative to the zone, or "@" for the zone apex.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="name is immutable"
+	Name string `json:"name"`
+
+	// Type is the record type.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="type is immutable"
+	Type RecordType `json:"type"`
+
+	// TTL is the record TTL in seconds. When unset, the TTL of an existing
+	// record set is kept, and new record sets get 14400.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Minimum=60
+	// +kubebuilder:validation:Maximum=2592000
+	TTL *int32 `json:"ttl,omitempty"`
+
+	// Values are the record contents, such as addresses for A records or
+	// "priority host" for MX records. Other values at the same name and
+	// type that this resource does not manage are left in place.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=100
+	Values []string `json:"values,omitempty"`
+
+	// InstanceRef references an Instance whose address is the record value:
+	// its IPv4 address for A records and its IPv6 address for AAAA records.
+	// The address is re-read on every reconcile.
+	// +kubebuilder:validation:Optional
+	InstanceRef *xpv1.NamespacedReference `json:"instanceRef,omitempty"`
+}
+
+// RecordObservation are the observable fields of a DNS record.
+type RecordObservation struct {
+	// FQDN is the fully qualified name of the record.
+	FQDN string `json:"fqdn,omitempty"`
+
+	// TTL is the TTL of the record set.
+	TTL int32 `json:"ttl,omitempty"`
+
+	// Values are all values of the record set, including those not managed
+	// by this resource.
+	Values []string `json:"values,omitempty"`
+
+	// ManagedValues are the values owned by this resource. They are removed
+	// from the record set when they leave spec or the resource is deleted.
+	ManagedValues []string `json:"managedValues,omitempty"`
+}
+
+// RecordSpec defines the desired state of a DNS record.
+type RecordSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       RecordParameters `json:"forProvider"`
+}
+
+// RecordStatus defines the observed state of a DNS record.
+type RecordStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          RecordObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Namespaced,categories={crossplane,managed,hostinger}
+// +kubebuilder:printcolumn:name="READY",type=string,JSONPath=.status.conditions[?(@.type=='Ready')].status
+// +kubebuilder:printcolumn:name="SYNCED",type=string,JSONPath=.status.conditions[?(@.type=='Synced')].status
+// +kubebuilder:printcolumn:name="TYPE",type=string,JSONPath=.spec.forProvider.type
+// +kubebuilder:printcolumn:name="FQDN",type=string,JSONPath=.status.atProvider.fqdn
+// +kubebuilder:printcolumn:name="AGE",type=date,JSONPath=.metadata.creationTimestamp
+// +genclient
+
+// Record is the CRD type for a Hostinger DNS record set.
+type Record struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   RecordSpec   `json:"spec,omitempty"`
+	Status RecordStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// RecordList contains a list of Record resources.
+type RecordList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Record `json:"items"`
+}
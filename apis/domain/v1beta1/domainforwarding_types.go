@@ -0,0 +1,111 @@
+/*
+Copyright 2025 Ross Golder.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+)
+
+// RedirectType is the HTTP status code of a domain forwarding redirect
+// +kubebuilder:validation:Enum="301";"302"
+type RedirectType string
+
+const (
+	// RedirectTypePermanent is a 301 Moved Permanently redirect
+	RedirectTypePermanent RedirectType = "301"
+	// RedirectTypeTemporary is a 302 Found redirect
+	RedirectTypeTemporary RedirectType = "302"
+)
+
+// DomainForwardingParameters are the configurable fields of the HTTP
+// forwarding of a Hostinger domain.
+type DomainForwardingParameters struct {
+	// Domain is the domain in the Hostinger portfolio to forward.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="domain is immutable"
+	Domain string `json:"domain"`
+
+	// RedirectType is 301 for a permanent or 302 for a temporary redirect.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default="301"
+	RedirectType RedirectType `json:"redirectType,omitempty"`
+
+	// RedirectURL is the absolute http or https URL to redirect to.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:XValidation:rule="isURL(self) && url(self).getScheme() in ['http', 'https'] && url(self).getHostname() != ''",message="redirectUrl must be an absolute http or https URL"
+	RedirectURL string `json:"redirectUrl"`
+}
+
+// DomainForwardingObservation are the observable fields of the HTTP
+// forwarding of a Hostinger domain.
+type DomainForwardingObservation struct {
+	// RedirectType is the live redirect type.
+	RedirectType RedirectType `json:"redirectType,omitempty"`
+
+	// RedirectURL is the live redirect target.
+	RedirectURL string `json:"redirectUrl,omitempty"`
+
+	// CreatedAt is when the forwarding was set up.
+	CreatedAt *metav1.Time `json:"createdAt,omitempty"`
+}
+
+// DomainForwardingSpec defines the desired state of the forwarding of a
+// domain.
+type DomainForwardingSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       DomainForwardingParameters `json:"forProvider"`
+}
+
+// DomainForwardingStatus defines the observed state of the forwarding of a
+// domain.
+type DomainForwardingStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          DomainForwardingObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Namespaced,categories={crossplane,managed,hostinger}
+// +kubebuilder:printcolumn:name="READY",type=string,JSONPath=.status.conditions[?(@.type=='Ready')].status
+// +kubebuilder:printcolumn:name="SYNCED",type=string,JSONPath=.status.conditions[?(@.type=='Synced')].status
+// +kubebuilder:printcolumn:name="DOMAIN",type=string,JSONPath=.spec.forProvider.domain
+// +kubebuilder:printcolumn:name="TYPE",type=string,JSONPath=.spec.forProvider.redirectType
+// +kubebuilder:printcolumn:name="URL",type=string,JSONPath=.spec.forProvider.redirectUrl
+// +kubebuilder:printcolumn:name="AGE",type=date,JSONPath=.metadata.creationTimestamp
+// +genclient
+
+// DomainForwarding is the CRD type for the HTTP forwarding of a domain in the
+// Hostinger portfolio. Deleting it removes the forwarding.
+type DomainForwarding struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   DomainForwardingSpec   `json:"spec,omitempty"`
+	Status DomainForwardingStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// DomainForwardingList contains a list of DomainForwarding resources.
+type DomainForwardingList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []DomainForwarding `json:"items"`
+}
@@ -0,0 +1,153 @@
+/*
+Copyright 2025 Ross Golder.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+)
+
+// DomainRegistrationParameters are the configurable fields of a Hostinger
+// domain registration.
+// +kubebuilder:validation:XValidation:rule="!has(self.purchase) || !self.purchase || ((has(self.whoisProfileId) || has(self.whoisProfileRef) || has(self.whoisProfileSelector)) && has(self.paymentMethodId))",message="purchase requires a WHOIS profile and paymentMethodId"
+type DomainRegistrationParameters struct {
+	// Domain is the domain name to register, including its TLD.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Pattern=`^[A-Za-z0-9]([A-Za-z0-9-]*[A-Za-z0-9])?(\.[A-Za-z0-9]([A-Za-z0-9-]*[A-Za-z0-9])?)+$`
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="domain is immutable"
+	Domain string `json:"domain"`
+
+	// Period is the registration period in years.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=10
+	// +kubebuilder:default=1
+	Period *int32 `json:"period,omitempty"`
+
+	// Purchase places the order for the domain. When false, availability
+	// and price are only reported, as a dry run.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default=false
+	Purchase *bool `json:"purchase,omitempty"`
+
+	// WHOISProfileID is the WHOIS profile used as the owner, admin, billing
+	// and tech contact of the domain. This, WHOISProfileRef or
+	// WHOISProfileSelector is required to purchase.
+	// +crossplane:generate:reference:type=WHOISProfile
+	// +crossplane:generate:reference:refFieldName=WHOISProfileRef
+	// +crossplane:generate:reference:selectorFieldName=WHOISProfileSelector
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Pattern=`^[0-9]+$`
+	WHOISProfileID *string `json:"whoisProfileId,omitempty"`
+
+	// WHOISProfileRef references a WHOISProfile in the same namespace to
+	// retrieve its ID.
+	// +kubebuilder:validation:Optional
+	WHOISProfileRef *xpv1.Reference `json:"whoisProfileRef,omitempty"`
+
+	// WHOISProfileSelector selects a WHOISProfile in the same namespace to
+	// retrieve its ID.
+	// +kubebuilder:validation:Optional
+	WHOISProfileSelector *xpv1.Selector `json:"whoisProfileSelector,omitempty"`
+
+	// PaymentMethodID is the payment method the order is charged to.
+	// Required to purchase.
+	// +kubebuilder:validation:Optional
+	PaymentMethodID *int64 `json:"paymentMethodId,omitempty"`
+}
+
+// DomainRegistrationObservation are the observable fields of a Hostinger
+// domain registration.
+type DomainRegistrationObservation struct {
+	// Available is whether the domain can be registered. It is unset once
+	// the order is placed.
+	Available *bool `json:"available,omitempty"`
+
+	// Restriction explains why the domain cannot be registered, if
+	// Hostinger gives a reason.
+	Restriction string `json:"restriction,omitempty"`
+
+	// PriceItemID is the billing catalog price the domain is ordered with.
+	PriceItemID string `json:"priceItemId,omitempty"`
+
+	// Currency is the currency of the prices.
+	Currency string `json:"currency,omitempty"`
+
+	// FirstPeriodPrice is the price of the first period, in cents.
+	FirstPeriodPrice int64 `json:"firstPeriodPrice,omitempty"`
+
+	// RenewalPrice is the price of each renewal, in cents.
+	RenewalPrice int64 `json:"renewalPrice,omitempty"`
+
+	// OrderID is the ID of the order placed for the domain, which is also
+	// the external name.
+	OrderID string `json:"orderId,omitempty"`
+
+	// DomainStatus is the status of the domain in the portfolio, such as
+	// pending or active.
+	DomainStatus string `json:"domainStatus,omitempty"`
+
+	// ExpiresAt is when the registration expires.
+	ExpiresAt *metav1.Time `json:"expiresAt,omitempty"`
+}
+
+// DomainRegistrationSpec defines the desired state of a domain
+// registration.
+type DomainRegistrationSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       DomainRegistrationParameters `json:"forProvider"`
+}
+
+// DomainRegistrationStatus defines the observed state of a domain
+// registration.
+type DomainRegistrationStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          DomainRegistrationObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Namespaced,categories={crossplane,managed,hostinger}
+// +kubebuilder:printcolumn:name="READY",type=string,JSONPath=.status.conditions[?(@.type=='Ready')].status
+// +kubebuilder:printcolumn:name="SYNCED",type=string,JSONPath=.status.conditions[?(@.type=='Synced')].status
+// +kubebuilder:printcolumn:name="DOMAIN",type=string,JSONPath=.spec.forProvider.domain
+// +kubebuilder:printcolumn:name="AVAILABLE",type=boolean,JSONPath=.status.atProvider.available
+// +kubebuilder:printcolumn:name="ORDER",type=string,JSONPath=.status.atProvider.orderId
+// +kubebuilder:printcolumn:name="STATUS",type=string,JSONPath=.status.atProvider.domainStatus
+// +kubebuilder:printcolumn:name="AGE",type=date,JSONPath=.metadata.creationTimestamp
+// +genclient
+
+// DomainRegistration is the CRD type for registering a domain with Hostinger.
+// It reports availability and price, and orders the domain once purchase is
+// set. Deleting it leaves the domain registered.
+type DomainRegistration struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   DomainRegistrationSpec   `json:"spec,omitempty"`
+	Status DomainRegistrationStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// DomainRegistrationList contains a list of DomainRegistration resources.
+type DomainRegistrationList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []DomainRegistration `json:"items"`
+}
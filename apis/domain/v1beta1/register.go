@@ -0,0 +1,68 @@
+/*
+Copyright 2025 Ross Golder.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+const (
+	// DomainKind is the kind of Domain resource.
+	DomainKind = "Domain"
+
+	// DomainForwardingKind is the kind of DomainForwarding resource.
+	DomainForwardingKind = "DomainForwarding"
+
+	// DomainRegistrationKind is the kind of DomainRegistration resource.
+	DomainRegistrationKind = "DomainRegistration"
+
+	// WHOISProfileKind is the kind of WHOISProfile resource.
+	WHOISProfileKind = "WHOISProfile"
+)
+
+var (
+	// DomainGroupKind is the GroupKind for Domain resources.
+	DomainGroupKind = schema.GroupKind{Group: Group, Kind: DomainKind}.String()
+
+	// DomainGroupVersionKind is the GroupVersionKind for Domain resources.
+	DomainGroupVersionKind = SchemeGroupVersion.WithKind(DomainKind)
+
+	// DomainForwardingGroupKind is the GroupKind for DomainForwarding resources.
+	DomainForwardingGroupKind = schema.GroupKind{Group: Group, Kind: DomainForwardingKind}.String()
+
+	// DomainForwardingGroupVersionKind is the GroupVersionKind for DomainForwarding resources.
+	DomainForwardingGroupVersionKind = SchemeGroupVersion.WithKind(DomainForwardingKind)
+
+	// DomainRegistrationGroupKind is the GroupKind for DomainRegistration resources.
+	DomainRegistrationGroupKind = schema.GroupKind{Group: Group, Kind: DomainRegistrationKind}.String()
+
+	// DomainRegistrationGroupVersionKind is the GroupVersionKind for DomainRegistration resources.
+	DomainRegistrationGroupVersionKind = SchemeGroupVersion.WithKind(DomainRegistrationKind)
+
+	// WHOISProfileGroupKind is the GroupKind for WHOISProfile resources.
+	WHOISProfileGroupKind = schema.GroupKind{Group: Group, Kind: WHOISProfileKind}.String()
+
+	// WHOISProfileGroupVersionKind is the GroupVersionKind for WHOISProfile resources.
+	WHOISProfileGroupVersionKind = SchemeGroupVersion.WithKind(WHOISProfileKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&Domain{}, &DomainList{})
+	SchemeBuilder.Register(&DomainForwarding{}, &DomainForwardingList{})
+	SchemeBuilder.Register(&DomainRegistration{}, &DomainRegistrationList{})
+	SchemeBuilder.Register(&WHOISProfile{}, &WHOISProfileList{})
+}
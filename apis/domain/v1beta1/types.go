@@ -0,0 +1,143 @@
+/*
+Copyright 2025 Ross Golder.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+)
+
+// DomainParameters are the configurable fields of a Hostinger domain.
+type DomainParameters struct {
+	// Domain is the domain name in the Hostinger portfolio.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="domain is immutable"
+	Domain string `json:"domain"`
+
+	// ExpiryWarningDays is how many days before expiry DomainExpiring
+	// warning events are emitted.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:default=30
+	ExpiryWarningDays *int32 `json:"expiryWarningDays,omitempty"`
+
+	// Nameservers delegates the domain to these nameservers, set as ns1 to
+	// ns4 in order. The nameservers are left as they are when unset.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:MinItems=2
+	// +kubebuilder:validation:MaxItems=4
+	// +kubebuilder:validation:items:Pattern=`^[A-Za-z0-9]([A-Za-z0-9-]*[A-Za-z0-9])?(\.[A-Za-z0-9]([A-Za-z0-9-]*[A-Za-z0-9])?)+\.?$`
+	Nameservers []string `json:"nameservers,omitempty"`
+
+	// Locked enables or disables the registrar lock, which protects the
+	// domain from transfers. The lock is left as it is when unset.
+	// +kubebuilder:validation:Optional
+	Locked *bool `json:"locked,omitempty"`
+
+	// PrivacyProtection enables or disables WHOIS privacy protection. It is
+	// left as it is when unset.
+	// +kubebuilder:validation:Optional
+	PrivacyProtection *bool `json:"privacyProtection,omitempty"`
+}
+
+// DomainObservation are the observable fields of a Hostinger domain.
+type DomainObservation struct {
+	// Status is the registration status, such as active or expired.
+	Status string `json:"status,omitempty"`
+
+	// Message explains the status, if Hostinger gives a reason.
+	Message string `json:"message,omitempty"`
+
+	// CreatedAt is when the domain was registered.
+	CreatedAt *metav1.Time `json:"createdAt,omitempty"`
+
+	// ExpiresAt is when the registration expires.
+	ExpiresAt *metav1.Time `json:"expiresAt,omitempty"`
+
+	// AutoRenew is whether the subscription of the domain renews
+	// automatically. It is unset when the subscription is not found.
+	AutoRenew *bool `json:"autoRenew,omitempty"`
+
+	// Locked is whether the registrar lock protects the domain from
+	// transfers.
+	Locked bool `json:"locked,omitempty"`
+
+	// Lockable is whether the registry supports the registrar lock.
+	Lockable bool `json:"lockable,omitempty"`
+
+	// PrivacyProtected is whether WHOIS privacy protection is enabled.
+	PrivacyProtected bool `json:"privacyProtected,omitempty"`
+
+	// PrivacyProtectable is whether the registry supports WHOIS privacy
+	// protection.
+	PrivacyProtectable bool `json:"privacyProtectable,omitempty"`
+
+	// Nameservers are the nameservers the domain is delegated to.
+	Nameservers []string `json:"nameservers,omitempty"`
+
+	// ExpiryWarningBucket is the number of days left, rounded up to 1, 3, 7,
+	// 14 or expiryWarningDays, when the last DomainExpiring warning was
+	// emitted; 0 once the domain has expired. It is unset outside the warning
+	// window.
+	ExpiryWarningBucket *int32 `json:"expiryWarningBucket,omitempty"`
+}
+
+// DomainSpec defines the desired state of a Hostinger domain.
+type DomainSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       DomainParameters `json:"forProvider"`
+}
+
+// DomainStatus defines the observed state of a Hostinger domain.
+type DomainStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          DomainObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Namespaced,categories={crossplane,managed,hostinger}
+// +kubebuilder:printcolumn:name="READY",type=string,JSONPath=.status.conditions[?(@.type=='Ready')].status
+// +kubebuilder:printcolumn:name="SYNCED",type=string,JSONPath=.status.conditions[?(@.type=='Synced')].status
+// +kubebuilder:printcolumn:name="DOMAIN",type=string,JSONPath=.spec.forProvider.domain
+// +kubebuilder:printcolumn:name="STATUS",type=string,JSONPath=.status.atProvider.status
+// +kubebuilder:printcolumn:name="EXPIRES",type=date,JSONPath=.status.atProvider.expiresAt
+// +kubebuilder:printcolumn:name="AGE",type=date,JSONPath=.metadata.creationTimestamp
+// +genclient
+
+// Domain is the CRD type for a domain in the Hostinger portfolio. Domains are
+// observed rather than created: the provider never registers or deletes
+// domains through this type. With the Update management policy it enforces
+// the nameservers, lock and privacy protection set in spec.
+type Domain struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   DomainSpec   `json:"spec,omitempty"`
+	Status DomainStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// DomainList contains a list of Domain resources.
+type DomainList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Domain `json:"items"`
+}
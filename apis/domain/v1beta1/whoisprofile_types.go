@@ -0,0 +1,131 @@
+/*
+Copyright 2025 Ross Golder.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+)
+
+// EntityType is the kind of legal entity a WHOIS contact is
+// +kubebuilder:validation:Enum=individual;organization
+type EntityType string
+
+const (
+	// EntityTypeIndividual is a natural person
+	EntityTypeIndividual EntityType = "individual"
+	// EntityTypeOrganization is a company or other organisation
+	EntityTypeOrganization EntityType = "organization"
+)
+
+// WHOISProfileParameters are the configurable fields of a Hostinger WHOIS
+// profile. Personal data is read from Secrets rather than stored in spec.
+type WHOISProfileParameters struct {
+	// TLD is the top-level domain the profile is used for, such as com.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Pattern=`^[a-z0-9]([a-z0-9-]*[a-z0-9])?(\.[a-z0-9]([a-z0-9-]*[a-z0-9])?)*$`
+	TLD string `json:"tld"`
+
+	// Country is the ISO 3166-1 alpha-2 code of the contact's country.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Pattern=`^[A-Z]{2}$`
+	Country string `json:"country"`
+
+	// EntityType is whether the contact is an individual or an
+	// organization.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default=individual
+	EntityType EntityType `json:"entityType,omitempty"`
+
+	// ContactSecretRef is a reference to a secret holding the contact
+	// details: firstName, lastName, organization, address1, address2, city,
+	// state, zip, phoneCountryCode, phone and email.
+	// +kubebuilder:validation:Required
+	ContactSecretRef xpv1.SecretReference `json:"contactSecretRef"`
+
+	// TLDDetailsSecretRef is a reference to a secret holding the extra
+	// details some registries require, such as a national ID number. Each
+	// key is sent as is.
+	// +kubebuilder:validation:Optional
+	TLDDetailsSecretRef *xpv1.SecretReference `json:"tldDetailsSecretRef,omitempty"`
+}
+
+// WHOISProfileObservation are the observable fields of a Hostinger WHOIS
+// profile.
+type WHOISProfileObservation struct {
+	// ID is the ID of the WHOIS profile.
+	ID string `json:"id,omitempty"`
+
+	// CreatedAt is when the profile was created.
+	CreatedAt *metav1.Time `json:"createdAt,omitempty"`
+
+	// Domains are the domains that use the profile as a contact.
+	Domains []string `json:"domains,omitempty"`
+
+	// ReplacementID is the ID of a profile created to replace a profile
+	// with changed contact details, until the external name has been
+	// switched to it.
+	ReplacementID string `json:"replacementId,omitempty"`
+
+	// ReplacedIDs are the IDs of replaced profiles that have not been
+	// deleted yet. Domains keep the contact they were registered with, so a
+	// replaced profile is kept until no domain uses it.
+	ReplacedIDs []string `json:"replacedIds,omitempty"`
+}
+
+// WHOISProfileSpec defines the desired state of a WHOIS profile.
+type WHOISProfileSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       WHOISProfileParameters `json:"forProvider"`
+}
+
+// WHOISProfileStatus defines the observed state of a WHOIS profile.
+type WHOISProfileStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          WHOISProfileObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Namespaced,categories={crossplane,managed,hostinger}
+// +kubebuilder:printcolumn:name="READY",type=string,JSONPath=.status.conditions[?(@.type=='Ready')].status
+// +kubebuilder:printcolumn:name="SYNCED",type=string,JSONPath=.status.conditions[?(@.type=='Synced')].status
+// +kubebuilder:printcolumn:name="TLD",type=string,JSONPath=.spec.forProvider.tld
+// +kubebuilder:printcolumn:name="ID",type=string,JSONPath=.status.atProvider.id
+// +kubebuilder:printcolumn:name="AGE",type=date,JSONPath=.metadata.creationTimestamp
+// +genclient
+
+// WHOISProfile is the CRD type for a Hostinger WHOIS contact profile, used
+// to register and transfer domains. Hostinger profiles cannot be changed, so
+// a changed profile is replaced by a new one.
+type WHOISProfile struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   WHOISProfileSpec   `json:"spec,omitempty"`
+	Status WHOISProfileStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// WHOISProfileList contains a list of WHOISProfile resources.
+type WHOISProfileList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []WHOISProfile `json:"items"`
+}
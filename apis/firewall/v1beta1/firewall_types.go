@@ -0,0 +1,148 @@
+/*
+Copyright 2025 Ross Golder.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+)
+
+// Firewall attachment states reported per instance
+const (
+	// FirewallAttachmentSynced means the firewall is active on the instance
+	// and its current rules have been applied
+	FirewallAttachmentSynced = "Synced"
+	// FirewallAttachmentPending means the firewall is active on the instance
+	// but its current rules have not been applied yet
+	FirewallAttachmentPending = "Pending"
+	// FirewallAttachmentInactive means the firewall is not active on the
+	// instance
+	FirewallAttachmentInactive = "Inactive"
+	// FirewallAttachmentFailed means activating the firewall on the instance
+	// failed
+	FirewallAttachmentFailed = "Failed"
+)
+
+// FirewallParameters are the configurable fields of a shared Hostinger
+// firewall.
+type FirewallParameters struct {
+	// Name is the name of the firewall. Defaults to the name of the resource.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:MaxLength=255
+	Name *string `json:"name,omitempty"`
+
+	// Rules is the list of firewall rules.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:MaxItems=100
+	Rules []FirewallRuleSpec `json:"rules,omitempty"`
+
+	// InstanceIDs are the IDs of the VPS instances to activate the firewall
+	// on. A VPS instance can only have one active firewall.
+	// +crossplane:generate:reference:type=github.com/rossigee/provider-hostinger/apis/instance/v1beta1.Instance
+	// +crossplane:generate:reference:refFieldName=InstanceIDRefs
+	// +crossplane:generate:reference:selectorFieldName=InstanceIDSelector
+	// +kubebuilder:validation:Optional
+	InstanceIDs []string `json:"instanceIds,omitempty"`
+
+	// InstanceIDRefs reference Instances in the same namespace to retrieve
+	// their IDs.
+	// +kubebuilder:validation:Optional
+	InstanceIDRefs []xpv1.Reference `json:"instanceIdRefs,omitempty"`
+
+	// InstanceIDSelector selects Instances in the same namespace to retrieve
+	// their IDs. Set policy.resolve to Always to pick up Instances created
+	// later.
+	// +kubebuilder:validation:Optional
+	InstanceIDSelector *xpv1.Selector `json:"instanceIdSelector,omitempty"`
+}
+
+// FirewallAttachmentStatus is the observed state of a firewall on one
+// instance.
+type FirewallAttachmentStatus struct {
+	// InstanceID is the ID of the VPS instance.
+	InstanceID string `json:"instanceId"`
+
+	// State is Synced, Pending, Inactive or Failed.
+	State string `json:"state"`
+
+	// Message describes why activation failed, if it did.
+	Message string `json:"message,omitempty"`
+}
+
+// FirewallObservation are the observable fields of a shared Hostinger
+// firewall.
+type FirewallObservation struct {
+	// ID is the external firewall ID.
+	ID string `json:"id,omitempty"`
+
+	// Status is synced once the current rules have been applied to every
+	// instance the firewall is active on, and pending before.
+	Status string `json:"status,omitempty"`
+
+	// RuleCount is the number of active rules.
+	RuleCount *int32 `json:"ruleCount,omitempty"`
+
+	// Instances is the state of the firewall on each desired instance.
+	Instances []FirewallAttachmentStatus `json:"instances,omitempty"`
+
+	// UpdatedAt is when the firewall was last changed.
+	UpdatedAt *metav1.Time `json:"updatedAt,omitempty"`
+}
+
+// SharedFirewallSpec defines the desired state of a shared Hostinger
+// firewall.
+type SharedFirewallSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       FirewallParameters `json:"forProvider"`
+}
+
+// SharedFirewallStatus defines the observed state of a shared Hostinger
+// firewall.
+type SharedFirewallStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          FirewallObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Namespaced,categories={crossplane,managed,hostinger}
+// +kubebuilder:printcolumn:name="READY",type=string,JSONPath=.status.conditions[?(@.type=='Ready')].status
+// +kubebuilder:printcolumn:name="SYNCED",type=string,JSONPath=.status.conditions[?(@.type=='Synced')].status
+// +kubebuilder:printcolumn:name="STATUS",type=string,JSONPath=.status.atProvider.status
+// +kubebuilder:printcolumn:name="AGE",type=date,JSONPath=.metadata.creationTimestamp
+// +genclient
+
+// Firewall is the CRD type for account-level Hostinger firewalls that own a
+// rule set independently of any instance and can be activated on many
+// instances. The external name is the firewall ID.
+type Firewall struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   SharedFirewallSpec   `json:"spec,omitempty"`
+	Status SharedFirewallStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// FirewallList contains a list of Firewall resources.
+type FirewallList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Firewall `json:"items"`
+}
@@ -16,4 +16,5 @@ limitations under the License.
 
 // Package v1beta1 contains the Firewall rule resource API types.
 // +kubebuilder:object:generate=true
+// +groupName=firewall.m.hostinger.crossplane.io
 package v1beta1
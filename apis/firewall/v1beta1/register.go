@@ -16,6 +16,33 @@ limitations under the License.
 
 package v1beta1
 
+import (
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+const (
+	// FirewallKind is the kind of Firewall resource.
+	FirewallKind = "Firewall"
+
+	// FirewallRuleKind is the kind of FirewallRule resource.
+	FirewallRuleKind = "FirewallRule"
+)
+
+var (
+	// FirewallGroupKind is the GroupKind for Firewall resources.
+	FirewallGroupKind = schema.GroupKind{Group: Group, Kind: FirewallKind}.String()
+
+	// FirewallGroupVersionKind is the GroupVersionKind for Firewall resources.
+	FirewallGroupVersionKind = SchemeGroupVersion.WithKind(FirewallKind)
+
+	// FirewallRuleGroupKind is the GroupKind for FirewallRule resources.
+	FirewallRuleGroupKind = schema.GroupKind{Group: Group, Kind: FirewallRuleKind}.String()
+
+	// FirewallRuleGroupVersionKind is the GroupVersionKind for FirewallRule resources.
+	FirewallRuleGroupVersionKind = SchemeGroupVersion.WithKind(FirewallRuleKind)
+)
+
 func init() {
+	SchemeBuilder.Register(&Firewall{}, &FirewallList{})
 	SchemeBuilder.Register(&FirewallRule{}, &FirewallRuleList{})
 }
@@ -57,36 +57,99 @@ const (
 	FirewallActionDeny FirewallAction = "deny"
 )
 
+// FirewallDriftPolicy determines what happens when the live rules drift
+// from the spec
+// +kubebuilder:validation:Enum=Correct;Report
+type FirewallDriftPolicy string
+
+const (
+	// FirewallDriftPolicyCorrect reports drift and then corrects it
+	FirewallDriftPolicyCorrect FirewallDriftPolicy = "Correct"
+	// FirewallDriftPolicyReport only reports drift
+	FirewallDriftPolicyReport FirewallDriftPolicy = "Report"
+)
+
 // FirewallRuleSpec represents a single firewall rule
+// +kubebuilder:validation:XValidation:rule="self.protocol == 'icmp' ? (!has(self.port) || self.port == 'any') : has(self.port)",message="port is required for tcp and udp rules and must be omitted or 'any' for icmp rules"
+// +kubebuilder:validation:XValidation:rule="!(has(self.source) && has(self.sourceFrom))",message="source and sourceFrom are mutually exclusive"
+// +kubebuilder:validation:XValidation:rule="self.direction == 'inbound'",message="hostinger firewalls only filter inbound traffic"
+// +kubebuilder:validation:XValidation:rule="!has(self.destination) || self.destination == 'any'",message="hostinger firewalls only filter inbound traffic, destination must be omitted or 'any'"
 type FirewallRuleSpec struct {
-	// Port is the port number or port range (e.g., "80" or "8000-9000").
-	// +kubebuilder:validation:Required
-	Port string `json:"port"`
+	// Port is the port number, port range (e.g., "80" or "8000-9000"), or
+	// "any". Ports must be between 1 and 65535. Omit for icmp rules.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:MaxLength=11
+	// +kubebuilder:validation:Pattern=`^(any|[0-9]{1,5}(-[0-9]{1,5})?)$`
+	// +kubebuilder:validation:XValidation:rule="self == 'any' || self.split('-').all(p, int(p) >= 1 && int(p) <= 65535)",message="ports must be between 1 and 65535"
+	// +kubebuilder:validation:XValidation:rule="self == 'any' || !self.contains('-') || int(self.split('-')[0]) <= int(self.split('-')[1])",message="port range must be in ascending order"
+	Port string `json:"port,omitempty"`
 
 	// Protocol is the network protocol.
 	// +kubebuilder:validation:Required
 	// +kubebuilder:validation:Enum=tcp;udp;icmp
 	Protocol FirewallProtocol `json:"protocol"`
 
-	// Direction is the traffic direction.
+	// Direction is the traffic direction. Hostinger firewalls only filter
+	// inbound traffic, so outbound rules are rejected.
 	// +kubebuilder:validation:Required
 	// +kubebuilder:validation:Enum=inbound;outbound
 	Direction FirewallDirection `json:"direction"`
 
-	// Action is the action for matching traffic.
+	// Action is the action for matching traffic. Defaults to allow.
 	// +kubebuilder:validation:Optional
 	// +kubebuilder:validation:Enum=allow;deny
 	Action *FirewallAction `json:"action,omitempty"`
 
-	// Source is the source IP/CIDR for inbound rules.
+	// Source is the source IPv4/IPv6 address or CIDR for inbound rules, or
+	// "any". The longest IPv6 CIDR, with an embedded IPv4 address, is 49
+	// characters.
 	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:MaxLength=49
+	// +kubebuilder:validation:XValidation:rule="self == 'any' || isIP(self) || isCIDR(self)",message="source must be 'any', an IP address or a CIDR"
 	Source *string `json:"source,omitempty"`
 
-	// Destination is the destination IP/CIDR for outbound rules.
+	// SourceFrom takes the sources of inbound rules from another object. The
+	// rule expands into one rule per address or CIDR, and follows changes to
+	// the object.
+	// +kubebuilder:validation:Optional
+	SourceFrom *FirewallRuleSourceFrom `json:"sourceFrom,omitempty"`
+
+	// Destination is the destination of the traffic. Only "any" is
+	// supported, as Hostinger firewalls only filter inbound traffic.
 	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:MaxLength=49
+	// +kubebuilder:validation:XValidation:rule="self == 'any' || isIP(self) || isCIDR(self)",message="destination must be 'any', an IP address or a CIDR"
 	Destination *string `json:"destination,omitempty"`
 }
 
+// FirewallRuleSourceFrom selects the object that rule sources are read from.
+// +kubebuilder:validation:XValidation:rule="has(self.configMapKeyRef) != has(self.instanceRef)",message="exactly one of configMapKeyRef or instanceRef must be set"
+type FirewallRuleSourceFrom struct {
+	// ConfigMapKeyRef selects a ConfigMap key holding a list of addresses or
+	// CIDRs, separated by commas or whitespace. Lines starting with # are
+	// ignored.
+	// +kubebuilder:validation:Optional
+	ConfigMapKeyRef *ConfigMapKeySelector `json:"configMapKeyRef,omitempty"`
+
+	// InstanceRef selects a Hostinger Instance whose status.atProvider
+	// ipAddress and ipv6Address are used.
+	// +kubebuilder:validation:Optional
+	InstanceRef *xpv1.NamespacedReference `json:"instanceRef,omitempty"`
+}
+
+// ConfigMapKeySelector is a reference to a ConfigMap key.
+type ConfigMapKeySelector struct {
+	// Name of the ConfigMap.
+	Name string `json:"name"`
+
+	// Namespace of the ConfigMap. Defaults to the namespace of the resource.
+	// +kubebuilder:validation:Optional
+	Namespace string `json:"namespace,omitempty"`
+
+	// Key within the ConfigMap.
+	Key string `json:"key"`
+}
+
 // FirewallRuleParameters are the configurable fields of a Hostinger Firewall Rule.
 type FirewallRuleParameters struct {
 	// InstanceID is the ID of the VPS instance to configure firewall for.
@@ -96,12 +159,52 @@ type FirewallRuleParameters struct {
 
 	// Rules is the list of firewall rules.
 	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:MaxItems=100
 	Rules []FirewallRuleSpec `json:"rules,omitempty"`
 
 	// DefaultAction is the default action for traffic not matching any rules.
 	// +kubebuilder:validation:Optional
 	// +kubebuilder:validation:Enum=allow;deny
 	DefaultAction *FirewallAction `json:"defaultAction,omitempty"`
+
+	// DriftPolicy determines what happens when the live rules drift from
+	// the spec. Drift is always reported in status and as an event; with
+	// Report it is not corrected.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Enum=Correct;Report
+	// +kubebuilder:default=Correct
+	DriftPolicy *FirewallDriftPolicy `json:"driftPolicy,omitempty"`
+}
+
+// FirewallRuleDiff is one rule that differs between the spec and the live
+// firewall. Rules are shown in normalised form.
+type FirewallRuleDiff struct {
+	// Index is the position of the rule in spec.forProvider.rules. Set for
+	// missing and modified rules.
+	Index *int32 `json:"index,omitempty"`
+
+	// RuleID is the ID of the live rule. Set for extra and modified rules.
+	RuleID string `json:"ruleId,omitempty"`
+
+	// Desired is the rule in the spec. Set for missing and modified rules.
+	Desired *FirewallRuleSpec `json:"desired,omitempty"`
+
+	// Actual is the live rule. Set for extra and modified rules.
+	Actual *FirewallRuleSpec `json:"actual,omitempty"`
+}
+
+// FirewallRuleDrift is the difference between the spec and the live rules.
+type FirewallRuleDrift struct {
+	// Missing are rules in the spec that are not live.
+	Missing []FirewallRuleDiff `json:"missing,omitempty"`
+
+	// Extra are live rules that are not in the spec.
+	Extra []FirewallRuleDiff `json:"extra,omitempty"`
+
+	// Modified are live rules for the same direction, protocol and port as
+	// a rule in the spec, but with a different source, destination or
+	// action.
+	Modified []FirewallRuleDiff `json:"modified,omitempty"`
 }
 
 // FirewallRuleObservation are the observable fields of a Hostinger Firewall Rule.
@@ -120,6 +223,9 @@ type FirewallRuleObservation struct {
 
 	// CurrentDefaultAction is the current default action.
 	CurrentDefaultAction *FirewallAction `json:"currentDefaultAction,omitempty"`
+
+	// Drift is the difference between the spec and the live rules, if any.
+	Drift *FirewallRuleDrift `json:"drift,omitempty"`
 }
 
 // FirewallRuleSpec defines the desired state of a Hostinger Firewall Rule.
@@ -21,9 +21,172 @@ limitations under the License.
 package v1beta1
 
 import (
+	"github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConfigMapKeySelector) DeepCopyInto(out *ConfigMapKeySelector) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigMapKeySelector.
+func (in *ConfigMapKeySelector) DeepCopy() *ConfigMapKeySelector {
+	if in == nil {
+		return nil
+	}
+	out := new(ConfigMapKeySelector)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Firewall) DeepCopyInto(out *Firewall) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Firewall.
+func (in *Firewall) DeepCopy() *Firewall {
+	if in == nil {
+		return nil
+	}
+	out := new(Firewall)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Firewall) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FirewallAttachmentStatus) DeepCopyInto(out *FirewallAttachmentStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FirewallAttachmentStatus.
+func (in *FirewallAttachmentStatus) DeepCopy() *FirewallAttachmentStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(FirewallAttachmentStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FirewallList) DeepCopyInto(out *FirewallList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Firewall, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FirewallList.
+func (in *FirewallList) DeepCopy() *FirewallList {
+	if in == nil {
+		return nil
+	}
+	out := new(FirewallList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *FirewallList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FirewallObservation) DeepCopyInto(out *FirewallObservation) {
+	*out = *in
+	if in.RuleCount != nil {
+		in, out := &in.RuleCount, &out.RuleCount
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Instances != nil {
+		in, out := &in.Instances, &out.Instances
+		*out = make([]FirewallAttachmentStatus, len(*in))
+		copy(*out, *in)
+	}
+	if in.UpdatedAt != nil {
+		in, out := &in.UpdatedAt, &out.UpdatedAt
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FirewallObservation.
+func (in *FirewallObservation) DeepCopy() *FirewallObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(FirewallObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FirewallParameters) DeepCopyInto(out *FirewallParameters) {
+	*out = *in
+	if in.Name != nil {
+		in, out := &in.Name, &out.Name
+		*out = new(string)
+		**out = **in
+	}
+	if in.Rules != nil {
+		in, out := &in.Rules, &out.Rules
+		*out = make([]FirewallRuleSpec, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.InstanceIDs != nil {
+		in, out := &in.InstanceIDs, &out.InstanceIDs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.InstanceIDRefs != nil {
+		in, out := &in.InstanceIDRefs, &out.InstanceIDRefs
+		*out = make([]v1.Reference, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.InstanceIDSelector != nil {
+		in, out := &in.InstanceIDSelector, &out.InstanceIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FirewallParameters.
+func (in *FirewallParameters) DeepCopy() *FirewallParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(FirewallParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *FirewallRule) DeepCopyInto(out *FirewallRule) {
 	*out = *in
@@ -51,6 +214,72 @@ func (in *FirewallRule) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FirewallRuleDiff) DeepCopyInto(out *FirewallRuleDiff) {
+	*out = *in
+	if in.Index != nil {
+		in, out := &in.Index, &out.Index
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Desired != nil {
+		in, out := &in.Desired, &out.Desired
+		*out = new(FirewallRuleSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Actual != nil {
+		in, out := &in.Actual, &out.Actual
+		*out = new(FirewallRuleSpec)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FirewallRuleDiff.
+func (in *FirewallRuleDiff) DeepCopy() *FirewallRuleDiff {
+	if in == nil {
+		return nil
+	}
+	out := new(FirewallRuleDiff)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FirewallRuleDrift) DeepCopyInto(out *FirewallRuleDrift) {
+	*out = *in
+	if in.Missing != nil {
+		in, out := &in.Missing, &out.Missing
+		*out = make([]FirewallRuleDiff, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Extra != nil {
+		in, out := &in.Extra, &out.Extra
+		*out = make([]FirewallRuleDiff, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Modified != nil {
+		in, out := &in.Modified, &out.Modified
+		*out = make([]FirewallRuleDiff, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FirewallRuleDrift.
+func (in *FirewallRuleDrift) DeepCopy() *FirewallRuleDrift {
+	if in == nil {
+		return nil
+	}
+	out := new(FirewallRuleDrift)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *FirewallRuleList) DeepCopyInto(out *FirewallRuleList) {
 	*out = *in
@@ -100,6 +329,11 @@ func (in *FirewallRuleObservation) DeepCopyInto(out *FirewallRuleObservation) {
 		*out = new(FirewallAction)
 		**out = **in
 	}
+	if in.Drift != nil {
+		in, out := &in.Drift, &out.Drift
+		*out = new(FirewallRuleDrift)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FirewallRuleObservation.
@@ -127,6 +361,11 @@ func (in *FirewallRuleParameters) DeepCopyInto(out *FirewallRuleParameters) {
 		*out = new(FirewallAction)
 		**out = **in
 	}
+	if in.DriftPolicy != nil {
+		in, out := &in.DriftPolicy, &out.DriftPolicy
+		*out = new(FirewallDriftPolicy)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FirewallRuleParameters.
@@ -139,6 +378,31 @@ func (in *FirewallRuleParameters) DeepCopy() *FirewallRuleParameters {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FirewallRuleSourceFrom) DeepCopyInto(out *FirewallRuleSourceFrom) {
+	*out = *in
+	if in.ConfigMapKeyRef != nil {
+		in, out := &in.ConfigMapKeyRef, &out.ConfigMapKeyRef
+		*out = new(ConfigMapKeySelector)
+		**out = **in
+	}
+	if in.InstanceRef != nil {
+		in, out := &in.InstanceRef, &out.InstanceRef
+		*out = new(v1.NamespacedReference)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FirewallRuleSourceFrom.
+func (in *FirewallRuleSourceFrom) DeepCopy() *FirewallRuleSourceFrom {
+	if in == nil {
+		return nil
+	}
+	out := new(FirewallRuleSourceFrom)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *FirewallRuleSpec) DeepCopyInto(out *FirewallRuleSpec) {
 	*out = *in
@@ -152,6 +416,11 @@ func (in *FirewallRuleSpec) DeepCopyInto(out *FirewallRuleSpec) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.SourceFrom != nil {
+		in, out := &in.SourceFrom, &out.SourceFrom
+		*out = new(FirewallRuleSourceFrom)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Destination != nil {
 		in, out := &in.Destination, &out.Destination
 		*out = new(string)
@@ -202,3 +471,37 @@ func (in *FirewallStatus) DeepCopy() *FirewallStatus {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SharedFirewallSpec) DeepCopyInto(out *SharedFirewallSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SharedFirewallSpec.
+func (in *SharedFirewallSpec) DeepCopy() *SharedFirewallSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(SharedFirewallSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SharedFirewallStatus) DeepCopyInto(out *SharedFirewallStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SharedFirewallStatus.
+func (in *SharedFirewallStatus) DeepCopy() *SharedFirewallStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(SharedFirewallStatus)
+	in.DeepCopyInto(out)
+	return out
+}
@@ -25,7 +25,9 @@ limitations under the License.
 // Generate deepcopy methodsets and CRD manifests
 //go:generate go run -tags generate sigs.k8s.io/controller-tools/cmd/controller-gen object:headerFile=../hack/boilerplate.go.txt paths=./... crd:crdVersions=v1,allowDangerousTypes=true output:artifacts:config=../package/crds
 
-// Generate crossplane-runtime methodsets (resource.Claim, etc)
+// Generate crossplane-runtime methodsets (resource.Managed, etc) and the
+// ResolveReferences methods of types with +crossplane:generate:reference
+// markers
 //go:generate go run -tags generate github.com/crossplane/crossplane-tools/cmd/angryjet generate-methodsets --header-file=../hack/boilerplate.go.txt ./...
 
 package apis
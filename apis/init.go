@@ -22,8 +22,12 @@ import (
 	v1beta1 "github.com/rossigee/provider-hostinger/apis/v1beta1"
 	instancev1beta1 "github.com/rossigee/provider-hostinger/apis/instance/v1beta1"
 	backupv1beta1 "github.com/rossigee/provider-hostinger/apis/backup/v1beta1"
+	dnsv1beta1 "github.com/rossigee/provider-hostinger/apis/dns/v1beta1"
+	domainv1beta1 "github.com/rossigee/provider-hostinger/apis/domain/v1beta1"
 	firewallv1beta1 "github.com/rossigee/provider-hostinger/apis/firewall/v1beta1"
 	sshkeyv1beta1 "github.com/rossigee/provider-hostinger/apis/sshkey/v1beta1"
+	postinstallscriptv1beta1 "github.com/rossigee/provider-hostinger/apis/postinstallscript/v1beta1"
+	snapshotv1beta1 "github.com/rossigee/provider-hostinger/apis/snapshot/v1beta1"
 )
 
 // Scheme is the runtime.Scheme for all Hostinger APIs
@@ -36,6 +40,10 @@ var SchemeBuilder = runtime.NewSchemeBuilder(
 	backupv1beta1.SchemeBuilder.AddToScheme,
 	firewallv1beta1.SchemeBuilder.AddToScheme,
 	sshkeyv1beta1.SchemeBuilder.AddToScheme,
+	postinstallscriptv1beta1.SchemeBuilder.AddToScheme,
+	snapshotv1beta1.SchemeBuilder.AddToScheme,
+	dnsv1beta1.SchemeBuilder.AddToScheme,
+	domainv1beta1.SchemeBuilder.AddToScheme,
 )
 
 // AddToScheme adds all Hostinger API types to the scheme
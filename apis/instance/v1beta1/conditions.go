@@ -0,0 +1,118 @@
+/*
+Copyright 2025 Ross Golder.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+)
+
+// TypeRecreate reports the progress of an operating system reinstall.
+const TypeRecreate xpv1.ConditionType = "Recreate"
+
+// Reasons for the Recreate condition.
+const (
+	ReasonRecreateNotAllowed xpv1.ConditionReason = "RecreateNotAllowed"
+	ReasonRecreating         xpv1.ConditionReason = "Recreating"
+	ReasonRecreateComplete   xpv1.ConditionReason = "RecreateComplete"
+	ReasonRecreateFailed     xpv1.ConditionReason = "RecreateFailed"
+)
+
+// RecreateNotAllowed indicates that osId changed but allowRecreate is not set.
+func RecreateNotAllowed() xpv1.Condition {
+	return xpv1.Condition{
+		Type:               TypeRecreate,
+		Status:             corev1.ConditionFalse,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonRecreateNotAllowed,
+		Message:            "osId differs from the installed template; set allowRecreate to reinstall",
+	}
+}
+
+// Recreating indicates that the operating system is being reinstalled.
+func Recreating() xpv1.Condition {
+	return xpv1.Condition{
+		Type:               TypeRecreate,
+		Status:             corev1.ConditionTrue,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonRecreating,
+	}
+}
+
+// RecreateComplete indicates that the last reinstall has finished.
+func RecreateComplete() xpv1.Condition {
+	return xpv1.Condition{
+		Type:               TypeRecreate,
+		Status:             corev1.ConditionFalse,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonRecreateComplete,
+	}
+}
+
+// RecreateFailed indicates that the last reinstall action reported an error.
+func RecreateFailed() xpv1.Condition {
+	return xpv1.Condition{
+		Type:               TypeRecreate,
+		Status:             corev1.ConditionFalse,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonRecreateFailed,
+		Message:            "the operating system reinstall action reported an error",
+	}
+}
+
+// TypeUpgrade reports the progress of a plan upgrade.
+const TypeUpgrade xpv1.ConditionType = "Upgrade"
+
+// Reasons for the Upgrade condition.
+const (
+	ReasonUpgrading       xpv1.ConditionReason = "Upgrading"
+	ReasonUpgradeComplete xpv1.ConditionReason = "UpgradeComplete"
+	ReasonUpgradeFailed   xpv1.ConditionReason = "UpgradeFailed"
+)
+
+// Upgrading indicates that the instance is being moved to another plan.
+func Upgrading() xpv1.Condition {
+	return xpv1.Condition{
+		Type:               TypeUpgrade,
+		Status:             corev1.ConditionTrue,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonUpgrading,
+	}
+}
+
+// UpgradeComplete indicates that the last plan upgrade has finished.
+func UpgradeComplete() xpv1.Condition {
+	return xpv1.Condition{
+		Type:               TypeUpgrade,
+		Status:             corev1.ConditionFalse,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonUpgradeComplete,
+	}
+}
+
+// UpgradeFailed indicates that the last plan upgrade action reported an error.
+func UpgradeFailed() xpv1.Condition {
+	return xpv1.Condition{
+		Type:               TypeUpgrade,
+		Status:             corev1.ConditionFalse,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonUpgradeFailed,
+		Message:            "the plan upgrade action reported an error",
+	}
+}
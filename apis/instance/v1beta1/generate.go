@@ -16,4 +16,5 @@ limitations under the License.
 
 // Package v1beta1 contains the Instance resource API types.
 // +kubebuilder:object:generate=true
+// +groupName=instance.m.hostinger.crossplane.io
 package v1beta1
@@ -23,6 +23,8 @@ import (
 )
 
 // InstanceParameters are the configurable fields of a Hostinger VPS Instance.
+// +kubebuilder:validation:XValidation:rule="has(self.plan) || (has(self.cpuCount) && has(self.ram) && has(self.diskSize))",message="either plan or cpuCount, ram and diskSize must be set"
+// +kubebuilder:validation:XValidation:rule="!has(oldSelf.dataCenterId) || has(self.dataCenterId)",message="dataCenterId cannot be removed once set"
 type InstanceParameters struct {
 	// Hostname is the hostname for the VPS instance.
 	// +kubebuilder:validation:Required
@@ -33,36 +35,131 @@ type InstanceParameters struct {
 	// +kubebuilder:validation:Required
 	OSId string `json:"osId"`
 
-	// CPUCount is the number of CPU cores.
-	// +kubebuilder:validation:Required
+	// Plan is the name of the KVM plan from the Hostinger billing catalog,
+	// e.g. "KVM 2". Changing it to a bigger plan upgrades the instance.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:MinLength=1
+	Plan *string `json:"plan,omitempty"`
+
+	// DataCenterID is the Hostinger data center to place the instance in.
+	// It is validated against the data centers offered by the API and cannot
+	// be changed or removed once set. It is late-initialized from the instance
+	// when unset, and setting it later to another data center than the
+	// instance's is reported as an error rather than moving the instance.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="dataCenterId is immutable"
+	DataCenterID *string `json:"dataCenterId,omitempty"`
+
+	// PaymentMethodID is the payment method used to purchase or upgrade the
+	// instance. The account default is used when unset.
+	// +kubebuilder:validation:Optional
+	PaymentMethodID *int64 `json:"paymentMethodId,omitempty"`
+
+	// BillingPeriod is the billing period of the plan in months, used to pick
+	// the plan's price when purchasing or upgrading. It is required when the
+	// plan is sold for more than one period.
+	// +kubebuilder:validation:Optional
 	// +kubebuilder:validation:Minimum=1
-	CPUCount int32 `json:"cpuCount"`
+	BillingPeriod *int32 `json:"billingPeriod,omitempty"`
 
-	// RAM is the amount of RAM in MB.
-	// +kubebuilder:validation:Required
+	// Currency is the ISO 4217 currency of the plan's price, e.g. "USD". It is
+	// required when the plan is sold in more than one currency.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Pattern=`^[A-Z]{3}$`
+	Currency *string `json:"currency,omitempty"`
+
+	// CPUCount is the number of CPU cores. When Plan is set it must match the
+	// plan, otherwise it is used together with RAM and DiskSize to select one.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Minimum=1
+	CPUCount int32 `json:"cpuCount,omitempty"`
+
+	// RAM is the amount of RAM in MB. When Plan is set it must match the plan.
+	// +kubebuilder:validation:Optional
 	// +kubebuilder:validation:Minimum=512
-	RAM int32 `json:"ram"`
+	RAM int32 `json:"ram,omitempty"`
 
-	// DiskSize is the disk size in GB.
-	// +kubebuilder:validation:Required
+	// DiskSize is the disk size in GB. When Plan is set it must match the plan.
+	// +kubebuilder:validation:Optional
 	// +kubebuilder:validation:Minimum=10
-	DiskSize int32 `json:"diskSize"`
+	DiskSize int32 `json:"diskSize,omitempty"`
 
-	// Bandwidth is the bandwidth in GB/month.
+	// Bandwidth is the bandwidth in GB/month. It comes with the plan and is
+	// observe-only: it is filled in from the instance and never enforced.
 	// +kubebuilder:validation:Optional
 	Bandwidth *int32 `json:"bandwidth,omitempty"`
 
-	// IPv6Enabled specifies whether IPv6 should be enabled.
+	// IPv6Enabled specifies whether IPv6 is enabled. It is observe-only: it
+	// is filled in from the instance and never enforced.
 	// +kubebuilder:validation:Optional
 	IPv6Enabled *bool `json:"ipv6Enabled,omitempty"`
 
-	// Inodes is the number of inodes.
+	// Inodes is the number of inodes. It comes with the plan and is
+	// observe-only: it is filled in from the instance and never enforced.
 	// +kubebuilder:validation:Optional
 	Inodes *int32 `json:"inodes,omitempty"`
 
 	// RootPasswordSecretRef is a reference to a secret containing the root password.
 	// +kubebuilder:validation:Optional
 	RootPasswordSecretRef *xpv1.SecretKeySelector `json:"rootPasswordSecretRef,omitempty"`
+
+	// AllowRecreate permits the provider to reinstall the operating system
+	// when OSId changes. Reinstalling wipes the instance disk.
+	// +kubebuilder:validation:Optional
+	AllowRecreate *bool `json:"allowRecreate,omitempty"`
+
+	// PostInstallScriptID is the ID of a post-install script to run after the
+	// operating system is installed, both on create and on recreate.
+	// +crossplane:generate:reference:type=github.com/rossigee/provider-hostinger/apis/postinstallscript/v1beta1.PostInstallScript
+	// +kubebuilder:validation:Optional
+	PostInstallScriptID *string `json:"postInstallScriptId,omitempty"`
+
+	// PostInstallScriptIDRef references a PostInstallScript in the same
+	// namespace to retrieve its ID.
+	// +kubebuilder:validation:Optional
+	PostInstallScriptIDRef *xpv1.Reference `json:"postInstallScriptIdRef,omitempty"`
+
+	// PostInstallScriptIDSelector selects a PostInstallScript in the same
+	// namespace to retrieve its ID.
+	// +kubebuilder:validation:Optional
+	PostInstallScriptIDSelector *xpv1.Selector `json:"postInstallScriptIdSelector,omitempty"`
+
+	// PTRRecords are the reverse DNS names of the primary IP addresses.
+	// +kubebuilder:validation:Optional
+	PTRRecords *PTRRecords `json:"ptrRecords,omitempty"`
+
+	// RecoveryMode boots the instance into a rescue system, e.g. when it no
+	// longer boots on its own.
+	// +kubebuilder:validation:Optional
+	RecoveryMode *RecoveryMode `json:"recoveryMode,omitempty"`
+}
+
+// RecoveryMode configures the rescue system of an instance.
+// +kubebuilder:validation:XValidation:rule="!self.enabled || has(self.rootPasswordSecretRef)",message="rootPasswordSecretRef is required when recovery mode is enabled"
+type RecoveryMode struct {
+	// Enabled boots the instance into the rescue system when true, and back
+	// into its own operating system when false.
+	// +kubebuilder:validation:Required
+	Enabled bool `json:"enabled"`
+
+	// RootPasswordSecretRef is a reference to a secret containing the root
+	// password of the rescue system.
+	// +kubebuilder:validation:Optional
+	RootPasswordSecretRef *xpv1.SecretKeySelector `json:"rootPasswordSecretRef,omitempty"`
+}
+
+// PTRRecords are the reverse DNS names of the primary IP addresses of an
+// instance. Unset fields are left alone; an empty string removes the record.
+type PTRRecords struct {
+	// IPv4 is the PTR record of the primary IPv4 address.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:MaxLength=253
+	IPv4 *string `json:"ipv4,omitempty"`
+
+	// IPv6 is the PTR record of the primary IPv6 address.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:MaxLength=253
+	IPv6 *string `json:"ipv6,omitempty"`
 }
 
 // InstanceObservation are the observable fields of a Hostinger VPS Instance.
@@ -88,6 +185,36 @@ type InstanceObservation struct {
 	// CurrentHostname is the current hostname set on the instance.
 	CurrentHostname string `json:"currentHostname,omitempty"`
 
+	// CurrentIPv4PTR is the PTR record of the primary IPv4 address.
+	CurrentIPv4PTR string `json:"currentIpv4Ptr,omitempty"`
+
+	// CurrentIPv6PTR is the PTR record of the primary IPv6 address.
+	CurrentIPv6PTR string `json:"currentIpv6Ptr,omitempty"`
+
+	// CurrentOSId is the operating system template currently installed.
+	CurrentOSId string `json:"currentOsId,omitempty"`
+
+	// RecoveryMode is true while the instance is booted into the rescue system.
+	RecoveryMode bool `json:"recoveryMode,omitempty"`
+
+	// RecreateActionID is the ID of the operating system reinstall action
+	// currently in progress, if any.
+	RecreateActionID *int64 `json:"recreateActionId,omitempty"`
+
+	// UpgradeActionID is the ID of the plan upgrade action currently in
+	// progress, if any.
+	UpgradeActionID *int64 `json:"upgradeActionId,omitempty"`
+
+	// RecoveryActionID is the ID of the action entering or leaving recovery
+	// mode currently in progress, if any.
+	RecoveryActionID *int64 `json:"recoveryActionId,omitempty"`
+
+	// DataCenterID is the data center the instance is located in.
+	DataCenterID string `json:"dataCenterId,omitempty"`
+
+	// CurrentPlan is the KVM plan the instance is currently subscribed to.
+	CurrentPlan string `json:"currentPlan,omitempty"`
+
 	// CurrentCPUCount is the current CPU count.
 	CurrentCPUCount int32 `json:"currentCpuCount,omitempty"`
 
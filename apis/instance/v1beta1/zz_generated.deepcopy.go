@@ -95,6 +95,21 @@ func (in *InstanceObservation) DeepCopyInto(out *InstanceObservation) {
 		in, out := &in.ExpirationDate, &out.ExpirationDate
 		*out = (*in).DeepCopy()
 	}
+	if in.RecreateActionID != nil {
+		in, out := &in.RecreateActionID, &out.RecreateActionID
+		*out = new(int64)
+		**out = **in
+	}
+	if in.UpgradeActionID != nil {
+		in, out := &in.UpgradeActionID, &out.UpgradeActionID
+		*out = new(int64)
+		**out = **in
+	}
+	if in.RecoveryActionID != nil {
+		in, out := &in.RecoveryActionID, &out.RecoveryActionID
+		*out = new(int64)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InstanceObservation.
@@ -110,6 +125,31 @@ func (in *InstanceObservation) DeepCopy() *InstanceObservation {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *InstanceParameters) DeepCopyInto(out *InstanceParameters) {
 	*out = *in
+	if in.Plan != nil {
+		in, out := &in.Plan, &out.Plan
+		*out = new(string)
+		**out = **in
+	}
+	if in.DataCenterID != nil {
+		in, out := &in.DataCenterID, &out.DataCenterID
+		*out = new(string)
+		**out = **in
+	}
+	if in.PaymentMethodID != nil {
+		in, out := &in.PaymentMethodID, &out.PaymentMethodID
+		*out = new(int64)
+		**out = **in
+	}
+	if in.BillingPeriod != nil {
+		in, out := &in.BillingPeriod, &out.BillingPeriod
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Currency != nil {
+		in, out := &in.Currency, &out.Currency
+		*out = new(string)
+		**out = **in
+	}
 	if in.Bandwidth != nil {
 		in, out := &in.Bandwidth, &out.Bandwidth
 		*out = new(int32)
@@ -130,6 +170,36 @@ func (in *InstanceParameters) DeepCopyInto(out *InstanceParameters) {
 		*out = new(v1.SecretKeySelector)
 		**out = **in
 	}
+	if in.AllowRecreate != nil {
+		in, out := &in.AllowRecreate, &out.AllowRecreate
+		*out = new(bool)
+		**out = **in
+	}
+	if in.PostInstallScriptID != nil {
+		in, out := &in.PostInstallScriptID, &out.PostInstallScriptID
+		*out = new(string)
+		**out = **in
+	}
+	if in.PostInstallScriptIDRef != nil {
+		in, out := &in.PostInstallScriptIDRef, &out.PostInstallScriptIDRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PostInstallScriptIDSelector != nil {
+		in, out := &in.PostInstallScriptIDSelector, &out.PostInstallScriptIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PTRRecords != nil {
+		in, out := &in.PTRRecords, &out.PTRRecords
+		*out = new(PTRRecords)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RecoveryMode != nil {
+		in, out := &in.RecoveryMode, &out.RecoveryMode
+		*out = new(RecoveryMode)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InstanceParameters.
@@ -175,3 +245,48 @@ func (in *InstanceStatus) DeepCopy() *InstanceStatus {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PTRRecords) DeepCopyInto(out *PTRRecords) {
+	*out = *in
+	if in.IPv4 != nil {
+		in, out := &in.IPv4, &out.IPv4
+		*out = new(string)
+		**out = **in
+	}
+	if in.IPv6 != nil {
+		in, out := &in.IPv6, &out.IPv6
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PTRRecords.
+func (in *PTRRecords) DeepCopy() *PTRRecords {
+	if in == nil {
+		return nil
+	}
+	out := new(PTRRecords)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RecoveryMode) DeepCopyInto(out *RecoveryMode) {
+	*out = *in
+	if in.RootPasswordSecretRef != nil {
+		in, out := &in.RootPasswordSecretRef, &out.RootPasswordSecretRef
+		*out = new(v1.SecretKeySelector)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RecoveryMode.
+func (in *RecoveryMode) DeepCopy() *RecoveryMode {
+	if in == nil {
+		return nil
+	}
+	out := new(RecoveryMode)
+	in.DeepCopyInto(out)
+	return out
+}
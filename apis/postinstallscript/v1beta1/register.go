@@ -0,0 +1,38 @@
+/*
+Copyright 2025 Ross Golder.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+const (
+	// PostInstallScriptKind is the kind of PostInstallScript resource.
+	PostInstallScriptKind = "PostInstallScript"
+)
+
+var (
+	// PostInstallScriptGroupKind is the GroupKind for PostInstallScript resources.
+	PostInstallScriptGroupKind = schema.GroupKind{Group: Group, Kind: PostInstallScriptKind}.String()
+
+	// PostInstallScriptGroupVersionKind is the GroupVersionKind for PostInstallScript resources.
+	PostInstallScriptGroupVersionKind = SchemeGroupVersion.WithKind(PostInstallScriptKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&PostInstallScript{}, &PostInstallScriptList{})
+}
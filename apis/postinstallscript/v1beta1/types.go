@@ -0,0 +1,101 @@
+/*
+Copyright 2025 Ross Golder.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+)
+
+// PostInstallScriptParameters are the configurable fields of a Hostinger
+// post-install script.
+// +kubebuilder:validation:XValidation:rule="has(self.content) != has(self.contentSecretRef)",message="exactly one of content or contentSecretRef must be set"
+type PostInstallScriptParameters struct {
+	// Name is the name of the post-install script.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	Name string `json:"name"`
+
+	// Content is the script to run on first boot, given inline.
+	// +kubebuilder:validation:Optional
+	Content *string `json:"content,omitempty"`
+
+	// ContentSecretRef is a reference to a secret containing the script,
+	// for scripts that embed credentials.
+	// +kubebuilder:validation:Optional
+	ContentSecretRef *xpv1.SecretKeySelector `json:"contentSecretRef,omitempty"`
+}
+
+// PostInstallScriptObservation are the observable fields of a Hostinger
+// post-install script.
+type PostInstallScriptObservation struct {
+	// ID is the external post-install script ID.
+	ID string `json:"id,omitempty"`
+
+	// Name is the current name of the post-install script.
+	Name string `json:"name,omitempty"`
+
+	// ContentHash is a SHA-256 hash of the current script content.
+	ContentHash string `json:"contentHash,omitempty"`
+
+	// CreatedAt is when the post-install script was created.
+	CreatedAt *metav1.Time `json:"createdAt,omitempty"`
+
+	// UpdatedAt is when the post-install script was last updated.
+	UpdatedAt *metav1.Time `json:"updatedAt,omitempty"`
+}
+
+// PostInstallScriptSpec defines the desired state of a Hostinger post-install
+// script.
+type PostInstallScriptSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       PostInstallScriptParameters `json:"forProvider"`
+}
+
+// PostInstallScriptStatus defines the observed state of a Hostinger
+// post-install script.
+type PostInstallScriptStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          PostInstallScriptObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Namespaced,categories={crossplane,managed,hostinger}
+// +kubebuilder:printcolumn:name="READY",type=string,JSONPath=.status.conditions[?(@.type=='Ready')].status
+// +kubebuilder:printcolumn:name="SYNCED",type=string,JSONPath=.status.conditions[?(@.type=='Synced')].status
+// +kubebuilder:printcolumn:name="AGE",type=date,JSONPath=.metadata.creationTimestamp
+// +genclient
+
+// PostInstallScript is the CRD type for Hostinger VPS post-install scripts.
+type PostInstallScript struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   PostInstallScriptSpec   `json:"spec,omitempty"`
+	Status PostInstallScriptStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// PostInstallScriptList contains a list of PostInstallScript resources.
+type PostInstallScriptList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []PostInstallScript `json:"items"`
+}
@@ -0,0 +1,38 @@
+/*
+Copyright 2025 Ross Golder.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+const (
+	// SnapshotKind is the kind of Snapshot resource.
+	SnapshotKind = "Snapshot"
+)
+
+var (
+	// SnapshotGroupKind is the GroupKind for Snapshot resources.
+	SnapshotGroupKind = schema.GroupKind{Group: Group, Kind: SnapshotKind}.String()
+
+	// SnapshotGroupVersionKind is the GroupVersionKind for Snapshot resources.
+	SnapshotGroupVersionKind = SchemeGroupVersion.WithKind(SnapshotKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&Snapshot{}, &SnapshotList{})
+}
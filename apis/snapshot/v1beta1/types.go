@@ -0,0 +1,138 @@
+/*
+Copyright 2025 Ross Golder.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+)
+
+// OverwritePolicy controls whether an existing snapshot may be replaced.
+type OverwritePolicy string
+
+// Overwrite policies. Hostinger keeps a single snapshot per instance, so
+// creating a snapshot replaces any snapshot taken before.
+const (
+	// OverwriteNever refuses to create a snapshot while the instance
+	// already has one.
+	OverwriteNever OverwritePolicy = "Never"
+	// OverwriteAlways replaces any existing snapshot of the instance.
+	OverwriteAlways OverwritePolicy = "Always"
+)
+
+// SnapshotParameters are the configurable fields of a Hostinger VPS snapshot.
+type SnapshotParameters struct {
+	// InstanceID is the ID of the instance to snapshot.
+	// +crossplane:generate:reference:type=github.com/rossigee/provider-hostinger/apis/instance/v1beta1.Instance
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="instanceId is immutable"
+	InstanceID *string `json:"instanceId,omitempty"`
+
+	// InstanceIDRef references an Instance in the same namespace to retrieve
+	// its ID.
+	// +kubebuilder:validation:Optional
+	InstanceIDRef *xpv1.Reference `json:"instanceIdRef,omitempty"`
+
+	// InstanceIDSelector selects an Instance in the same namespace to
+	// retrieve its ID.
+	// +kubebuilder:validation:Optional
+	InstanceIDSelector *xpv1.Selector `json:"instanceIdSelector,omitempty"`
+
+	// OverwritePolicy controls whether an existing snapshot of the instance
+	// may be replaced when this snapshot is created.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Enum=Never;Always
+	// +kubebuilder:default=Never
+	OverwritePolicy *OverwritePolicy `json:"overwritePolicy,omitempty"`
+
+	// RestoreGeneration restores the instance from the snapshot whenever it
+	// is increased above status.atProvider.restoredGeneration. Restoring
+	// replaces all data on the instance disk.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Minimum=0
+	RestoreGeneration *int64 `json:"restoreGeneration,omitempty"`
+}
+
+// SnapshotObservation are the observable fields of a Hostinger VPS snapshot.
+type SnapshotObservation struct {
+	// ID is the external snapshot ID.
+	ID string `json:"id,omitempty"`
+
+	// CreatedAt is when the snapshot was taken.
+	CreatedAt *metav1.Time `json:"createdAt,omitempty"`
+
+	// ExpiresAt is when Hostinger will delete the snapshot.
+	ExpiresAt *metav1.Time `json:"expiresAt,omitempty"`
+
+	// CreateActionID is the ID of the snapshot creation action currently in
+	// progress, if any. It is tracked in the external name, which reads
+	// <instance ID>/<action ID> until the action has finished.
+	CreateActionID *int64 `json:"createActionId,omitempty"`
+
+	// RestoreActionID is the ID of the restore action currently in
+	// progress, if any.
+	RestoreActionID *int64 `json:"restoreActionId,omitempty"`
+
+	// RestoredGeneration is the last restoreGeneration a restore was
+	// started for. It is set to the current restoreGeneration when the
+	// snapshot is first observed, so that creating or adopting a snapshot
+	// never restores it.
+	RestoredGeneration *int64 `json:"restoredGeneration,omitempty"`
+
+	// LastRestoreState is the final state of the last restore action.
+	LastRestoreState string `json:"lastRestoreState,omitempty"`
+}
+
+// SnapshotSpec defines the desired state of a Hostinger VPS snapshot.
+type SnapshotSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       SnapshotParameters `json:"forProvider"`
+}
+
+// SnapshotStatus defines the observed state of a Hostinger VPS snapshot.
+type SnapshotStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          SnapshotObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Namespaced,categories={crossplane,managed,hostinger}
+// +kubebuilder:printcolumn:name="READY",type=string,JSONPath=.status.conditions[?(@.type=='Ready')].status
+// +kubebuilder:printcolumn:name="SYNCED",type=string,JSONPath=.status.conditions[?(@.type=='Synced')].status
+// +kubebuilder:printcolumn:name="AGE",type=date,JSONPath=.metadata.creationTimestamp
+// +genclient
+
+// Snapshot is the CRD type for Hostinger VPS snapshots. Hostinger keeps a
+// single snapshot per instance, so the external name is the instance ID.
+type Snapshot struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   SnapshotSpec   `json:"spec,omitempty"`
+	Status SnapshotStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// SnapshotList contains a list of Snapshot resources.
+type SnapshotList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Snapshot `json:"items"`
+}
@@ -16,6 +16,23 @@ limitations under the License.
 
 package v1beta1
 
+import (
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+const (
+	// SSHKeyKind is the kind of SSHKey resource.
+	SSHKeyKind = "SSHKey"
+)
+
+var (
+	// SSHKeyGroupKind is the GroupKind for SSHKey resources.
+	SSHKeyGroupKind = schema.GroupKind{Group: Group, Kind: SSHKeyKind}.String()
+
+	// SSHKeyGroupVersionKind is the GroupVersionKind for SSHKey resources.
+	SSHKeyGroupVersionKind = SchemeGroupVersion.WithKind(SSHKeyKind)
+)
+
 func init() {
 	SchemeBuilder.Register(&SSHKey{}, &SSHKeyList{})
 }
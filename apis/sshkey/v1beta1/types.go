@@ -22,7 +22,32 @@ import (
 	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
 )
 
+// SSHKeyType is the algorithm of a generated SSH key
+// +kubebuilder:validation:Enum=ed25519;rsa
+type SSHKeyType string
+
+const (
+	// SSHKeyTypeED25519 is an Ed25519 key
+	SSHKeyTypeED25519 SSHKeyType = "ed25519"
+	// SSHKeyTypeRSA is an RSA key
+	SSHKeyTypeRSA SSHKeyType = "rsa"
+)
+
+// SSHKeyGenerate configures a keypair generated by the provider.
+// +kubebuilder:validation:XValidation:rule="!has(self.bits) || self.type == 'rsa'",message="bits can only be set for rsa keys"
+type SSHKeyGenerate struct {
+	// Type is the key algorithm.
+	// +kubebuilder:validation:Required
+	Type SSHKeyType `json:"type"`
+
+	// Bits is the size of RSA keys. Defaults to 4096.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Enum=2048;3072;4096
+	Bits *int32 `json:"bits,omitempty"`
+}
+
 // SSHKeyParameters are the configurable fields of a Hostinger SSH Key.
+// +kubebuilder:validation:XValidation:rule="has(self.publicKeySecretRef) != has(self.generate)",message="exactly one of publicKeySecretRef or generate must be set"
 type SSHKeyParameters struct {
 	// Name is the name/label for the SSH key.
 	// +kubebuilder:validation:Required
@@ -31,12 +56,24 @@ type SSHKeyParameters struct {
 
 	// PublicKeySecretRef is a reference to a secret containing the public key.
 	// The secret key should be "public-key".
-	// +kubebuilder:validation:Required
-	PublicKeySecretRef xpv1.SecretKeySelector `json:"publicKeySecretRef"`
+	// +kubebuilder:validation:Optional
+	PublicKeySecretRef *xpv1.SecretKeySelector `json:"publicKeySecretRef,omitempty"`
+
+	// Generate makes the provider generate the keypair. The private key is
+	// written to the connection secret, which must be configured.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="generate is immutable"
+	Generate *SSHKeyGenerate `json:"generate,omitempty"`
 
 	// InstanceIDs are the instance IDs to attach this SSH key to.
 	// +kubebuilder:validation:Optional
 	InstanceIDs []string `json:"instanceIds,omitempty"`
+
+	// InstanceSelector selects Instances in the same namespace to attach this
+	// SSH key to, in addition to InstanceIDs. It is re-evaluated on every
+	// reconcile, so matching Instances are picked up as they appear.
+	// +kubebuilder:validation:Optional
+	InstanceSelector *metav1.LabelSelector `json:"instanceSelector,omitempty"`
 }
 
 // SSHKeyObservation are the observable fields of a Hostinger SSH Key.
@@ -44,20 +81,44 @@ type SSHKeyObservation struct {
 	// ID is the external SSH key resource ID.
 	ID string `json:"id,omitempty"`
 
-	// Fingerprint is the SSH key fingerprint.
+	// Fingerprint is the OpenSSH SHA256 fingerprint of the key.
 	Fingerprint string `json:"fingerprint,omitempty"`
 
 	// CreatedDate is when the SSH key was created.
 	CreatedDate *metav1.Time `json:"createdDate,omitempty"`
 
-	// AttachedInstances is the list of instance IDs this key is attached to.
+	// AttachedInstances is the list of instance IDs this key is attached to,
+	// out of those listed in InstanceIDs or matched by InstanceSelector.
 	AttachedInstances []string `json:"attachedInstances,omitempty"`
 
-	// PublicKeyHash is a hash of the public key content.
+	// UnmatchedInstances are instances the key was attached to that are no
+	// longer listed or matched. The Hostinger API cannot detach keys, so the
+	// key must be removed from these instances manually.
+	UnmatchedInstances []string `json:"unmatchedInstances,omitempty"`
+
+	// PublicKeyHash is a SHA256 hash of the public key, without its
+	// comment. A change of hash means the key was rotated.
 	PublicKeyHash string `json:"publicKeyHash,omitempty"`
+
+	// KeyType is the key type, such as ssh-ed25519 or ssh-rsa.
+	KeyType string `json:"keyType,omitempty"`
+
+	// Bits is the key size.
+	Bits int32 `json:"bits,omitempty"`
+
+	// Comment is the comment of the public key.
+	Comment string `json:"comment,omitempty"`
+
+	// ReplacementID is the ID of a key uploaded to replace a changed key,
+	// until the external name has been switched to it.
+	ReplacementID string `json:"replacementId,omitempty"`
+
+	// ReplacedID is the ID of a replaced key that has not been deleted yet.
+	ReplacedID string `json:"replacedId,omitempty"`
 }
 
 // SSHKeySpec defines the desired state of a Hostinger SSH Key.
+// +kubebuilder:validation:XValidation:rule="!has(self.forProvider.generate) || has(self.writeConnectionSecretToRef)",message="writeConnectionSecretToRef is required to generate a key"
 type SSHKeySpec struct {
 	xpv1.ResourceSpec `json:",inline"`
 	ForProvider       SSHKeyParameters `json:"forProvider"`
@@ -74,6 +135,8 @@ type SSHKeyStatus struct {
 // +kubebuilder:resource:scope=Namespaced,categories={crossplane,managed,hostinger}
 // +kubebuilder:printcolumn:name="READY",type=string,JSONPath=.status.conditions[?(@.type=='Ready')].status
 // +kubebuilder:printcolumn:name="SYNCED",type=string,JSONPath=.status.conditions[?(@.type=='Synced')].status
+// +kubebuilder:printcolumn:name="TYPE",type=string,JSONPath=.status.atProvider.keyType
+// +kubebuilder:printcolumn:name="FINGERPRINT",type=string,JSONPath=.status.atProvider.fingerprint
 // +kubebuilder:printcolumn:name="AGE",type=date,JSONPath=.metadata.creationTimestamp
 // +genclient
 
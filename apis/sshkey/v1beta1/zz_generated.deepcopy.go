@@ -21,6 +21,8 @@ limitations under the License.
 package v1beta1
 
 import (
+	"github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
@@ -51,6 +53,26 @@ func (in *SSHKey) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SSHKeyGenerate) DeepCopyInto(out *SSHKeyGenerate) {
+	*out = *in
+	if in.Bits != nil {
+		in, out := &in.Bits, &out.Bits
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SSHKeyGenerate.
+func (in *SSHKeyGenerate) DeepCopy() *SSHKeyGenerate {
+	if in == nil {
+		return nil
+	}
+	out := new(SSHKeyGenerate)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SSHKeyList) DeepCopyInto(out *SSHKeyList) {
 	*out = *in
@@ -95,6 +117,11 @@ func (in *SSHKeyObservation) DeepCopyInto(out *SSHKeyObservation) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.UnmatchedInstances != nil {
+		in, out := &in.UnmatchedInstances, &out.UnmatchedInstances
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SSHKeyObservation.
@@ -110,12 +137,26 @@ func (in *SSHKeyObservation) DeepCopy() *SSHKeyObservation {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SSHKeyParameters) DeepCopyInto(out *SSHKeyParameters) {
 	*out = *in
-	in.PublicKeySecretRef.DeepCopyInto(&out.PublicKeySecretRef)
+	if in.PublicKeySecretRef != nil {
+		in, out := &in.PublicKeySecretRef, &out.PublicKeySecretRef
+		*out = new(v1.SecretKeySelector)
+		**out = **in
+	}
+	if in.Generate != nil {
+		in, out := &in.Generate, &out.Generate
+		*out = new(SSHKeyGenerate)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.InstanceIDs != nil {
 		in, out := &in.InstanceIDs, &out.InstanceIDs
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.InstanceSelector != nil {
+		in, out := &in.InstanceSelector, &out.InstanceSelector
+		*out = new(metav1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SSHKeyParameters.
@@ -16,4 +16,5 @@ limitations under the License.
 
 // Package v1beta1 contains the core API types for the Hostinger provider.
 // +kubebuilder:object:generate=true
+// +groupName=hostinger.crossplane.io
 package v1beta1
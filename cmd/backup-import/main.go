@@ -0,0 +1,76 @@
+/*
+Copyright 2025 Ross Golder.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Command backup-import prints observe-only Backup managed resources for the
+// existing backups of one or more Hostinger VPS instances, using the
+// credentials of a ProviderConfig in the cluster.
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"gopkg.in/alecthomas/kingpin.v2"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/yaml"
+
+	"github.com/rossigee/provider-hostinger/apis"
+	providerv1beta1 "github.com/rossigee/provider-hostinger/apis/v1beta1"
+	"github.com/rossigee/provider-hostinger/internal/clients"
+	backupclient "github.com/rossigee/provider-hostinger/internal/clients/backup"
+)
+
+func main() {
+	var (
+		app            = kingpin.New(filepath.Base(os.Args[0]), "Generate observe-only Backup resources for existing Hostinger VPS backups.").DefaultEnvars()
+		namespace      = app.Flag("namespace", "Namespace of the ProviderConfig and the generated Backups.").Short('n').Default("default").String()
+		providerConfig = app.Flag("provider-config", "Name of the ProviderConfig whose credentials are used.").Short('p').Default("default").String()
+		instanceIDs    = app.Arg("instance-id", "IDs of the VPS instances whose backups are imported.").Required().Strings()
+	)
+	kingpin.MustParse(app.Parse(os.Args[1:]))
+
+	ctx := context.Background()
+
+	scheme := runtime.NewScheme()
+	kingpin.FatalIfError(clientgoscheme.AddToScheme(scheme), "Cannot add Kubernetes APIs to scheme")
+	kingpin.FatalIfError(apis.AddToScheme(scheme), "Cannot add Hostinger APIs to scheme")
+
+	cfg, err := ctrl.GetConfig()
+	kingpin.FatalIfError(err, "Cannot get API server rest config")
+	kube, err := client.New(cfg, client.Options{Scheme: scheme})
+	kingpin.FatalIfError(err, "Cannot create Kubernetes client")
+
+	pc := &providerv1beta1.ProviderConfig{}
+	kingpin.FatalIfError(kube.Get(ctx, client.ObjectKey{Namespace: *namespace, Name: *providerConfig}, pc), "Cannot get ProviderConfig")
+	hc, err := clients.NewClientFactory(kube, clients.DefaultHTTPClientConfig()).CreateHostingerClient(ctx, pc)
+	kingpin.FatalIfError(err, "Cannot create Hostinger client")
+	bc := backupclient.NewBackupClient(hc)
+
+	for _, instanceID := range *instanceIDs {
+		backups, err := bc.List(ctx, instanceID)
+		kingpin.FatalIfError(err, "Cannot list backups of instance %s", instanceID)
+		for _, cr := range backupclient.ObserveOnly(*namespace, *providerConfig, instanceID, backups) {
+			out, err := yaml.Marshal(cr)
+			kingpin.FatalIfError(err, "Cannot marshal Backup %s", cr.GetName())
+			fmt.Printf("---\n%s", out)
+		}
+	}
+}
@@ -0,0 +1,56 @@
+/*
+Copyright 2025 Ross Golder.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clients
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+// Action states reported by the Hostinger VPS actions API
+const (
+	ActionStateInitiated = "initiated"
+	ActionStateSent      = "sent"
+	ActionStateDelayed   = "delayed"
+	ActionStateSuccess   = "success"
+	ActionStateError     = "error"
+)
+
+// Action is an asynchronous operation started against a VPS instance
+type Action struct {
+	ID        int64  `json:"id"`
+	Name      string `json:"name"`
+	State     string `json:"state"`
+	CreatedAt string `json:"created_at"`
+	UpdatedAt string `json:"updated_at"`
+}
+
+// IsDone returns true once the action has either succeeded or failed
+func (a *Action) IsDone() bool {
+	return a.State == ActionStateSuccess || a.State == ActionStateError
+}
+
+// GetAction retrieves the current state of an action on a VPS instance
+func (hc *HostingerClient) GetAction(ctx context.Context, instanceID string, actionID int64) (*Action, error) {
+	action := &Action{}
+	path := fmt.Sprintf("/vps/virtual-machines/%s/actions/%d", instanceID, actionID)
+	if err := hc.DoJSON(ctx, http.MethodGet, path, nil, action); err != nil {
+		return nil, err
+	}
+	return action, nil
+}
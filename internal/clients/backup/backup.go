@@ -0,0 +1,183 @@
+/*
+Copyright 2025 Ross Golder.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backup
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/rossigee/provider-hostinger/internal/clients"
+	"github.com/rossigee/provider-hostinger/internal/clients/billing"
+)
+
+// Backup represents a backup of a Hostinger VPS instance
+type Backup struct {
+	ID        string
+	Location  string
+	CreatedAt *string
+}
+
+// backup is the VPS backup as returned by the Hostinger API
+type backup struct {
+	ID        int     `json:"id"`
+	Location  string  `json:"location"`
+	CreatedAt *string `json:"created_at"`
+}
+
+// backupPage is a page of backups returned by the Hostinger API
+type backupPage struct {
+	Data []backup `json:"data"`
+	Meta struct {
+		CurrentPage int `json:"current_page"`
+		LastPage    int `json:"last_page"`
+	} `json:"meta"`
+}
+
+// virtualMachine is the subset of the VPS instance needed to find its plan
+type virtualMachine struct {
+	Plan string `json:"plan"`
+}
+
+// Client defines operations for managing Hostinger VPS backups
+type Client interface {
+	// List returns all backups of an instance
+	List(ctx context.Context, instanceID string) ([]*Backup, error)
+
+	// Create starts a backup of an instance
+	Create(ctx context.Context, instanceID string) (*clients.Action, error)
+
+	// Delete deletes a backup of an instance
+	Delete(ctx context.Context, instanceID, backupID string) error
+
+	// Limit returns the number of backups the plan of an instance allows,
+	// or zero if the plan does not advertise a limit
+	Limit(ctx context.Context, instanceID string) (int32, error)
+
+	// Restore restores a backup onto its instance
+	Restore(ctx context.Context, instanceID, backupID string) (*clients.Action, error)
+
+	// GetAction retrieves the state of an action started on an instance
+	GetAction(ctx context.Context, instanceID string, actionID int64) (*clients.Action, error)
+}
+
+// BackupClient implements the Client interface
+type BackupClient struct {
+	hostingerClient *clients.HostingerClient
+}
+
+// NewBackupClient creates a new backup client
+func NewBackupClient(hostingerClient *clients.HostingerClient) *BackupClient {
+	return &BackupClient{
+		hostingerClient: hostingerClient,
+	}
+}
+
+// List returns all backups of an instance, following pagination
+func (bc *BackupClient) List(ctx context.Context, instanceID string) ([]*Backup, error) {
+	backups := []*Backup{}
+	for page := 1; ; page++ {
+		resp := &backupPage{}
+		path := fmt.Sprintf("%s?page=%d", backupsPath(instanceID), page)
+		if err := bc.hostingerClient.DoJSON(ctx, http.MethodGet, path, nil, resp); err != nil {
+			return nil, err
+		}
+		for i := range resp.Data {
+			backups = append(backups, toBackup(&resp.Data[i]))
+		}
+		if resp.Meta.LastPage <= page {
+			return backups, nil
+		}
+	}
+}
+
+// Create starts a backup of an instance
+func (bc *BackupClient) Create(ctx context.Context, instanceID string) (*clients.Action, error) {
+	action := &clients.Action{}
+	if err := bc.hostingerClient.DoJSON(ctx, http.MethodPost, backupsPath(instanceID), nil, action); err != nil {
+		return nil, err
+	}
+	return action, nil
+}
+
+// Delete deletes a backup of an instance
+func (bc *BackupClient) Delete(ctx context.Context, instanceID, backupID string) error {
+	return bc.hostingerClient.DoJSON(ctx, http.MethodDelete, backupsPath(instanceID)+"/"+backupID, nil, nil)
+}
+
+// Limit looks up the plan of an instance in the VPS catalog and returns the
+// number of backups it allows, or zero if the plan does not advertise one
+func (bc *BackupClient) Limit(ctx context.Context, instanceID string) (int32, error) {
+	vm := &virtualMachine{}
+	if err := bc.hostingerClient.DoJSON(ctx, http.MethodGet, "/vps/virtual-machines/"+instanceID, nil, vm); err != nil {
+		return 0, err
+	}
+	items, err := billing.NewBillingClient(bc.hostingerClient).ListCatalog(ctx, billing.CategoryVPS)
+	if err != nil {
+		return 0, fmt.Errorf("failed to list VPS plans: %w", err)
+	}
+	for _, item := range items {
+		if strings.EqualFold(item.Name, vm.Plan) {
+			return item.MetadataInt("backups"), nil
+		}
+	}
+	return 0, nil
+}
+
+// Restore restores a backup onto its instance
+func (bc *BackupClient) Restore(ctx context.Context, instanceID, backupID string) (*clients.Action, error) {
+	action := &clients.Action{}
+	path := backupsPath(instanceID) + "/" + backupID + "/restore"
+	if err := bc.hostingerClient.DoJSON(ctx, http.MethodPost, path, nil, action); err != nil {
+		return nil, err
+	}
+	return action, nil
+}
+
+// Created returns when the backup was created, or the zero time if unknown
+func (b *Backup) Created() time.Time {
+	if b.CreatedAt == nil {
+		return time.Time{}
+	}
+	t, err := time.Parse(time.RFC3339, *b.CreatedAt)
+	if err != nil {
+		return time.Time{}
+	}
+	return t
+}
+
+// GetAction retrieves the state of an action started on an instance
+func (bc *BackupClient) GetAction(ctx context.Context, instanceID string, actionID int64) (*clients.Action, error) {
+	return bc.hostingerClient.GetAction(ctx, instanceID, actionID)
+}
+
+// backupsPath returns the API path of the backups of an instance
+func backupsPath(instanceID string) string {
+	return "/vps/virtual-machines/" + instanceID + "/backups"
+}
+
+// toBackup maps the API representation of a backup to a Backup
+func toBackup(b *backup) *Backup {
+	return &Backup{
+		ID:        strconv.Itoa(b.ID),
+		Location:  b.Location,
+		CreatedAt: b.CreatedAt,
+	}
+}
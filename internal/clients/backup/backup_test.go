@@ -0,0 +1,130 @@
+/*
+Copyright 2025 Ross Golder.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backup
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/rossigee/provider-hostinger/internal/clients"
+	"github.com/rossigee/provider-hostinger/internal/clients/auth"
+)
+
+func newTestClient(server *httptest.Server) *BackupClient {
+	cfg := clients.DefaultHTTPClientConfig()
+	cfg.MaxRetries = 0
+	return NewBackupClient(clients.NewHostingerClient(auth.NewV1KeyAuth("key", "customer", server.URL), cfg))
+}
+
+func TestList(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/vps/virtual-machines/123/backups" {
+			t.Errorf("unexpected path %s", r.URL.Path)
+		}
+		page := r.URL.Query().Get("page")
+		_, _ = fmt.Fprintf(w, `{
+			"data": [{"id": %s0, "location": "nl-srv-1", "created_at": "2025-02-2%sT00:00:00Z"}],
+			"meta": {"current_page": %s, "last_page": 2}
+		}`, page, page, page)
+	}))
+	defer server.Close()
+
+	backups, err := newTestClient(server).List(context.Background(), "123")
+	if err != nil {
+		t.Fatalf("List() error = %v", err)
+	}
+	if len(backups) != 2 || backups[0].ID != "10" || backups[1].ID != "20" {
+		t.Errorf("List() = %+v, want backups from both pages", backups)
+	}
+}
+
+func TestRestore(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/vps/virtual-machines/123/backups/10/restore" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		_, _ = w.Write([]byte(`{"id": 8123, "name": "backup_restore", "state": "initiated"}`))
+	}))
+	defer server.Close()
+
+	action, err := newTestClient(server).Restore(context.Background(), "123", "10")
+	if err != nil {
+		t.Fatalf("Restore() error = %v", err)
+	}
+	if action.ID != 8123 {
+		t.Errorf("Restore() action ID = %d, want 8123", action.ID)
+	}
+}
+
+func TestCreate(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/vps/virtual-machines/123/backups" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		_, _ = w.Write([]byte(`{"id": 8124, "name": "backup_create", "state": "initiated"}`))
+	}))
+	defer server.Close()
+
+	action, err := newTestClient(server).Create(context.Background(), "123")
+	if err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+	if action.ID != 8124 {
+		t.Errorf("Create() action ID = %d, want 8124", action.ID)
+	}
+}
+
+func TestDelete(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodDelete || r.URL.Path != "/vps/virtual-machines/123/backups/10" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	if err := newTestClient(server).Delete(context.Background(), "123", "10"); err != nil {
+		t.Fatalf("Delete() error = %v", err)
+	}
+}
+
+func TestLimit(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/vps/virtual-machines/123":
+			_, _ = w.Write([]byte(`{"id": 123, "plan": "KVM 2"}`))
+		case "/billing/catalog":
+			_, _ = w.Write([]byte(`[
+				{"id": "hostingercom-vps-kvm1", "name": "KVM 1", "metadata": {"backups": 1}},
+				{"id": "hostingercom-vps-kvm2", "name": "KVM 2", "metadata": {"backups": 4}}
+			]`))
+		default:
+			t.Errorf("unexpected path %s", r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	limit, err := newTestClient(server).Limit(context.Background(), "123")
+	if err != nil {
+		t.Fatalf("Limit() error = %v", err)
+	}
+	if limit != 4 {
+		t.Errorf("Limit() = %d, want 4", limit)
+	}
+}
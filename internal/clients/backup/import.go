@@ -0,0 +1,52 @@
+/*
+Copyright 2025 Ross Golder.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backup
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+
+	"github.com/rossigee/provider-hostinger/apis/backup/v1beta1"
+)
+
+// ObserveOnly returns an observe-only Backup managed resource for each of
+// the given backups of an instance, so that existing backups can be imported
+// in bulk. Each resource is named after the instance and backup IDs and uses
+// the given ProviderConfig.
+func ObserveOnly(namespace, providerConfig, instanceID string, backups []*Backup) []*v1beta1.Backup {
+	resources := make([]*v1beta1.Backup, 0, len(backups))
+	for _, b := range backups {
+		cr := &v1beta1.Backup{
+			TypeMeta: metav1.TypeMeta{
+				APIVersion: v1beta1.SchemeGroupVersion.String(),
+				Kind:       v1beta1.BackupKind,
+			},
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "vps-" + instanceID + "-backup-" + b.ID,
+				Namespace: namespace,
+			},
+		}
+		meta.SetExternalName(cr, b.ID)
+		cr.Spec.ProviderConfigReference = &xpv1.Reference{Name: providerConfig}
+		cr.Spec.ManagementPolicies = xpv1.ManagementPolicies{xpv1.ManagementActionObserve}
+		cr.Spec.ForProvider.InstanceID = instanceID
+		resources = append(resources, cr)
+	}
+	return resources
+}
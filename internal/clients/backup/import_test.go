@@ -0,0 +1,47 @@
+/*
+Copyright 2025 Ross Golder.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backup
+
+import (
+	"testing"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+)
+
+func TestObserveOnly(t *testing.T) {
+	backups := []*Backup{{ID: "10"}, {ID: "20"}}
+
+	resources := ObserveOnly("default", "hostinger", "123", backups)
+	if len(resources) != 2 {
+		t.Fatalf("ObserveOnly() returned %d resources, want 2", len(resources))
+	}
+
+	cr := resources[1]
+	if cr.GetName() != "vps-123-backup-20" || cr.GetNamespace() != "default" {
+		t.Errorf("ObserveOnly() name = %s/%s, want default/vps-123-backup-20", cr.GetNamespace(), cr.GetName())
+	}
+	if meta.GetExternalName(cr) != "20" {
+		t.Errorf("external name = %q, want 20", meta.GetExternalName(cr))
+	}
+	if len(cr.Spec.ManagementPolicies) != 1 || cr.Spec.ManagementPolicies[0] != xpv1.ManagementActionObserve {
+		t.Errorf("managementPolicies = %v, want [Observe]", cr.Spec.ManagementPolicies)
+	}
+	if cr.Spec.ProviderConfigReference.Name != "hostinger" || cr.Spec.ForProvider.InstanceID != "123" {
+		t.Errorf("spec = %+v, want provider config and instance set", cr.Spec)
+	}
+}
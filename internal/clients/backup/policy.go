@@ -0,0 +1,121 @@
+/*
+Copyright 2025 Ross Golder.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backup
+
+import (
+	"sort"
+	"time"
+
+	"github.com/rossigee/provider-hostinger/apis/backup/v1beta1"
+	"github.com/rossigee/provider-hostinger/internal/clients"
+)
+
+// Retention describes which backups of an instance a policy keeps
+type Retention struct {
+	// KeepLast is the number of most recent backups to keep, or zero to
+	// keep any number
+	KeepLast int32
+	// KeepDays is the age in days beyond which backups are pruned, or zero
+	// to keep backups of any age. The newest backup is exempt.
+	KeepDays int32
+	// Limit is the number of backups the plan allows, or zero if unknown
+	Limit int32
+}
+
+// NextRun returns when the next backup is due given the newest existing
+// backup. It returns false for manual schedules. With no backups, a backup
+// is due now.
+func NextRun(schedule v1beta1.BackupScheduleType, last, now time.Time) (time.Time, bool) {
+	if last.IsZero() {
+		return now, schedule != v1beta1.BackupScheduleManual
+	}
+	switch schedule {
+	case v1beta1.BackupScheduleDaily:
+		return last.AddDate(0, 0, 1), true
+	case v1beta1.BackupScheduleWeekly:
+		return last.AddDate(0, 0, 7), true
+	case v1beta1.BackupScheduleMonthly:
+		return last.AddDate(0, 1, 0), true
+	}
+	return time.Time{}, false
+}
+
+// Newest returns the most recently created backup, or nil if there are none
+func Newest(backups []*Backup) *Backup {
+	var newest *Backup
+	for _, b := range backups {
+		if newest == nil || b.Created().After(newest.Created()) {
+			newest = b
+		}
+	}
+	return newest
+}
+
+// CreatedBy returns the backup made by a finished backup action: the newest
+// backup created after the action started that is not in known. It returns
+// nil if the backup is not listed yet.
+func CreatedBy(backups []*Backup, action *clients.Action, known map[string]bool) *Backup {
+	var started time.Time
+	if t := clients.ParseTime(&action.CreatedAt); t != nil {
+		started = t.Time
+	}
+
+	var made *Backup
+	for _, b := range backups {
+		if known[b.ID] || b.Created().Before(started) {
+			continue
+		}
+		if made == nil || b.Created().After(made.Created()) {
+			made = b
+		}
+	}
+	return made
+}
+
+// Prune returns the backups the retention does not keep, oldest first. When
+// reserve is true one slot below the plan limit is freed for a new backup.
+func Prune(backups []*Backup, r Retention, reserve bool, now time.Time) []*Backup {
+	sorted := make([]*Backup, len(backups))
+	copy(sorted, backups)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].Created().After(sorted[j].Created())
+	})
+
+	keep := len(sorted)
+	if r.KeepLast > 0 && int(r.KeepLast) < keep {
+		keep = int(r.KeepLast)
+	}
+	if r.Limit > 0 {
+		allowed := int(r.Limit)
+		if reserve {
+			allowed--
+		}
+		if allowed < keep {
+			keep = allowed
+		}
+	}
+
+	maxAge := time.Duration(r.KeepDays) * 24 * time.Hour
+	pruned := []*Backup{}
+	for i := len(sorted) - 1; i >= 0; i-- {
+		expired := r.KeepDays > 0 && i > 0 && now.Sub(sorted[i].Created()) > maxAge
+		if i >= keep || expired {
+			pruned = append(pruned, sorted[i])
+		}
+	}
+	return pruned
+}
@@ -0,0 +1,131 @@
+/*
+Copyright 2025 Ross Golder.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backup
+
+import (
+	"testing"
+	"time"
+
+	"github.com/rossigee/provider-hostinger/apis/backup/v1beta1"
+	"github.com/rossigee/provider-hostinger/internal/clients"
+)
+
+func backupAt(id string, t time.Time) *Backup {
+	created := t.Format(time.RFC3339)
+	return &Backup{ID: id, CreatedAt: &created}
+}
+
+func TestNextRun(t *testing.T) {
+	now := time.Date(2025, 3, 10, 12, 0, 0, 0, time.UTC)
+	last := time.Date(2025, 3, 9, 12, 0, 0, 0, time.UTC)
+
+	cases := map[string]struct {
+		schedule v1beta1.BackupScheduleType
+		last     time.Time
+		want     time.Time
+		wantOK   bool
+	}{
+		"Daily":         {schedule: v1beta1.BackupScheduleDaily, last: last, want: last.AddDate(0, 0, 1), wantOK: true},
+		"Weekly":        {schedule: v1beta1.BackupScheduleWeekly, last: last, want: last.AddDate(0, 0, 7), wantOK: true},
+		"Monthly":       {schedule: v1beta1.BackupScheduleMonthly, last: last, want: last.AddDate(0, 1, 0), wantOK: true},
+		"NoBackups":     {schedule: v1beta1.BackupScheduleWeekly, want: now, wantOK: true},
+		"Manual":        {schedule: v1beta1.BackupScheduleManual, last: last},
+		"ManualNoneYet": {schedule: v1beta1.BackupScheduleManual, want: now},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, ok := NextRun(tc.schedule, tc.last, now)
+			if ok != tc.wantOK {
+				t.Fatalf("NextRun() ok = %v, want %v", ok, tc.wantOK)
+			}
+			if ok && !got.Equal(tc.want) {
+				t.Errorf("NextRun() = %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestPrune(t *testing.T) {
+	now := time.Date(2025, 3, 10, 12, 0, 0, 0, time.UTC)
+	backups := []*Backup{
+		backupAt("1", now.AddDate(0, 0, -20)),
+		backupAt("3", now.AddDate(0, 0, -1)),
+		backupAt("2", now.AddDate(0, 0, -10)),
+	}
+
+	cases := map[string]struct {
+		backups   []*Backup
+		retention Retention
+		reserve   bool
+		want      []string
+	}{
+		"KeepAll":        {backups: backups, want: []string{}},
+		"KeepLast":       {backups: backups, retention: Retention{KeepLast: 1}, want: []string{"1", "2"}},
+		"KeepDays":       {backups: backups, retention: Retention{KeepDays: 14}, want: []string{"1"}},
+		"KeepDaysNewest": {backups: backups[:1], retention: Retention{KeepDays: 14}, want: []string{}},
+		"Limit":          {backups: backups, retention: Retention{Limit: 2}, want: []string{"1"}},
+		"LimitReserve":   {backups: backups, retention: Retention{Limit: 2}, reserve: true, want: []string{"1", "2"}},
+		"Combined":       {backups: backups, retention: Retention{KeepLast: 5, KeepDays: 5, Limit: 3}, reserve: true, want: []string{"1", "2"}},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			pruned := Prune(tc.backups, tc.retention, tc.reserve, now)
+			got := make([]string, 0, len(pruned))
+			for _, b := range pruned {
+				got = append(got, b.ID)
+			}
+			if len(got) != len(tc.want) {
+				t.Fatalf("Prune() = %v, want %v", got, tc.want)
+			}
+			for i := range got {
+				if got[i] != tc.want[i] {
+					t.Errorf("Prune() = %v, want %v", got, tc.want)
+				}
+			}
+		})
+	}
+}
+
+func TestCreatedBy(t *testing.T) {
+	now := time.Date(2025, 3, 10, 12, 0, 0, 0, time.UTC)
+	backups := []*Backup{
+		backupAt("1", now.AddDate(0, 0, -1)),
+		backupAt("2", now.Add(-10*time.Minute)),
+		backupAt("3", now.Add(-5*time.Minute)),
+	}
+	action := &clients.Action{ID: 1, State: clients.ActionStateSuccess, CreatedAt: now.Add(-time.Hour).Format(time.RFC3339)}
+
+	cases := map[string]struct {
+		known map[string]bool
+		want  string
+	}{
+		"Newest":     {want: "3"},
+		"SkipsKnown": {known: map[string]bool{"3": true}, want: "2"},
+		"NotListed":  {known: map[string]bool{"2": true, "3": true}},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := ""
+			if b := CreatedBy(backups, action, tc.known); b != nil {
+				got = b.ID
+			}
+			if got != tc.want {
+				t.Errorf("CreatedBy() = %q, want %q", got, tc.want)
+			}
+		})
+	}
+}
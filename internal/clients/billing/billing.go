@@ -0,0 +1,116 @@
+/*
+Copyright 2025 Ross Golder.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package billing
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/rossigee/provider-hostinger/internal/clients"
+)
+
+// Catalog categories understood by the Hostinger billing API
+const (
+	CategoryVPS    = "VPS"
+	CategoryDomain = "DOMAIN"
+)
+
+// CatalogItem is a purchasable product from the Hostinger billing catalog
+type CatalogItem struct {
+	ID       string                 `json:"id"`
+	Name     string                 `json:"name"`
+	Category string                 `json:"category"`
+	Metadata map[string]interface{} `json:"metadata"`
+	Prices   []Price                `json:"prices"`
+}
+
+// Price is a billing period option for a catalog item. Amounts are in cents.
+type Price struct {
+	ID               string `json:"id"`
+	Name             string `json:"name"`
+	Currency         string `json:"currency"`
+	Price            int64  `json:"price"`
+	FirstPeriodPrice int64  `json:"first_period_price"`
+	Period           int32  `json:"period"`
+	PeriodUnit       string `json:"period_unit"`
+}
+
+// Subscription is a billing subscription of the Hostinger account
+type Subscription struct {
+	ID            string  `json:"id"`
+	Name          string  `json:"name"`
+	Status        string  `json:"status"`
+	IsAutoRenewed bool    `json:"is_auto_renewed"`
+	ExpiresAt     *string `json:"expires_at"`
+}
+
+// MetadataInt returns a numeric metadata value, or zero if it is missing
+func (i *CatalogItem) MetadataInt(key string) int32 {
+	switch v := i.Metadata[key].(type) {
+	case float64:
+		return int32(v)
+	case string:
+		n, err := strconv.ParseInt(v, 10, 32)
+		if err != nil {
+			return 0
+		}
+		return int32(n)
+	}
+	return 0
+}
+
+// Client defines operations against the Hostinger billing API
+type Client interface {
+	// ListCatalog returns the catalog items in a category
+	ListCatalog(ctx context.Context, category string) ([]*CatalogItem, error)
+
+	// ListSubscriptions returns the subscriptions of the account
+	ListSubscriptions(ctx context.Context) ([]*Subscription, error)
+}
+
+// BillingClient implements the Client interface
+type BillingClient struct {
+	hostingerClient *clients.HostingerClient
+}
+
+// NewBillingClient creates a new billing client
+func NewBillingClient(hostingerClient *clients.HostingerClient) *BillingClient {
+	return &BillingClient{
+		hostingerClient: hostingerClient,
+	}
+}
+
+// ListCatalog returns the catalog items in a category
+func (bc *BillingClient) ListCatalog(ctx context.Context, category string) ([]*CatalogItem, error) {
+	items := []*CatalogItem{}
+	path := "/billing/catalog?category=" + url.QueryEscape(category)
+	if err := bc.hostingerClient.DoJSON(ctx, http.MethodGet, path, nil, &items); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+// ListSubscriptions returns the subscriptions of the account
+func (bc *BillingClient) ListSubscriptions(ctx context.Context) ([]*Subscription, error) {
+	subscriptions := []*Subscription{}
+	if err := bc.hostingerClient.DoJSON(ctx, http.MethodGet, "/billing/subscriptions", nil, &subscriptions); err != nil {
+		return nil, err
+	}
+	return subscriptions, nil
+}
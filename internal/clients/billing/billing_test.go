@@ -0,0 +1,106 @@
+/*
+Copyright 2025 Ross Golder.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package billing
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/rossigee/provider-hostinger/internal/clients"
+	"github.com/rossigee/provider-hostinger/internal/clients/auth"
+)
+
+func TestListCatalog(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/billing/catalog" || r.URL.Query().Get("category") != CategoryVPS {
+			t.Errorf("unexpected request %s", r.URL.String())
+		}
+		_, _ = w.Write([]byte(`[{
+			"id": "hostingercom-vps-kvm2",
+			"name": "KVM 2",
+			"category": "VPS",
+			"metadata": {"cpus": "2", "memory": 8192},
+			"prices": [{"id": "hostingercom-vps-kvm2-usd-1m", "currency": "USD", "price": 1299, "period": 1, "period_unit": "month"}]
+		}]`))
+	}))
+	defer server.Close()
+
+	cfg := clients.DefaultHTTPClientConfig()
+	cfg.MaxRetries = 0
+	client := NewBillingClient(clients.NewHostingerClient(auth.NewV1KeyAuth("key", "customer", server.URL), cfg))
+
+	items, err := client.ListCatalog(context.Background(), CategoryVPS)
+	if err != nil {
+		t.Fatalf("ListCatalog() error = %v", err)
+	}
+	if len(items) != 1 {
+		t.Fatalf("ListCatalog() returned %d items, want 1", len(items))
+	}
+	if items[0].Name != "KVM 2" || len(items[0].Prices) != 1 {
+		t.Errorf("ListCatalog() = %+v", items[0])
+	}
+	if items[0].Prices[0].Price != 1299 {
+		t.Errorf("Price = %v, want 1299", items[0].Prices[0].Price)
+	}
+}
+
+func TestListSubscriptions(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/billing/subscriptions" {
+			t.Errorf("unexpected request %s", r.URL.String())
+		}
+		_, _ = w.Write([]byte(`[{"id": "Azz353Uhl1xC54pR0", "name": "example.com", "status": "active", "is_auto_renewed": true, "expires_at": "2026-03-01T00:00:00Z"}]`))
+	}))
+	defer server.Close()
+
+	cfg := clients.DefaultHTTPClientConfig()
+	cfg.MaxRetries = 0
+	client := NewBillingClient(clients.NewHostingerClient(auth.NewV1KeyAuth("key", "customer", server.URL), cfg))
+
+	subscriptions, err := client.ListSubscriptions(context.Background())
+	if err != nil {
+		t.Fatalf("ListSubscriptions() error = %v", err)
+	}
+	if len(subscriptions) != 1 || subscriptions[0].Name != "example.com" || !subscriptions[0].IsAutoRenewed {
+		t.Errorf("ListSubscriptions() = %+v, want one auto-renewed subscription", subscriptions)
+	}
+}
+
+func TestMetadataInt(t *testing.T) {
+	item := &CatalogItem{
+		Metadata: map[string]interface{}{
+			"cpus":   "4",
+			"memory": float64(16384),
+			"bogus":  "lots",
+		},
+	}
+
+	if got := item.MetadataInt("cpus"); got != 4 {
+		t.Errorf("MetadataInt(cpus) = %v, want 4", got)
+	}
+	if got := item.MetadataInt("memory"); got != 16384 {
+		t.Errorf("MetadataInt(memory) = %v, want 16384", got)
+	}
+	if got := item.MetadataInt("bogus"); got != 0 {
+		t.Errorf("MetadataInt(bogus) = %v, want 0", got)
+	}
+	if got := item.MetadataInt("missing"); got != 0 {
+		t.Errorf("MetadataInt(missing) = %v, want 0", got)
+	}
+}
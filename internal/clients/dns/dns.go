@@ -0,0 +1,175 @@
+/*
+Copyright 2025 Ross Golder.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dns
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+
+	"github.com/rossigee/provider-hostinger/internal/clients"
+)
+
+// DefaultTTL is the TTL Hostinger gives new record sets
+const DefaultTTL = 14400
+
+// RecordSet is the set of records sharing a name and type in a DNS zone
+type RecordSet struct {
+	// Name is relative to the zone, with "@" for the zone apex
+	Name   string
+	Type   string
+	TTL    int32
+	Values []string
+	// Disabled are the values that are switched off in hPanel. They are
+	// not served, so they are left out of Values, but they are written back
+	// with the record set so that they are not lost.
+	Disabled []string
+}
+
+// record is a single record value as used by the Hostinger API
+type record struct {
+	Content    string `json:"content"`
+	IsDisabled bool   `json:"is_disabled,omitempty"`
+}
+
+// recordSet is a record set as used by the Hostinger API
+type recordSet struct {
+	Name    string   `json:"name"`
+	Records []record `json:"records"`
+	TTL     int32    `json:"ttl"`
+	Type    string   `json:"type"`
+}
+
+// updateRequest is the payload for updating a DNS zone
+type updateRequest struct {
+	Overwrite bool        `json:"overwrite"`
+	Zone      []recordSet `json:"zone"`
+}
+
+// Filter selects the record sets to delete from a DNS zone
+type Filter struct {
+	Name string `json:"name"`
+	Type string `json:"type"`
+}
+
+// deleteRequest is the payload for deleting records from a DNS zone
+type deleteRequest struct {
+	Filters []Filter `json:"filters"`
+}
+
+// resetRequest is the payload for resetting a DNS zone to the defaults
+type resetRequest struct {
+	Sync                   bool     `json:"sync"`
+	ResetEmailRecords      bool     `json:"reset_email_records"`
+	WhitelistedRecordTypes []string `json:"whitelisted_record_types,omitempty"`
+}
+
+// Client defines operations for managing Hostinger DNS zones
+type Client interface {
+	// GetZone returns the record sets of a DNS zone
+	GetZone(ctx context.Context, domain string) ([]RecordSet, error)
+
+	// UpdateZone writes record sets to a DNS zone. With overwrite, each
+	// given record set replaces the records of the same name and type,
+	// including disabled ones; without it, the values are appended. Other
+	// record sets are untouched.
+	UpdateZone(ctx context.Context, domain string, overwrite bool, sets []RecordSet) error
+
+	// DeleteRecords deletes the record sets matching the filters
+	DeleteRecords(ctx context.Context, domain string, filters []Filter) error
+
+	// ResetZone resets a DNS zone to the Hostinger defaults, keeping the
+	// records of the given types
+	ResetZone(ctx context.Context, domain string, resetEmailRecords bool, keepTypes []string) error
+
+	// ListSnapshots returns the snapshots of a DNS zone, newest first
+	ListSnapshots(ctx context.Context, domain string) ([]*Snapshot, error)
+
+	// RestoreSnapshot restores a DNS zone to one of its snapshots
+	RestoreSnapshot(ctx context.Context, domain, snapshotID string) error
+}
+
+// DNSClient implements the Client interface
+type DNSClient struct {
+	hostingerClient *clients.HostingerClient
+}
+
+// NewDNSClient creates a new DNS client
+func NewDNSClient(hostingerClient *clients.HostingerClient) *DNSClient {
+	return &DNSClient{
+		hostingerClient: hostingerClient,
+	}
+}
+
+// GetZone returns the record sets of a DNS zone. Disabled records are
+// returned apart from the other values.
+func (dc *DNSClient) GetZone(ctx context.Context, domain string) ([]RecordSet, error) {
+	var resp []recordSet
+	if err := dc.hostingerClient.DoJSON(ctx, http.MethodGet, zonePath(domain), nil, &resp); err != nil {
+		return nil, err
+	}
+	sets := make([]RecordSet, 0, len(resp))
+	for _, rs := range resp {
+		set := RecordSet{
+			Name: NormalizeName(domain, rs.Name),
+			Type: rs.Type,
+			TTL:  rs.TTL,
+		}
+		for _, r := range rs.Records {
+			if r.IsDisabled {
+				set.Disabled = append(set.Disabled, r.Content)
+			} else {
+				set.Values = append(set.Values, r.Content)
+			}
+		}
+		sets = append(sets, set)
+	}
+	return sets, nil
+}
+
+// UpdateZone writes record sets to a DNS zone
+func (dc *DNSClient) UpdateZone(ctx context.Context, domain string, overwrite bool, sets []RecordSet) error {
+	req := &updateRequest{Overwrite: overwrite, Zone: make([]recordSet, 0, len(sets))}
+	for _, set := range sets {
+		rs := recordSet{Name: set.Name, Type: set.Type, TTL: set.TTL, Records: make([]record, 0, len(set.Values))}
+		for _, v := range set.Values {
+			rs.Records = append(rs.Records, record{Content: v})
+		}
+		for _, v := range set.Disabled {
+			rs.Records = append(rs.Records, record{Content: v, IsDisabled: true})
+		}
+		req.Zone = append(req.Zone, rs)
+	}
+	return dc.hostingerClient.DoJSON(ctx, http.MethodPut, zonePath(domain), req, nil)
+}
+
+// DeleteRecords deletes the record sets matching the filters
+func (dc *DNSClient) DeleteRecords(ctx context.Context, domain string, filters []Filter) error {
+	return dc.hostingerClient.DoJSON(ctx, http.MethodDelete, zonePath(domain), &deleteRequest{Filters: filters}, nil)
+}
+
+// ResetZone resets a DNS zone to the Hostinger defaults. The reset is
+// synchronous, so the zone can be read back straight away.
+func (dc *DNSClient) ResetZone(ctx context.Context, domain string, resetEmailRecords bool, keepTypes []string) error {
+	req := &resetRequest{Sync: true, ResetEmailRecords: resetEmailRecords, WhitelistedRecordTypes: keepTypes}
+	return dc.hostingerClient.DoJSON(ctx, http.MethodPost, zonePath(domain)+"/reset", req, nil)
+}
+
+// zonePath returns the API path of a DNS zone
+func zonePath(domain string) string {
+	return "/dns/zones/" + url.PathEscape(domain)
+}
@@ -0,0 +1,177 @@
+/*
+Copyright 2025 Ross Golder.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dns
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/rossigee/provider-hostinger/internal/clients"
+	"github.com/rossigee/provider-hostinger/internal/clients/auth"
+)
+
+func newTestClient(server *httptest.Server) *DNSClient {
+	cfg := clients.DefaultHTTPClientConfig()
+	cfg.MaxRetries = 0
+	return NewDNSClient(clients.NewHostingerClient(auth.NewV1KeyAuth("key", "customer", server.URL), cfg))
+}
+
+func TestGetZone(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet || r.URL.Path != "/dns/zones/example.com" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		_, _ = w.Write([]byte(`[
+			{"name": "@", "type": "A", "ttl": 300, "records": [{"content": "192.0.2.1", "is_disabled": false}, {"content": "192.0.2.2", "is_disabled": true}]},
+			{"name": "www.example.com", "type": "CNAME", "ttl": 14400, "records": [{"content": "example.com.", "is_disabled": false}]}
+		]`))
+	}))
+	defer server.Close()
+
+	sets, err := newTestClient(server).GetZone(context.Background(), "example.com")
+	if err != nil {
+		t.Fatalf("GetZone() error = %v", err)
+	}
+	want := []RecordSet{
+		{Name: "@", Type: "A", TTL: 300, Values: []string{"192.0.2.1"}, Disabled: []string{"192.0.2.2"}},
+		{Name: "www", Type: "CNAME", TTL: 14400, Values: []string{"example.com."}},
+	}
+	if !reflect.DeepEqual(sets, want) {
+		t.Errorf("GetZone() = %+v, want %+v", sets, want)
+	}
+}
+
+func TestGetZoneNotFound(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = w.Write([]byte(`{"message": "zone not found"}`))
+	}))
+	defer server.Close()
+
+	if _, err := newTestClient(server).GetZone(context.Background(), "example.com"); !clients.IsNotFound(err) {
+		t.Errorf("GetZone() error = %v, want not found", err)
+	}
+}
+
+func TestUpdateZone(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPut || r.URL.Path != "/dns/zones/example.com" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		req := &updateRequest{}
+		if err := json.NewDecoder(r.Body).Decode(req); err != nil {
+			t.Fatalf("decode request: %v", err)
+		}
+		want := &updateRequest{Overwrite: true, Zone: []recordSet{
+			{Name: "@", Type: "MX", TTL: 3600, Records: []record{{Content: "10 mx1.example.com"}, {Content: "20 mx2.example.com"}, {Content: "30 mx3.example.com", IsDisabled: true}}},
+		}}
+		if !reflect.DeepEqual(req, want) {
+			t.Errorf("request = %+v, want %+v", req, want)
+		}
+		_, _ = w.Write([]byte(`{"message": "Request accepted"}`))
+	}))
+	defer server.Close()
+
+	err := newTestClient(server).UpdateZone(context.Background(), "example.com", true, []RecordSet{
+		{Name: "@", Type: "MX", TTL: 3600, Values: []string{"10 mx1.example.com", "20 mx2.example.com"}, Disabled: []string{"30 mx3.example.com"}},
+	})
+	if err != nil {
+		t.Fatalf("UpdateZone() error = %v", err)
+	}
+}
+
+func TestDeleteRecords(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodDelete || r.URL.Path != "/dns/zones/example.com" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		req := &deleteRequest{}
+		if err := json.NewDecoder(r.Body).Decode(req); err != nil {
+			t.Fatalf("decode request: %v", err)
+		}
+		if want := []Filter{{Name: "www", Type: "A"}}; !reflect.DeepEqual(req.Filters, want) {
+			t.Errorf("filters = %+v, want %+v", req.Filters, want)
+		}
+	}))
+	defer server.Close()
+
+	if err := newTestClient(server).DeleteRecords(context.Background(), "example.com", []Filter{{Name: "www", Type: "A"}}); err != nil {
+		t.Fatalf("DeleteRecords() error = %v", err)
+	}
+}
+
+func TestListSnapshots(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet || r.URL.Path != "/dns/snapshots/example.com" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		_, _ = w.Write([]byte(`[
+			{"id": 1, "reason": "Zone updated", "created_at": "2025-02-01T10:00:00Z"},
+			{"id": 2, "reason": "Zone updated", "created_at": "2025-02-03T10:00:00Z"}
+		]`))
+	}))
+	defer server.Close()
+
+	snapshots, err := newTestClient(server).ListSnapshots(context.Background(), "example.com")
+	if err != nil {
+		t.Fatalf("ListSnapshots() error = %v", err)
+	}
+	if len(snapshots) != 2 || snapshots[0].ID != "2" || snapshots[1].ID != "1" {
+		t.Errorf("ListSnapshots() = %+v, want newest first", snapshots)
+	}
+	if info := GetSnapshotInfo(snapshots[0]); info.CreatedAt == nil || info.CreatedAt.Day() != 3 {
+		t.Errorf("GetSnapshotInfo() = %+v, want the creation time", info)
+	}
+}
+
+func TestRestoreSnapshot(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/dns/snapshots/example.com/42/restore" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	if err := newTestClient(server).RestoreSnapshot(context.Background(), "example.com", "42"); err != nil {
+		t.Fatalf("RestoreSnapshot() error = %v", err)
+	}
+}
+
+func TestResetZone(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/dns/zones/example.com/reset" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		req := &resetRequest{}
+		if err := json.NewDecoder(r.Body).Decode(req); err != nil {
+			t.Fatalf("decode request: %v", err)
+		}
+		want := &resetRequest{Sync: true, ResetEmailRecords: false, WhitelistedRecordTypes: []string{"MX", "TXT"}}
+		if !reflect.DeepEqual(req, want) {
+			t.Errorf("request = %+v, want %+v", req, want)
+		}
+	}))
+	defer server.Close()
+
+	if err := newTestClient(server).ResetZone(context.Background(), "example.com", false, []string{"MX", "TXT"}); err != nil {
+		t.Fatalf("ResetZone() error = %v", err)
+	}
+}
@@ -0,0 +1,182 @@
+/*
+Copyright 2025 Ross Golder.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dns
+
+import (
+	"fmt"
+	"net/netip"
+	"strconv"
+	"strings"
+)
+
+// Apex is the record name of the zone apex
+const Apex = "@"
+
+// NormalizeName returns a record name relative to its zone, accepting
+// relative names, "@", and fully qualified names within the zone
+func NormalizeName(zone, name string) string {
+	zone = strings.TrimSuffix(strings.ToLower(zone), ".")
+	name = strings.TrimSuffix(strings.ToLower(strings.TrimSpace(name)), ".")
+	switch {
+	case name == "" || name == Apex || name == zone:
+		return Apex
+	case strings.HasSuffix(name, "."+zone):
+		return strings.TrimSuffix(name, "."+zone)
+	}
+	return name
+}
+
+// FQDN returns the fully qualified name of a record
+func FQDN(zone, name string) string {
+	zone = strings.TrimSuffix(strings.ToLower(zone), ".")
+	if name = NormalizeName(zone, name); name == Apex {
+		return zone
+	}
+	return name + "." + zone
+}
+
+// FindSet returns the record set with a name and type, or nil
+func FindSet(sets []RecordSet, zone, name, recordType string) *RecordSet {
+	name = NormalizeName(zone, name)
+	for i := range sets {
+		if NormalizeName(zone, sets[i].Name) == name && strings.EqualFold(sets[i].Type, recordType) {
+			return &sets[i]
+		}
+	}
+	return nil
+}
+
+// NormalizeValues validates record values and returns them in canonical
+// form without duplicates, so that equivalent spellings compare equal
+func NormalizeValues(recordType string, values []string) ([]string, error) {
+	out := make([]string, 0, len(values))
+	seen := map[string]bool{}
+	for _, v := range values {
+		n, err := NormalizeValue(recordType, v)
+		if err != nil {
+			return nil, err
+		}
+		if !seen[n] {
+			seen[n] = true
+			out = append(out, n)
+		}
+	}
+	return out, nil
+}
+
+// NormalizeValue validates a record value and returns its canonical form
+func NormalizeValue(recordType, value string) (string, error) {
+	value = strings.TrimSpace(value)
+	n, err := normalizeValue(strings.ToUpper(recordType), value)
+	if err != nil {
+		return "", fmt.Errorf("invalid %s record value %q: %w", recordType, value, err)
+	}
+	return n, nil
+}
+
+func normalizeValue(recordType, value string) (string, error) {
+	switch recordType {
+	case "A":
+		addr, err := netip.ParseAddr(value)
+		if err != nil || !addr.Is4() {
+			return "", fmt.Errorf("not an IPv4 address")
+		}
+		return addr.String(), nil
+	case "AAAA":
+		addr, err := netip.ParseAddr(value)
+		if err != nil || !addr.Is6() || addr.Zone() != "" {
+			return "", fmt.Errorf("not an IPv6 address")
+		}
+		return addr.String(), nil
+	case "CNAME", "NS":
+		return normalizeHost(value)
+	case "MX":
+		f := strings.Fields(value)
+		if len(f) != 2 {
+			return "", fmt.Errorf("want \"priority host\"")
+		}
+		return joinPrefixed(f[:1], f[1])
+	case "SRV":
+		f := strings.Fields(value)
+		if len(f) != 4 {
+			return "", fmt.Errorf("want \"priority weight port target\"")
+		}
+		return joinPrefixed(f[:3], f[3])
+	case "CAA":
+		f := strings.Fields(value)
+		if len(f) < 3 {
+			return "", fmt.Errorf("want \"flags tag value\"")
+		}
+		flags, err := strconv.ParseUint(f[0], 10, 8)
+		if err != nil {
+			return "", fmt.Errorf("flags must be between 0 and 255")
+		}
+		tag := strings.ToLower(f[1])
+		for _, c := range tag {
+			if (c < 'a' || c > 'z') && (c < '0' || c > '9') {
+				return "", fmt.Errorf("tag must be alphanumeric")
+			}
+		}
+		return fmt.Sprintf("%d %s %q", flags, tag, unquote(strings.Join(f[2:], " "))), nil
+	case "TXT":
+		if value == "" {
+			return "", fmt.Errorf("value is empty")
+		}
+		// Long TXT values are returned as several quoted strings
+		return strings.ReplaceAll(unquote(value), `" "`, ""), nil
+	}
+	return "", fmt.Errorf("unsupported record type")
+}
+
+// joinPrefixed validates numeric fields between 0 and 65535 followed by a
+// host name, and joins them with single spaces
+func joinPrefixed(numbers []string, host string) (string, error) {
+	parts := make([]string, 0, len(numbers)+1)
+	for _, n := range numbers {
+		v, err := strconv.ParseUint(n, 10, 16)
+		if err != nil {
+			return "", fmt.Errorf("%q must be a number between 0 and 65535", n)
+		}
+		parts = append(parts, strconv.FormatUint(v, 10))
+	}
+	h, err := normalizeHost(host)
+	if err != nil {
+		return "", err
+	}
+	return strings.Join(append(parts, h), " "), nil
+}
+
+// normalizeHost lowercases a host name and drops its trailing dot. A lone
+// dot, used by SRV and MX records to mean no service, is kept.
+func normalizeHost(host string) (string, error) {
+	if host == "." {
+		return host, nil
+	}
+	h := strings.TrimSuffix(strings.ToLower(host), ".")
+	if h == "" || strings.ContainsAny(h, " \t") {
+		return "", fmt.Errorf("not a host name")
+	}
+	return h, nil
+}
+
+// unquote strips one pair of surrounding double quotes
+func unquote(s string) string {
+	if len(s) >= 2 && strings.HasPrefix(s, `"`) && strings.HasSuffix(s, `"`) {
+		return s[1 : len(s)-1]
+	}
+	return s
+}
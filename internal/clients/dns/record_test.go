@@ -0,0 +1,104 @@
+/*
+Copyright 2025 Ross Golder.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dns
+
+import (
+	"testing"
+)
+
+func TestNormalizeName(t *testing.T) {
+	cases := map[string]string{
+		"":                 "@",
+		"@":                "@",
+		"example.com.":     "@",
+		"WWW":              "www",
+		"www.example.com":  "www",
+		"a.b.example.com.": "a.b",
+		"*":                "*",
+		"_dmarc":           "_dmarc",
+	}
+	for in, want := range cases {
+		if got := NormalizeName("Example.com", in); got != want {
+			t.Errorf("NormalizeName(%q) = %q, want %q", in, got, want)
+		}
+	}
+}
+
+func TestFQDN(t *testing.T) {
+	if got := FQDN("example.com", "@"); got != "example.com" {
+		t.Errorf("FQDN(@) = %q, want example.com", got)
+	}
+	if got := FQDN("example.com", "www"); got != "www.example.com" {
+		t.Errorf("FQDN(www) = %q, want www.example.com", got)
+	}
+}
+
+func TestNormalizeValue(t *testing.T) {
+	cases := []struct {
+		recordType string
+		value      string
+		want       string
+		wantErr    bool
+	}{
+		{recordType: "A", value: " 192.0.2.1 ", want: "192.0.2.1"},
+		{recordType: "A", value: "2001:db8::1", wantErr: true},
+		{recordType: "A", value: "host", wantErr: true},
+		{recordType: "AAAA", value: "2001:DB8:0::1", want: "2001:db8::1"},
+		{recordType: "AAAA", value: "192.0.2.1", wantErr: true},
+		{recordType: "CNAME", value: "Target.Example.com.", want: "target.example.com"},
+		{recordType: "NS", value: "ns1.dns-parking.com", want: "ns1.dns-parking.com"},
+		{recordType: "MX", value: "10  MX1.example.com.", want: "10 mx1.example.com"},
+		{recordType: "MX", value: "mx1.example.com", wantErr: true},
+		{recordType: "MX", value: "70000 mx1.example.com", wantErr: true},
+		{recordType: "SRV", value: "10 5 5060 sip.example.com.", want: "10 5 5060 sip.example.com"},
+		{recordType: "SRV", value: "10 5 sip.example.com", wantErr: true},
+		{recordType: "CAA", value: `0 ISSUE "letsencrypt.org"`, want: `0 issue "letsencrypt.org"`},
+		{recordType: "CAA", value: "0 issue letsencrypt.org", want: `0 issue "letsencrypt.org"`},
+		{recordType: "CAA", value: "256 issue letsencrypt.org", wantErr: true},
+		{recordType: "CAA", value: "0 issue", wantErr: true},
+		{recordType: "TXT", value: `"v=spf1 -all"`, want: "v=spf1 -all"},
+		{recordType: "TXT", value: `"abc" "def"`, want: "abcdef"},
+		{recordType: "TXT", value: "", wantErr: true},
+		{recordType: "PTR", value: "host", wantErr: true},
+	}
+	for _, tc := range cases {
+		got, err := NormalizeValue(tc.recordType, tc.value)
+		if tc.wantErr {
+			if err == nil {
+				t.Errorf("NormalizeValue(%s, %q) = %q, want error", tc.recordType, tc.value, got)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("NormalizeValue(%s, %q) error = %v", tc.recordType, tc.value, err)
+			continue
+		}
+		if got != tc.want {
+			t.Errorf("NormalizeValue(%s, %q) = %q, want %q", tc.recordType, tc.value, got, tc.want)
+		}
+	}
+}
+
+func TestNormalizeValuesDeduplicates(t *testing.T) {
+	got, err := NormalizeValues("A", []string{"192.0.2.1", "192.0.2.2", " 192.0.2.1"})
+	if err != nil {
+		t.Fatalf("NormalizeValues() error = %v", err)
+	}
+	if len(got) != 2 {
+		t.Errorf("NormalizeValues() = %v, want two values", got)
+	}
+}
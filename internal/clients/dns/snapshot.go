@@ -0,0 +1,87 @@
+/*
+Copyright 2025 Ross Golder.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dns
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/rossigee/provider-hostinger/apis/dns/v1beta1"
+	"github.com/rossigee/provider-hostinger/internal/clients"
+)
+
+// Snapshot represents a snapshot Hostinger took of a DNS zone
+type Snapshot struct {
+	ID        string
+	Reason    string
+	CreatedAt *string
+}
+
+// snapshot is a DNS zone snapshot as returned by the Hostinger API
+type snapshot struct {
+	ID        int64   `json:"id"`
+	Reason    string  `json:"reason"`
+	CreatedAt *string `json:"created_at"`
+}
+
+// ListSnapshots returns the snapshots of a DNS zone, newest first
+func (dc *DNSClient) ListSnapshots(ctx context.Context, domain string) ([]*Snapshot, error) {
+	var resp []snapshot
+	if err := dc.hostingerClient.DoJSON(ctx, http.MethodGet, snapshotsPath(domain), nil, &resp); err != nil {
+		return nil, err
+	}
+	snapshots := make([]*Snapshot, 0, len(resp))
+	for _, s := range resp {
+		snapshots = append(snapshots, &Snapshot{ID: strconv.FormatInt(s.ID, 10), Reason: s.Reason, CreatedAt: s.CreatedAt})
+	}
+	sort.SliceStable(snapshots, func(i, j int) bool {
+		return createdAt(snapshots[i]).After(createdAt(snapshots[j]))
+	})
+	return snapshots, nil
+}
+
+// RestoreSnapshot replaces the records of a DNS zone with those of one of
+// its snapshots
+func (dc *DNSClient) RestoreSnapshot(ctx context.Context, domain, snapshotID string) error {
+	return dc.hostingerClient.DoJSON(ctx, http.MethodPost, snapshotsPath(domain)+"/"+url.PathEscape(snapshotID)+"/restore", nil, nil)
+}
+
+// GetSnapshotInfo maps a Snapshot to DNSZoneSnapshotInfo
+func GetSnapshotInfo(s *Snapshot) v1beta1.DNSZoneSnapshotInfo {
+	return v1beta1.DNSZoneSnapshotInfo{
+		ID:        s.ID,
+		Reason:    s.Reason,
+		CreatedAt: clients.ParseTime(s.CreatedAt),
+	}
+}
+
+// snapshotsPath returns the API path of the snapshots of a DNS zone
+func snapshotsPath(domain string) string {
+	return "/dns/snapshots/" + url.PathEscape(domain)
+}
+
+// createdAt returns when a snapshot was taken, or the zero time if unknown
+func createdAt(s *Snapshot) time.Time {
+	if t := clients.ParseTime(s.CreatedAt); t != nil {
+		return t.Time
+	}
+	return time.Time{}
+}
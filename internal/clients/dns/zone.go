@@ -0,0 +1,138 @@
+/*
+Copyright 2025 Ross Golder.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dns
+
+import (
+	"strings"
+)
+
+// Declared holds the record values declared for a zone, by SetKey. A nil
+// entry declares the whole record set, for records whose values are not
+// known yet.
+type Declared map[string][]string
+
+// Add declares values of a record set. Nil values declare the whole set.
+func (d Declared) Add(key string, values []string) {
+	current, ok := d[key]
+	switch {
+	case ok && current == nil:
+		return
+	case values == nil:
+		d[key] = nil
+		return
+	}
+	if current == nil {
+		current = []string{}
+	}
+	for _, v := range values {
+		if !containsValue(current, v) {
+			current = append(current, v)
+		}
+	}
+	d[key] = current
+}
+
+// Classification splits the values of a zone into managed, excluded and
+// unmanaged values
+type Classification struct {
+	Managed  int
+	Excluded int
+
+	// Unmanaged are the record sets holding unmanaged values, with only
+	// those values
+	Unmanaged []RecordSet
+
+	// Kept are the same record sets as Unmanaged, with only the values to
+	// keep and any disabled values
+	Kept []RecordSet
+}
+
+// UnmanagedCount returns the number of unmanaged values
+func (c Classification) UnmanagedCount() int {
+	n := 0
+	for _, set := range c.Unmanaged {
+		n += len(set.Values)
+	}
+	return n
+}
+
+// SetKey identifies a record set by name and type
+func SetKey(zone, name, recordType string) string {
+	return NormalizeName(zone, name) + "/" + strings.ToUpper(recordType)
+}
+
+// Classify compares the record sets of a zone with the declared values.
+// Record sets matching an exclusion filter, where an empty type matches all
+// types, are excluded, as are the NS and SOA records of the zone apex.
+func Classify(zone string, sets []RecordSet, declared Declared, exclude []Filter) Classification {
+	c := Classification{}
+	for _, set := range sets {
+		if excluded(zone, set, exclude) {
+			c.Excluded += len(set.Values)
+			continue
+		}
+		values, ok := declared[SetKey(zone, set.Name, set.Type)]
+		if ok && values == nil {
+			c.Managed += len(set.Values)
+			continue
+		}
+
+		keep := []string{}
+		drop := []string{}
+		for _, v := range set.Values {
+			n, err := NormalizeValue(set.Type, v)
+			if err != nil {
+				n = v
+			}
+			if containsValue(values, n) {
+				keep = append(keep, v)
+			} else {
+				drop = append(drop, v)
+			}
+		}
+		c.Managed += len(keep)
+		if len(drop) > 0 {
+			c.Unmanaged = append(c.Unmanaged, RecordSet{Name: set.Name, Type: set.Type, TTL: set.TTL, Values: drop})
+			c.Kept = append(c.Kept, RecordSet{Name: set.Name, Type: set.Type, TTL: set.TTL, Values: keep, Disabled: set.Disabled})
+		}
+	}
+	return c
+}
+
+// excluded reports whether a record set is kept regardless of declarations
+func excluded(zone string, set RecordSet, exclude []Filter) bool {
+	name := NormalizeName(zone, set.Name)
+	if name == Apex && (strings.EqualFold(set.Type, "NS") || strings.EqualFold(set.Type, "SOA")) {
+		return true
+	}
+	for _, f := range exclude {
+		if NormalizeName(zone, f.Name) == name && (f.Type == "" || strings.EqualFold(f.Type, set.Type)) {
+			return true
+		}
+	}
+	return false
+}
+
+// containsValue returns true if s contains v
+func containsValue(s []string, v string) bool {
+	for _, x := range s {
+		if x == v {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,72 @@
+/*
+Copyright 2025 Ross Golder.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dns
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestDeclaredAdd(t *testing.T) {
+	d := Declared{}
+	d.Add("www/A", []string{"192.0.2.1"})
+	d.Add("www/A", []string{"192.0.2.1", "192.0.2.2"})
+	if want := []string{"192.0.2.1", "192.0.2.2"}; !reflect.DeepEqual(d["www/A"], want) {
+		t.Errorf("Declared[www/A] = %v, want %v", d["www/A"], want)
+	}
+
+	d.Add("www/A", nil)
+	d.Add("www/A", []string{"192.0.2.3"})
+	if v, ok := d["www/A"]; !ok || v != nil {
+		t.Errorf("Declared[www/A] = %v, want the whole set declared", v)
+	}
+}
+
+func TestClassify(t *testing.T) {
+	sets := []RecordSet{
+		{Name: "@", Type: "NS", TTL: 86400, Values: []string{"ns1.dns-parking.com.", "ns2.dns-parking.com."}},
+		{Name: "@", Type: "A", TTL: 300, Values: []string{"192.0.2.1", "198.51.100.7"}},
+		{Name: "www", Type: "CNAME", TTL: 300, Values: []string{"example.com."}},
+		{Name: "ftp", Type: "A", TTL: 300, Values: []string{"198.51.100.8"}},
+		{Name: "_dmarc", Type: "TXT", TTL: 300, Values: []string{"v=DMARC1; p=none"}},
+		{Name: "app", Type: "AAAA", TTL: 300, Values: []string{"2001:db8::1"}},
+	}
+	declared := Declared{}
+	declared.Add(SetKey("example.com", "@", "A"), []string{"192.0.2.1"})
+	declared.Add(SetKey("example.com", "www", "CNAME"), []string{"example.com"})
+	declared.Add(SetKey("example.com", "app", "AAAA"), nil)
+
+	c := Classify("example.com", sets, declared, []Filter{{Name: "_dmarc"}})
+
+	if c.Managed != 3 || c.Excluded != 3 || c.UnmanagedCount() != 2 {
+		t.Errorf("Classify() = %d managed, %d excluded, %d unmanaged, want 3, 3 and 2", c.Managed, c.Excluded, c.UnmanagedCount())
+	}
+	wantUnmanaged := []RecordSet{
+		{Name: "@", Type: "A", TTL: 300, Values: []string{"198.51.100.7"}},
+		{Name: "ftp", Type: "A", TTL: 300, Values: []string{"198.51.100.8"}},
+	}
+	if !reflect.DeepEqual(c.Unmanaged, wantUnmanaged) {
+		t.Errorf("Unmanaged = %+v, want %+v", c.Unmanaged, wantUnmanaged)
+	}
+	wantKept := []RecordSet{
+		{Name: "@", Type: "A", TTL: 300, Values: []string{"192.0.2.1"}},
+		{Name: "ftp", Type: "A", TTL: 300, Values: []string{}},
+	}
+	if !reflect.DeepEqual(c.Kept, wantKept) {
+		t.Errorf("Kept = %+v, want %+v", c.Kept, wantKept)
+	}
+}
@@ -0,0 +1,234 @@
+/*
+Copyright 2025 Ross Golder.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package domain
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+
+	"github.com/rossigee/provider-hostinger/apis/domain/v1beta1"
+	"github.com/rossigee/provider-hostinger/internal/clients"
+	"github.com/rossigee/provider-hostinger/internal/clients/billing"
+)
+
+// Domain represents a domain in the Hostinger portfolio
+type Domain struct {
+	Domain             string
+	Status             string
+	Message            string
+	CreatedAt          *string
+	ExpiresAt          *string
+	Lockable           bool
+	Locked             bool
+	PrivacyProtectable bool
+	PrivacyProtected   bool
+	Nameservers        []string
+}
+
+// domain is a domain as returned by the Hostinger API
+type domain struct {
+	Domain               string            `json:"domain"`
+	Status               string            `json:"status"`
+	Message              *string           `json:"message"`
+	IsLockable           bool              `json:"is_lockable"`
+	IsLocked             bool              `json:"is_locked"`
+	IsPrivacyProtectable bool              `json:"is_privacy_protectable"`
+	IsPrivacyProtected   bool              `json:"is_privacy_protected"`
+	NameServers          map[string]string `json:"name_servers"`
+	CreatedAt            *string           `json:"created_at"`
+	ExpiresAt            *string           `json:"expires_at"`
+}
+
+// Client defines operations for managing domains in the Hostinger portfolio
+type Client interface {
+	// Get retrieves a domain in the portfolio
+	Get(ctx context.Context, name string) (*Domain, error)
+
+	// AutoRenew reports whether the subscription of a domain renews
+	// automatically, or nil if the subscription is not found
+	AutoRenew(ctx context.Context, name string) (*bool, error)
+
+	// SetNameservers delegates a domain to nameservers
+	SetNameservers(ctx context.Context, name string, nameservers []string) error
+
+	// SetLock enables or disables the registrar lock of a domain
+	SetLock(ctx context.Context, name string, locked bool) error
+
+	// SetPrivacyProtection enables or disables WHOIS privacy protection of
+	// a domain
+	SetPrivacyProtection(ctx context.Context, name string, enabled bool) error
+}
+
+// DomainClient implements the Client, ForwardingClient, RegistrationClient
+// and WHOISClient interfaces
+type DomainClient struct {
+	hostingerClient *clients.HostingerClient
+}
+
+// NewDomainClient creates a new domain client
+func NewDomainClient(hostingerClient *clients.HostingerClient) *DomainClient {
+	return &DomainClient{
+		hostingerClient: hostingerClient,
+	}
+}
+
+// Get retrieves a domain in the portfolio
+func (dc *DomainClient) Get(ctx context.Context, name string) (*Domain, error) {
+	resp := &domain{}
+	if err := dc.hostingerClient.DoJSON(ctx, http.MethodGet, domainPath(name), nil, resp); err != nil {
+		return nil, err
+	}
+	d := &Domain{
+		Domain:             resp.Domain,
+		Status:             resp.Status,
+		CreatedAt:          resp.CreatedAt,
+		ExpiresAt:          resp.ExpiresAt,
+		Lockable:           resp.IsLockable,
+		Locked:             resp.IsLocked,
+		PrivacyProtectable: resp.IsPrivacyProtectable,
+		PrivacyProtected:   resp.IsPrivacyProtected,
+		Nameservers:        nameservers(resp.NameServers),
+	}
+	if resp.Message != nil {
+		d.Message = *resp.Message
+	}
+	return d, nil
+}
+
+// AutoRenew reports whether the subscription of a domain renews
+// automatically
+func (dc *DomainClient) AutoRenew(ctx context.Context, name string) (*bool, error) {
+	s, err := dc.GetSubscription(ctx, name)
+	if err != nil || s == nil {
+		return nil, err
+	}
+	autoRenew := s.IsAutoRenewed
+	return &autoRenew, nil
+}
+
+// GetSubscription returns the billing subscription of a domain, or nil if
+// there is none. Domain subscriptions are named after the domain.
+func (dc *DomainClient) GetSubscription(ctx context.Context, name string) (*billing.Subscription, error) {
+	subscriptions, err := billing.NewBillingClient(dc.hostingerClient).ListSubscriptions(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, s := range subscriptions {
+		if namesDomain(s.Name, name) {
+			return s, nil
+		}
+	}
+	return nil, nil
+}
+
+// SetNameservers delegates a domain to nameservers, set as ns1 to ns4 in
+// order
+func (dc *DomainClient) SetNameservers(ctx context.Context, name string, nameservers []string) error {
+	req := map[string]string{}
+	for i, ns := range nameservers {
+		req[fmt.Sprintf("ns%d", i+1)] = ns
+	}
+	return dc.hostingerClient.DoJSON(ctx, http.MethodPut, domainPath(name)+"/nameservers", req, nil)
+}
+
+// SetLock enables or disables the registrar lock of a domain
+func (dc *DomainClient) SetLock(ctx context.Context, name string, locked bool) error {
+	return dc.hostingerClient.DoJSON(ctx, toggleMethod(locked), domainPath(name)+"/domain-lock", nil, nil)
+}
+
+// SetPrivacyProtection enables or disables WHOIS privacy protection of a
+// domain
+func (dc *DomainClient) SetPrivacyProtection(ctx context.Context, name string, enabled bool) error {
+	return dc.hostingerClient.DoJSON(ctx, toggleMethod(enabled), domainPath(name)+"/privacy-protection", nil, nil)
+}
+
+// NormalizeNameservers returns nameservers in canonical form, so that
+// equivalent spellings compare equal
+func NormalizeNameservers(nameservers []string) []string {
+	out := make([]string, 0, len(nameservers))
+	for _, ns := range nameservers {
+		out = append(out, strings.ToLower(strings.TrimSuffix(strings.TrimSpace(ns), ".")))
+	}
+	return out
+}
+
+// GetObservation maps a Domain to DomainObservation
+func GetObservation(d *Domain, autoRenew *bool) v1beta1.DomainObservation {
+	return v1beta1.DomainObservation{
+		Status:             d.Status,
+		Message:            d.Message,
+		CreatedAt:          clients.ParseTime(d.CreatedAt),
+		ExpiresAt:          clients.ParseTime(d.ExpiresAt),
+		AutoRenew:          autoRenew,
+		Locked:             d.Locked,
+		Lockable:           d.Lockable,
+		PrivacyProtected:   d.PrivacyProtected,
+		PrivacyProtectable: d.PrivacyProtectable,
+		Nameservers:        d.Nameservers,
+	}
+}
+
+// nameservers returns the nameservers of a domain, ordered by their ns1,
+// ns2, ... keys
+func nameservers(m map[string]string) []string {
+	keys := make([]string, 0, len(m))
+	for k, v := range m {
+		if v != "" {
+			keys = append(keys, k)
+		}
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		if len(keys[i]) != len(keys[j]) {
+			return len(keys[i]) < len(keys[j])
+		}
+		return keys[i] < keys[j]
+	})
+	out := make([]string, 0, len(keys))
+	for _, k := range keys {
+		out = append(out, m[k])
+	}
+	return NormalizeNameservers(out)
+}
+
+// namesDomain reports whether a subscription name is, or contains as a
+// word, the domain
+func namesDomain(subscription, name string) bool {
+	for _, word := range strings.FieldsFunc(subscription, func(r rune) bool { return r == ' ' || r == ':' || r == '(' || r == ')' }) {
+		if strings.EqualFold(word, name) {
+			return true
+		}
+	}
+	return false
+}
+
+// toggleMethod returns the method that enables a domain setting with PUT or
+// disables it with DELETE
+func toggleMethod(enable bool) string {
+	if enable {
+		return http.MethodPut
+	}
+	return http.MethodDelete
+}
+
+// domainPath returns the API path of a domain in the portfolio
+func domainPath(name string) string {
+	return "/domains/portfolio/" + url.PathEscape(name)
+}
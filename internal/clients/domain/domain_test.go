@@ -0,0 +1,164 @@
+/*
+Copyright 2025 Ross Golder.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package domain
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/rossigee/provider-hostinger/internal/clients"
+	"github.com/rossigee/provider-hostinger/internal/clients/auth"
+)
+
+func newTestClient(server *httptest.Server) *DomainClient {
+	cfg := clients.DefaultHTTPClientConfig()
+	cfg.MaxRetries = 0
+	return NewDomainClient(clients.NewHostingerClient(auth.NewV1KeyAuth("key", "customer", server.URL), cfg))
+}
+
+func TestGet(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet || r.URL.Path != "/domains/portfolio/example.com" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		_, _ = w.Write([]byte(`{
+			"domain": "example.com",
+			"status": "active",
+			"message": null,
+			"is_privacy_protectable": true,
+			"is_privacy_protected": false,
+			"is_lockable": true,
+			"is_locked": true,
+			"name_servers": {"ns2": "NS2.dns-parking.com.", "ns1": "ns1.dns-parking.com", "ns10": "ns10.example.net", "ns3": ""},
+			"created_at": "2024-03-01T10:00:00Z",
+			"expires_at": "2026-03-01T10:00:00Z"
+		}`))
+	}))
+	defer server.Close()
+
+	d, err := newTestClient(server).Get(context.Background(), "example.com")
+	if err != nil {
+		t.Fatalf("Get() error = %v", err)
+	}
+	if d.Status != "active" || !d.Locked || !d.Lockable || d.PrivacyProtected || !d.PrivacyProtectable {
+		t.Errorf("Get() = %+v, want the domain flags", d)
+	}
+	if want := []string{"ns1.dns-parking.com", "ns2.dns-parking.com", "ns10.example.net"}; !reflect.DeepEqual(d.Nameservers, want) {
+		t.Errorf("Nameservers = %v, want %v", d.Nameservers, want)
+	}
+
+	obs := GetObservation(d, nil)
+	if obs.ExpiresAt == nil || obs.ExpiresAt.Year() != 2026 {
+		t.Errorf("ExpiresAt = %v, want 2026", obs.ExpiresAt)
+	}
+}
+
+func TestGetNotFound(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer server.Close()
+
+	if _, err := newTestClient(server).Get(context.Background(), "example.com"); !clients.IsNotFound(err) {
+		t.Errorf("Get() error = %v, want not found", err)
+	}
+}
+
+func TestAutoRenew(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/billing/subscriptions" {
+			t.Errorf("unexpected request %s", r.URL.Path)
+		}
+		_, _ = w.Write([]byte(`[
+			{"id": "a", "name": "myexample.com", "is_auto_renewed": true},
+			{"id": "b", "name": "Domain: example.com", "is_auto_renewed": false}
+		]`))
+	}))
+	defer server.Close()
+
+	c := newTestClient(server)
+	autoRenew, err := c.AutoRenew(context.Background(), "example.com")
+	if err != nil {
+		t.Fatalf("AutoRenew() error = %v", err)
+	}
+	if autoRenew == nil || *autoRenew {
+		t.Errorf("AutoRenew() = %v, want false from the example.com subscription", autoRenew)
+	}
+
+	autoRenew, err = c.AutoRenew(context.Background(), "example.org")
+	if err != nil {
+		t.Fatalf("AutoRenew() error = %v", err)
+	}
+	if autoRenew != nil {
+		t.Errorf("AutoRenew() = %v, want nil without a subscription", *autoRenew)
+	}
+}
+
+func TestSetNameservers(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPut || r.URL.Path != "/domains/portfolio/example.com/nameservers" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		req := map[string]string{}
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Fatalf("decode request: %v", err)
+		}
+		if want := map[string]string{"ns1": "ns1.example.net", "ns2": "ns2.example.net", "ns3": "ns3.example.net"}; !reflect.DeepEqual(req, want) {
+			t.Errorf("request = %v, want %v", req, want)
+		}
+	}))
+	defer server.Close()
+
+	if err := newTestClient(server).SetNameservers(context.Background(), "example.com", []string{"ns1.example.net", "ns2.example.net", "ns3.example.net"}); err != nil {
+		t.Fatalf("SetNameservers() error = %v", err)
+	}
+}
+
+func TestToggles(t *testing.T) {
+	requests := []string{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.Method+" "+r.URL.Path)
+	}))
+	defer server.Close()
+
+	c := newTestClient(server)
+	if err := c.SetLock(context.Background(), "example.com", true); err != nil {
+		t.Fatalf("SetLock() error = %v", err)
+	}
+	if err := c.SetLock(context.Background(), "example.com", false); err != nil {
+		t.Fatalf("SetLock() error = %v", err)
+	}
+	if err := c.SetPrivacyProtection(context.Background(), "example.com", true); err != nil {
+		t.Fatalf("SetPrivacyProtection() error = %v", err)
+	}
+	if err := c.SetPrivacyProtection(context.Background(), "example.com", false); err != nil {
+		t.Fatalf("SetPrivacyProtection() error = %v", err)
+	}
+	want := []string{
+		"PUT /domains/portfolio/example.com/domain-lock",
+		"DELETE /domains/portfolio/example.com/domain-lock",
+		"PUT /domains/portfolio/example.com/privacy-protection",
+		"DELETE /domains/portfolio/example.com/privacy-protection",
+	}
+	if !reflect.DeepEqual(requests, want) {
+		t.Errorf("requests = %v, want %v", requests, want)
+	}
+}
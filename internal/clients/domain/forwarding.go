@@ -0,0 +1,116 @@
+/*
+Copyright 2025 Ross Golder.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package domain
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/rossigee/provider-hostinger/apis/domain/v1beta1"
+	"github.com/rossigee/provider-hostinger/internal/clients"
+)
+
+// Forwarding represents the HTTP forwarding of a domain
+type Forwarding struct {
+	Domain       string
+	RedirectType string
+	RedirectURL  string
+	CreatedAt    *string
+}
+
+// forwarding is the forwarding of a domain as returned and accepted by the
+// Hostinger API
+type forwarding struct {
+	Domain       string  `json:"domain"`
+	RedirectType string  `json:"redirect_type"`
+	RedirectURL  string  `json:"redirect_url"`
+	CreatedAt    *string `json:"created_at,omitempty"`
+}
+
+// ForwardingClient defines operations for managing the HTTP forwarding of
+// domains
+type ForwardingClient interface {
+	// GetForwarding retrieves the HTTP forwarding of a domain
+	GetForwarding(ctx context.Context, name string) (*Forwarding, error)
+
+	// CreateForwarding forwards a domain to a URL
+	CreateForwarding(ctx context.Context, f *Forwarding) error
+
+	// DeleteForwarding removes the HTTP forwarding of a domain
+	DeleteForwarding(ctx context.Context, name string) error
+}
+
+// GetForwarding retrieves the forwarding of a domain
+func (dc *DomainClient) GetForwarding(ctx context.Context, name string) (*Forwarding, error) {
+	resp := &forwarding{}
+	if err := dc.hostingerClient.DoJSON(ctx, http.MethodGet, forwardingPath(name), nil, resp); err != nil {
+		return nil, err
+	}
+	return &Forwarding{
+		Domain:       resp.Domain,
+		RedirectType: resp.RedirectType,
+		RedirectURL:  resp.RedirectURL,
+		CreatedAt:    resp.CreatedAt,
+	}, nil
+}
+
+// CreateForwarding forwards a domain to a URL
+func (dc *DomainClient) CreateForwarding(ctx context.Context, f *Forwarding) error {
+	req := &forwarding{
+		Domain:       f.Domain,
+		RedirectType: f.RedirectType,
+		RedirectURL:  f.RedirectURL,
+	}
+	return dc.hostingerClient.DoJSON(ctx, http.MethodPost, "/domains/forwarding", req, nil)
+}
+
+// DeleteForwarding removes the forwarding of a domain
+func (dc *DomainClient) DeleteForwarding(ctx context.Context, name string) error {
+	return dc.hostingerClient.DoJSON(ctx, http.MethodDelete, forwardingPath(name), nil, nil)
+}
+
+// ValidateRedirectURL checks that a redirect target is an absolute http or
+// https URL
+func ValidateRedirectURL(target string) error {
+	u, err := url.Parse(target)
+	if err != nil {
+		return fmt.Errorf("invalid redirect URL: %w", err)
+	}
+	if !u.IsAbs() || u.Host == "" {
+		return fmt.Errorf("redirect URL %q is not absolute", target)
+	}
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return fmt.Errorf("redirect URL %q must use http or https", target)
+	}
+	return nil
+}
+
+// GetForwardingObservation maps a Forwarding to DomainForwardingObservation
+func GetForwardingObservation(f *Forwarding) v1beta1.DomainForwardingObservation {
+	return v1beta1.DomainForwardingObservation{
+		RedirectType: v1beta1.RedirectType(f.RedirectType),
+		RedirectURL:  f.RedirectURL,
+		CreatedAt:    clients.ParseTime(f.CreatedAt),
+	}
+}
+
+// forwardingPath returns the API path of the forwarding of a domain
+func forwardingPath(name string) string {
+	return "/domains/forwarding/" + url.PathEscape(name)
+}
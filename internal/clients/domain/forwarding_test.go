@@ -0,0 +1,97 @@
+/*
+Copyright 2025 Ross Golder.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package domain
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/rossigee/provider-hostinger/internal/clients"
+)
+
+func TestGetForwarding(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet || r.URL.Path != "/domains/forwarding/example.com" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		_, _ = w.Write([]byte(`{"domain": "example.com", "redirect_type": "302", "redirect_url": "https://www.example.org/", "created_at": "2025-01-01T00:00:00Z"}`))
+	}))
+	defer server.Close()
+
+	f, err := newTestClient(server).GetForwarding(context.Background(), "example.com")
+	if err != nil {
+		t.Fatalf("GetForwarding() error = %v", err)
+	}
+	obs := GetForwardingObservation(f)
+	if obs.RedirectType != "302" || obs.RedirectURL != "https://www.example.org/" || obs.CreatedAt == nil {
+		t.Errorf("GetForwardingObservation() = %+v, want the forwarding", obs)
+	}
+}
+
+func TestGetForwardingNotFound(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer server.Close()
+
+	if _, err := newTestClient(server).GetForwarding(context.Background(), "example.com"); !clients.IsNotFound(err) {
+		t.Errorf("GetForwarding() error = %v, want not found", err)
+	}
+}
+
+func TestCreateForwarding(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/domains/forwarding" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		req := map[string]string{}
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Fatalf("decode request: %v", err)
+		}
+		if req["domain"] != "example.com" || req["redirect_type"] != "301" || req["redirect_url"] != "https://example.org" {
+			t.Errorf("request = %v, want the forwarding", req)
+		}
+		if _, ok := req["created_at"]; ok {
+			t.Error("request sets created_at, want it omitted")
+		}
+	}))
+	defer server.Close()
+
+	f := &Forwarding{Domain: "example.com", RedirectType: "301", RedirectURL: "https://example.org"}
+	if err := newTestClient(server).CreateForwarding(context.Background(), f); err != nil {
+		t.Fatalf("CreateForwarding() error = %v", err)
+	}
+}
+
+func TestValidateRedirectURL(t *testing.T) {
+	cases := map[string]bool{
+		"https://example.org/landing?utm=1": true,
+		"http://example.org":                true,
+		"example.org":                       false,
+		"/landing":                          false,
+		"ftp://example.org":                 false,
+		"https://":                          false,
+	}
+	for target, valid := range cases {
+		if err := ValidateRedirectURL(target); (err == nil) != valid {
+			t.Errorf("ValidateRedirectURL(%q) error = %v, want valid %t", target, err, valid)
+		}
+	}
+}
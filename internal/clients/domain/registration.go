@@ -0,0 +1,208 @@
+/*
+Copyright 2025 Ross Golder.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package domain
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/rossigee/provider-hostinger/internal/clients/billing"
+)
+
+// periodUnitYear is the catalog period unit of domain prices
+const periodUnitYear = "year"
+
+// Availability reports whether a domain can be registered
+type Availability struct {
+	Domain      string
+	Available   bool
+	Restriction string
+}
+
+// Price is the catalog price of registering a domain for a period
+type Price struct {
+	ItemID           string
+	Currency         string
+	FirstPeriodPrice int64
+	RenewalPrice     int64
+}
+
+// Purchase is an order for a domain registration
+type Purchase struct {
+	Domain          string
+	ItemID          string
+	PaymentMethodID *int64
+	WHOISProfileID  int64
+}
+
+// Order is an order placed with Hostinger
+type Order struct {
+	ID     string
+	Status string
+}
+
+// RegistrationClient defines operations for registering domains
+type RegistrationClient interface {
+	// Get retrieves a domain in the portfolio
+	Get(ctx context.Context, name string) (*Domain, error)
+
+	// GetSubscription returns the billing subscription of a domain, or nil
+	// if there is none
+	GetSubscription(ctx context.Context, name string) (*billing.Subscription, error)
+
+	// CheckAvailability reports whether a domain can be registered
+	CheckAvailability(ctx context.Context, name string) (*Availability, error)
+
+	// GetPrice returns the catalog price of registering a domain
+	GetPrice(ctx context.Context, name string, years int32) (*Price, error)
+
+	// Purchase orders the registration of a domain
+	Purchase(ctx context.Context, p *Purchase) (*Order, error)
+}
+
+// availabilityRequest is the payload for checking domain availability
+type availabilityRequest struct {
+	Domain           string   `json:"domain"`
+	TLDs             []string `json:"tlds"`
+	WithAlternatives bool     `json:"with_alternatives"`
+}
+
+// availability is the availability of a domain as returned by the
+// Hostinger API
+type availability struct {
+	Domain        *string `json:"domain"`
+	IsAvailable   bool    `json:"is_available"`
+	IsAlternative bool    `json:"is_alternative"`
+	Restriction   *string `json:"restriction"`
+}
+
+// purchaseRequest is the payload for purchasing a domain
+type purchaseRequest struct {
+	Domain          string         `json:"domain"`
+	ItemID          string         `json:"item_id"`
+	PaymentMethodID *int64         `json:"payment_method_id,omitempty"`
+	DomainContacts  domainContacts `json:"domain_contacts"`
+}
+
+// domainContacts are the WHOIS profiles of the contacts of a domain
+type domainContacts struct {
+	OwnerID   int64 `json:"owner_id"`
+	AdminID   int64 `json:"admin_id"`
+	BillingID int64 `json:"billing_id"`
+	TechID    int64 `json:"tech_id"`
+}
+
+// order is an order as returned by the Hostinger API
+type order struct {
+	ID     int64  `json:"id"`
+	Status string `json:"status"`
+}
+
+// CheckAvailability reports whether a domain can be registered
+func (dc *DomainClient) CheckAvailability(ctx context.Context, name string) (*Availability, error) {
+	label, tld, err := SplitDomain(name)
+	if err != nil {
+		return nil, err
+	}
+	req := &availabilityRequest{Domain: label, TLDs: []string{tld}}
+	var resp []availability
+	if err := dc.hostingerClient.DoJSON(ctx, http.MethodPost, "/domains/availability", req, &resp); err != nil {
+		return nil, err
+	}
+	for _, a := range resp {
+		if a.IsAlternative || a.Domain == nil || !strings.EqualFold(*a.Domain, name) {
+			continue
+		}
+		result := &Availability{Domain: *a.Domain, Available: a.IsAvailable}
+		if a.Restriction != nil {
+			result.Restriction = *a.Restriction
+		}
+		return result, nil
+	}
+	return nil, fmt.Errorf("availability of %s was not reported", name)
+}
+
+// GetPrice returns the catalog price of registering a domain for a number of
+// years
+func (dc *DomainClient) GetPrice(ctx context.Context, name string, years int32) (*Price, error) {
+	_, tld, err := SplitDomain(name)
+	if err != nil {
+		return nil, err
+	}
+	items, err := billing.NewBillingClient(dc.hostingerClient).ListCatalog(ctx, billing.CategoryDomain)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list domain prices: %w", err)
+	}
+	return selectPrice(items, tld, years)
+}
+
+// Purchase orders the registration of a domain, using a WHOIS profile for
+// all of its contacts
+func (dc *DomainClient) Purchase(ctx context.Context, p *Purchase) (*Order, error) {
+	req := &purchaseRequest{
+		Domain:          p.Domain,
+		ItemID:          p.ItemID,
+		PaymentMethodID: p.PaymentMethodID,
+		DomainContacts: domainContacts{
+			OwnerID:   p.WHOISProfileID,
+			AdminID:   p.WHOISProfileID,
+			BillingID: p.WHOISProfileID,
+			TechID:    p.WHOISProfileID,
+		},
+	}
+	resp := &order{}
+	if err := dc.hostingerClient.DoJSON(ctx, http.MethodPost, "/domains/portfolio", req, resp); err != nil {
+		return nil, err
+	}
+	return &Order{ID: strconv.FormatInt(resp.ID, 10), Status: resp.Status}, nil
+}
+
+// SplitDomain splits a domain into its first label and its TLD, such as
+// example and co.uk
+func SplitDomain(name string) (string, string, error) {
+	label, tld, ok := strings.Cut(strings.ToLower(strings.TrimSuffix(name, ".")), ".")
+	if !ok || label == "" || tld == "" {
+		return "", "", fmt.Errorf("domain %q has no TLD", name)
+	}
+	return label, tld, nil
+}
+
+// selectPrice picks the price of a TLD for a number of years from the domain
+// catalog. Domain items are named after their TLD, such as .com.
+func selectPrice(items []*billing.CatalogItem, tld string, years int32) (*Price, error) {
+	for _, item := range items {
+		fields := strings.Fields(item.Name)
+		if len(fields) == 0 || !strings.EqualFold(strings.TrimPrefix(fields[0], "."), tld) {
+			continue
+		}
+		for _, p := range item.Prices {
+			if p.PeriodUnit == periodUnitYear && p.Period == years {
+				return &Price{
+					ItemID:           p.ID,
+					Currency:         p.Currency,
+					FirstPeriodPrice: p.FirstPeriodPrice,
+					RenewalPrice:     p.Price,
+				}, nil
+			}
+		}
+		return nil, fmt.Errorf("no %d year price for .%s in the domain catalog", years, tld)
+	}
+	return nil, fmt.Errorf(".%s is not in the domain catalog", tld)
+}
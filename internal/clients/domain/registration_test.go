@@ -0,0 +1,125 @@
+/*
+Copyright 2025 Ross Golder.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package domain
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/rossigee/provider-hostinger/internal/clients/billing"
+)
+
+func TestCheckAvailability(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/domains/availability" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		req := availabilityRequest{}
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Fatalf("decode request: %v", err)
+		}
+		if req.Domain != "campaign" || len(req.TLDs) != 1 || req.TLDs[0] != "co.uk" {
+			t.Errorf("request = %+v, want the label and TLD", req)
+		}
+		_, _ = w.Write([]byte(`[
+			{"domain": "campaign.uk", "is_available": true, "is_alternative": true, "restriction": null},
+			{"domain": "campaign.co.uk", "is_available": false, "is_alternative": false, "restriction": "Premium domain"}
+		]`))
+	}))
+	defer server.Close()
+
+	a, err := newTestClient(server).CheckAvailability(context.Background(), "campaign.co.uk")
+	if err != nil {
+		t.Fatalf("CheckAvailability() error = %v", err)
+	}
+	if a.Available || a.Restriction != "Premium domain" {
+		t.Errorf("CheckAvailability() = %+v, want the requested domain, not the alternative", a)
+	}
+}
+
+func TestPurchase(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/domains/portfolio" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		req := purchaseRequest{}
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Fatalf("decode request: %v", err)
+		}
+		if req.Domain != "campaign.com" || req.ItemID != "hostingercom-domain-com-usd-1y" || req.PaymentMethodID == nil || *req.PaymentMethodID != 77 {
+			t.Errorf("request = %+v, want the order", req)
+		}
+		if c := req.DomainContacts; c.OwnerID != 42 || c.AdminID != 42 || c.BillingID != 42 || c.TechID != 42 {
+			t.Errorf("contacts = %+v, want the WHOIS profile for all contacts", c)
+		}
+		_, _ = w.Write([]byte(`{"id": 2957086, "status": "completed"}`))
+	}))
+	defer server.Close()
+
+	paymentMethodID := int64(77)
+	o, err := newTestClient(server).Purchase(context.Background(), &Purchase{
+		Domain:          "campaign.com",
+		ItemID:          "hostingercom-domain-com-usd-1y",
+		PaymentMethodID: &paymentMethodID,
+		WHOISProfileID:  42,
+	})
+	if err != nil {
+		t.Fatalf("Purchase() error = %v", err)
+	}
+	if o.ID != "2957086" || o.Status != "completed" {
+		t.Errorf("Purchase() = %+v, want the order", o)
+	}
+}
+
+func TestSplitDomain(t *testing.T) {
+	label, tld, err := SplitDomain("Campaign.CO.uk.")
+	if err != nil || label != "campaign" || tld != "co.uk" {
+		t.Errorf("SplitDomain() = %q, %q, %v, want campaign, co.uk", label, tld, err)
+	}
+	if _, _, err := SplitDomain("localhost"); err == nil {
+		t.Error("SplitDomain() error = nil, want a domain without TLD to be rejected")
+	}
+}
+
+func TestSelectPrice(t *testing.T) {
+	items := []*billing.CatalogItem{
+		{ID: "hostingercom-domain-net", Name: ".NET Domain", Prices: []billing.Price{
+			{ID: "hostingercom-domain-net-usd-1y", Currency: "USD", Price: 1599, FirstPeriodPrice: 999, Period: 1, PeriodUnit: "year"},
+		}},
+		{ID: "hostingercom-domain-com", Name: ".COM Domain", Prices: []billing.Price{
+			{ID: "hostingercom-domain-com-usd-1y", Currency: "USD", Price: 1599, FirstPeriodPrice: 899, Period: 1, PeriodUnit: "year"},
+			{ID: "hostingercom-domain-com-usd-2y", Currency: "USD", Price: 3198, FirstPeriodPrice: 2498, Period: 2, PeriodUnit: "year"},
+		}},
+	}
+
+	p, err := selectPrice(items, "com", 2)
+	if err != nil {
+		t.Fatalf("selectPrice() error = %v", err)
+	}
+	if p.ItemID != "hostingercom-domain-com-usd-2y" || p.FirstPeriodPrice != 2498 || p.RenewalPrice != 3198 || p.Currency != "USD" {
+		t.Errorf("selectPrice() = %+v, want the 2 year .com price", p)
+	}
+	if _, err := selectPrice(items, "com", 5); err == nil {
+		t.Error("selectPrice() error = nil, want a missing period to be rejected")
+	}
+	if _, err := selectPrice(items, "org", 1); err == nil {
+		t.Error("selectPrice() error = nil, want a missing TLD to be rejected")
+	}
+}
@@ -0,0 +1,263 @@
+/*
+Copyright 2025 Ross Golder.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package domain
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/rossigee/provider-hostinger/apis/domain/v1beta1"
+	"github.com/rossigee/provider-hostinger/internal/clients"
+)
+
+// Keys of the contact secret of a WHOIS profile
+const (
+	ContactKeyFirstName        = "firstName"
+	ContactKeyLastName         = "lastName"
+	ContactKeyOrganization     = "organization"
+	ContactKeyAddress1         = "address1"
+	ContactKeyAddress2         = "address2"
+	ContactKeyCity             = "city"
+	ContactKeyState            = "state"
+	ContactKeyZip              = "zip"
+	ContactKeyPhoneCountryCode = "phoneCountryCode"
+	ContactKeyPhone            = "phone"
+	ContactKeyEmail            = "email"
+)
+
+// entityTypeOrganization is the entity type of organization contacts
+const entityTypeOrganization = "organization"
+
+// contactFields maps the keys of the contact secret to WHOIS detail fields
+// of the Hostinger API
+var contactFields = map[string]string{
+	ContactKeyFirstName:        "first_name",
+	ContactKeyLastName:         "last_name",
+	ContactKeyOrganization:     "company_name",
+	ContactKeyAddress1:         "address_1",
+	ContactKeyAddress2:         "address_2",
+	ContactKeyCity:             "city",
+	ContactKeyState:            "state",
+	ContactKeyZip:              "zip",
+	ContactKeyPhoneCountryCode: "phone_cc",
+	ContactKeyPhone:            "phone",
+	ContactKeyEmail:            "email",
+}
+
+// requiredContactKeys are the contact secret keys every profile needs
+var requiredContactKeys = []string{
+	ContactKeyFirstName,
+	ContactKeyLastName,
+	ContactKeyAddress1,
+	ContactKeyCity,
+	ContactKeyZip,
+	ContactKeyPhoneCountryCode,
+	ContactKeyPhone,
+	ContactKeyEmail,
+}
+
+// WHOISProfile represents a Hostinger WHOIS contact profile
+type WHOISProfile struct {
+	ID           string
+	TLD          string
+	Country      string
+	EntityType   string
+	WHOISDetails map[string]string
+	TLDDetails   map[string]string
+	CreatedAt    *string
+}
+
+// whoisProfile is a WHOIS profile as returned and accepted by the Hostinger
+// API
+type whoisProfile struct {
+	ID           int64          `json:"id,omitempty"`
+	TLD          string         `json:"tld"`
+	Country      string         `json:"country"`
+	EntityType   string         `json:"entity_type"`
+	WHOISDetails map[string]any `json:"whois_details"`
+	TLDDetails   map[string]any `json:"tld_details,omitempty"`
+	CreatedAt    *string        `json:"created_at,omitempty"`
+}
+
+// WHOISClient defines operations for managing WHOIS contact profiles
+type WHOISClient interface {
+	// GetWHOISProfile retrieves a WHOIS profile by ID
+	GetWHOISProfile(ctx context.Context, id string) (*WHOISProfile, error)
+
+	// CreateWHOISProfile creates a WHOIS profile
+	CreateWHOISProfile(ctx context.Context, p *WHOISProfile) (*WHOISProfile, error)
+
+	// DeleteWHOISProfile deletes a WHOIS profile
+	DeleteWHOISProfile(ctx context.Context, id string) error
+
+	// ListWHOISProfileUsage returns the domains that use a WHOIS profile
+	ListWHOISProfileUsage(ctx context.Context, id string) ([]string, error)
+}
+
+// GetWHOISProfile retrieves a WHOIS profile by ID
+func (dc *DomainClient) GetWHOISProfile(ctx context.Context, id string) (*WHOISProfile, error) {
+	resp := &whoisProfile{}
+	if err := dc.hostingerClient.DoJSON(ctx, http.MethodGet, whoisPath(id), nil, resp); err != nil {
+		return nil, err
+	}
+	return toWHOISProfile(resp), nil
+}
+
+// CreateWHOISProfile creates a WHOIS profile
+func (dc *DomainClient) CreateWHOISProfile(ctx context.Context, p *WHOISProfile) (*WHOISProfile, error) {
+	req := &whoisProfile{
+		TLD:          p.TLD,
+		Country:      p.Country,
+		EntityType:   p.EntityType,
+		WHOISDetails: toAny(p.WHOISDetails),
+		TLDDetails:   toAny(p.TLDDetails),
+	}
+	resp := &whoisProfile{}
+	if err := dc.hostingerClient.DoJSON(ctx, http.MethodPost, "/domains/whois", req, resp); err != nil {
+		return nil, err
+	}
+	return toWHOISProfile(resp), nil
+}
+
+// DeleteWHOISProfile deletes a WHOIS profile
+func (dc *DomainClient) DeleteWHOISProfile(ctx context.Context, id string) error {
+	return dc.hostingerClient.DoJSON(ctx, http.MethodDelete, whoisPath(id), nil, nil)
+}
+
+// ListWHOISProfileUsage returns the domains that use a WHOIS profile, sorted
+func (dc *DomainClient) ListWHOISProfileUsage(ctx context.Context, id string) ([]string, error) {
+	domains := []string{}
+	if err := dc.hostingerClient.DoJSON(ctx, http.MethodGet, whoisPath(id)+"/usage", nil, &domains); err != nil {
+		return nil, err
+	}
+	sort.Strings(domains)
+	return domains, nil
+}
+
+// ContactDetails maps the data of a contact secret to WHOIS details,
+// checking that the fields the entity type needs are set
+func ContactDetails(data map[string][]byte, entityType string) (map[string]string, error) {
+	required := requiredContactKeys
+	if entityType == entityTypeOrganization {
+		required = append([]string{ContactKeyOrganization}, required...)
+	}
+	missing := []string{}
+	for _, key := range required {
+		if strings.TrimSpace(string(data[key])) == "" {
+			missing = append(missing, key)
+		}
+	}
+	if len(missing) > 0 {
+		return nil, fmt.Errorf("contact secret is missing %s", strings.Join(missing, ", "))
+	}
+
+	unknown := []string{}
+	details := map[string]string{}
+	for key, value := range data {
+		field, ok := contactFields[key]
+		if !ok {
+			unknown = append(unknown, key)
+			continue
+		}
+		if v := strings.TrimSpace(string(value)); v != "" {
+			details[field] = v
+		}
+	}
+	if len(unknown) > 0 {
+		sort.Strings(unknown)
+		return nil, fmt.Errorf("contact secret has unknown keys %s", strings.Join(unknown, ", "))
+	}
+	return details, nil
+}
+
+// WHOISProfileMatches reports whether a live profile holds the desired
+// details. Fields Hostinger adds to the live profile are ignored.
+func WHOISProfileMatches(live, desired *WHOISProfile) bool {
+	if !strings.EqualFold(live.TLD, desired.TLD) ||
+		!strings.EqualFold(live.Country, desired.Country) ||
+		!strings.EqualFold(live.EntityType, desired.EntityType) {
+		return false
+	}
+	return detailsMatch(live.WHOISDetails, desired.WHOISDetails) && detailsMatch(live.TLDDetails, desired.TLDDetails)
+}
+
+// detailsMatch reports whether every desired detail has the same live value,
+// ignoring case and surrounding space
+func detailsMatch(live, desired map[string]string) bool {
+	for k, v := range desired {
+		if !strings.EqualFold(strings.TrimSpace(live[k]), strings.TrimSpace(v)) {
+			return false
+		}
+	}
+	return true
+}
+
+// GetWHOISProfileObservation maps a WHOISProfile and the domains using it to
+// WHOISProfileObservation. Contact details are not copied to status.
+func GetWHOISProfileObservation(p *WHOISProfile, domains []string) v1beta1.WHOISProfileObservation {
+	return v1beta1.WHOISProfileObservation{
+		ID:        p.ID,
+		CreatedAt: clients.ParseTime(p.CreatedAt),
+		Domains:   domains,
+	}
+}
+
+// toWHOISProfile maps an API WHOIS profile to a WHOISProfile
+func toWHOISProfile(p *whoisProfile) *WHOISProfile {
+	return &WHOISProfile{
+		ID:           strconv.FormatInt(p.ID, 10),
+		TLD:          p.TLD,
+		Country:      p.Country,
+		EntityType:   p.EntityType,
+		WHOISDetails: toStrings(p.WHOISDetails),
+		TLDDetails:   toStrings(p.TLDDetails),
+		CreatedAt:    p.CreatedAt,
+	}
+}
+
+// toStrings formats the values of API details as strings
+func toStrings(m map[string]any) map[string]string {
+	out := make(map[string]string, len(m))
+	for k, v := range m {
+		if v != nil {
+			out[k] = fmt.Sprint(v)
+		}
+	}
+	return out
+}
+
+// toAny converts details to API values, or nil if there are none
+func toAny(m map[string]string) map[string]any {
+	if len(m) == 0 {
+		return nil
+	}
+	out := make(map[string]any, len(m))
+	for k, v := range m {
+		out[k] = v
+	}
+	return out
+}
+
+// whoisPath returns the API path of a WHOIS profile
+func whoisPath(id string) string {
+	return "/domains/whois/" + url.PathEscape(id)
+}
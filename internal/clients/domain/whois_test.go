@@ -0,0 +1,122 @@
+/*
+Copyright 2025 Ross Golder.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package domain
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+)
+
+func testContact() map[string][]byte {
+	return map[string][]byte{
+		ContactKeyFirstName:        []byte("Jane"),
+		ContactKeyLastName:         []byte("Doe"),
+		ContactKeyAddress1:         []byte("1 Main Street"),
+		ContactKeyCity:             []byte("Vilnius"),
+		ContactKeyZip:              []byte("01100"),
+		ContactKeyPhoneCountryCode: []byte("370"),
+		ContactKeyPhone:            []byte("60000000"),
+		ContactKeyEmail:            []byte("jane@example.com\n"),
+	}
+}
+
+func TestContactDetails(t *testing.T) {
+	details, err := ContactDetails(testContact(), "individual")
+	if err != nil {
+		t.Fatalf("ContactDetails() error = %v", err)
+	}
+	if details["first_name"] != "Jane" || details["phone_cc"] != "370" || details["email"] != "jane@example.com" {
+		t.Errorf("ContactDetails() = %v, want the API fields", details)
+	}
+
+	if _, err := ContactDetails(testContact(), "organization"); err == nil {
+		t.Error("ContactDetails() error = nil, want an organization without organization to be rejected")
+	}
+
+	data := testContact()
+	data["fax"] = []byte("123")
+	if _, err := ContactDetails(data, "individual"); err == nil {
+		t.Error("ContactDetails() error = nil, want unknown keys to be rejected")
+	}
+}
+
+func TestCreateWHOISProfile(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/domains/whois" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		req := map[string]any{}
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Fatalf("decode request: %v", err)
+		}
+		if req["tld"] != "com" || req["country"] != "LT" || req["entity_type"] != "individual" {
+			t.Errorf("request = %v, want the profile", req)
+		}
+		if _, ok := req["tld_details"]; ok {
+			t.Error("request sets tld_details, want it omitted when empty")
+		}
+		_, _ = w.Write([]byte(`{"id": 42, "tld": "com", "country": "LT", "entity_type": "individual", "whois_details": {"first_name": "Jane", "zip": 1100, "state": null}}`))
+	}))
+	defer server.Close()
+
+	p, err := newTestClient(server).CreateWHOISProfile(context.Background(), &WHOISProfile{
+		TLD:          "com",
+		Country:      "LT",
+		EntityType:   "individual",
+		WHOISDetails: map[string]string{"first_name": "Jane"},
+	})
+	if err != nil {
+		t.Fatalf("CreateWHOISProfile() error = %v", err)
+	}
+	if p.ID != "42" || !reflect.DeepEqual(p.WHOISDetails, map[string]string{"first_name": "Jane", "zip": "1100"}) {
+		t.Errorf("CreateWHOISProfile() = %+v, want the created profile", p)
+	}
+}
+
+func TestListWHOISProfileUsage(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet || r.URL.Path != "/domains/whois/42/usage" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		_, _ = w.Write([]byte(`["example.org", "example.com"]`))
+	}))
+	defer server.Close()
+
+	domains, err := newTestClient(server).ListWHOISProfileUsage(context.Background(), "42")
+	if err != nil {
+		t.Fatalf("ListWHOISProfileUsage() error = %v", err)
+	}
+	if want := []string{"example.com", "example.org"}; !reflect.DeepEqual(domains, want) {
+		t.Errorf("ListWHOISProfileUsage() = %v, want %v", domains, want)
+	}
+}
+
+func TestWHOISProfileMatches(t *testing.T) {
+	desired := &WHOISProfile{TLD: "com", Country: "LT", EntityType: "individual", WHOISDetails: map[string]string{"email": "Jane@example.com"}}
+	live := &WHOISProfile{TLD: "com", Country: "lt", EntityType: "individual", WHOISDetails: map[string]string{"email": "jane@example.com", "fax": ""}}
+	if !WHOISProfileMatches(live, desired) {
+		t.Error("WHOISProfileMatches() = false, want case and extra live fields to be ignored")
+	}
+	desired.WHOISDetails["city"] = "Kaunas"
+	if WHOISProfileMatches(live, desired) {
+		t.Error("WHOISProfileMatches() = true, want a changed detail to differ")
+	}
+}
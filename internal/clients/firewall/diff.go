@@ -0,0 +1,184 @@
+/*
+Copyright 2025 Ross Golder.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package firewall
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/rossigee/provider-hostinger/apis/firewall/v1beta1"
+	"github.com/rossigee/provider-hostinger/internal/clients"
+)
+
+// Change is one rule that differs between the desired and the remote rules
+type Change struct {
+	// Index is the position of the desired rule, or -1 for extra rules
+	Index int
+	// Desired is the desired rule, or nil for extra rules
+	Desired *Rule
+	// Actual is the remote rule, or nil for missing rules
+	Actual *Rule
+}
+
+// Drift is the difference between desired and remote rules
+type Drift struct {
+	Missing  []Change
+	Extra    []Change
+	Modified []Change
+}
+
+// Empty returns true if the desired and remote rules match
+func (d Drift) Empty() bool {
+	return len(d.Missing) == 0 && len(d.Extra) == 0 && len(d.Modified) == 0
+}
+
+// String summarises the drift for events and logs
+func (d Drift) String() string {
+	parts := []string{}
+	for _, c := range d.Missing {
+		parts = append(parts, fmt.Sprintf("missing rule %d (%s)", c.Index, c.Desired.Key()))
+	}
+	for _, c := range d.Extra {
+		parts = append(parts, fmt.Sprintf("extra rule %s (%s)", c.Actual.ID, c.Actual.Key()))
+	}
+	for _, c := range d.Modified {
+		parts = append(parts, fmt.Sprintf("modified rule %d as %s (%s, want %s)", c.Index, c.Actual.ID, c.Actual.Key(), c.Desired.Key()))
+	}
+	return strings.Join(parts, "; ")
+}
+
+// Compare computes the drift between desired and remote rules in canonical
+// form. Identical rules are matched one for one. A leftover desired rule and
+// a leftover remote rule for the same direction, protocol and port are
+// reported as a modification rather than as missing and extra.
+func Compare(desired, actual []Rule) Drift {
+	remaining := map[string][]int{}
+	for i, r := range actual {
+		remaining[r.Key()] = append(remaining[r.Key()], i)
+	}
+
+	unmatched := []int{}
+	for i, r := range desired {
+		if matches := remaining[r.Key()]; len(matches) > 0 {
+			remaining[r.Key()] = matches[1:]
+			continue
+		}
+		unmatched = append(unmatched, i)
+	}
+
+	// Leftover remote rules, in their original order, grouped by identity
+	leftover := map[string][]int{}
+	for i, r := range actual {
+		if matches := remaining[r.Key()]; len(matches) > 0 && matches[0] == i {
+			remaining[r.Key()] = matches[1:]
+			leftover[identity(r)] = append(leftover[identity(r)], i)
+		}
+	}
+
+	d := Drift{Missing: []Change{}, Extra: []Change{}, Modified: []Change{}}
+	for _, i := range unmatched {
+		id := identity(desired[i])
+		if matches := leftover[id]; len(matches) > 0 {
+			leftover[id] = matches[1:]
+			d.Modified = append(d.Modified, Change{Index: i, Desired: &desired[i], Actual: &actual[matches[0]]})
+			continue
+		}
+		d.Missing = append(d.Missing, Change{Index: i, Desired: &desired[i]})
+	}
+	for i, r := range actual {
+		if matches := leftover[identity(r)]; len(matches) > 0 && matches[0] == i {
+			leftover[identity(r)] = matches[1:]
+			d.Extra = append(d.Extra, Change{Index: -1, Actual: &actual[i]})
+		}
+	}
+	return d
+}
+
+// Diff compares desired and remote rules in canonical form. It returns the
+// desired rules missing from the remote firewall and the remote rules that
+// are not desired; modified rules appear in both.
+func Diff(desired, actual []Rule) (missing, extra []Rule) {
+	d := Compare(desired, actual)
+	missing, extra = []Rule{}, []Rule{}
+	for _, c := range append(d.Missing, d.Modified...) {
+		missing = append(missing, *c.Desired)
+	}
+	for _, c := range append(d.Extra, d.Modified...) {
+		extra = append(extra, *c.Actual)
+	}
+	return missing, extra
+}
+
+// GetDrift maps a Drift to FirewallRuleDrift, or nil if there is none
+func GetDrift(d Drift) *v1beta1.FirewallRuleDrift {
+	if d.Empty() {
+		return nil
+	}
+	return &v1beta1.FirewallRuleDrift{
+		Missing:  toRuleDiffs(d.Missing),
+		Extra:    toRuleDiffs(d.Extra),
+		Modified: toRuleDiffs(d.Modified),
+	}
+}
+
+// ReconcileRules makes the rules of a remote firewall match the desired
+// rules, removing extra rules before adding missing ones. It returns whether
+// any rule changed.
+func ReconcileRules(ctx context.Context, c Client, fw *Firewall, desired []Rule) (bool, error) {
+	missing, extra := Diff(desired, fw.Rules)
+	for _, r := range extra {
+		if err := c.DeleteRule(ctx, fw.ID, r.ID); err != nil && !clients.IsNotFound(err) {
+			return false, fmt.Errorf("failed to delete firewall rule %s: %w", r.ID, err)
+		}
+	}
+	for _, r := range missing {
+		if err := c.CreateRule(ctx, fw.ID, r); err != nil {
+			return false, fmt.Errorf("failed to create firewall rule %s: %w", r.Key(), err)
+		}
+	}
+	return len(missing) > 0 || len(extra) > 0, nil
+}
+
+// identity is what a modified rule keeps in common with its desired rule
+func identity(r Rule) string {
+	return strings.Join([]string{r.Direction, r.Protocol, r.Port}, "|")
+}
+
+// toRuleDiffs maps changes to FirewallRuleDiffs
+func toRuleDiffs(changes []Change) []v1beta1.FirewallRuleDiff {
+	if len(changes) == 0 {
+		return nil
+	}
+	diffs := make([]v1beta1.FirewallRuleDiff, 0, len(changes))
+	for _, c := range changes {
+		diff := v1beta1.FirewallRuleDiff{}
+		if c.Desired != nil {
+			index := int32(c.Index)
+			spec := c.Desired.Spec()
+			diff.Index = &index
+			diff.Desired = &spec
+		}
+		if c.Actual != nil {
+			spec := c.Actual.Spec()
+			diff.RuleID = c.Actual.ID
+			diff.Actual = &spec
+		}
+		diffs = append(diffs, diff)
+	}
+	return diffs
+}
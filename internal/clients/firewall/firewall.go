@@ -0,0 +1,279 @@
+/*
+Copyright 2025 Ross Golder.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package firewall
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/rossigee/provider-hostinger/apis/firewall/v1beta1"
+	"github.com/rossigee/provider-hostinger/internal/clients"
+)
+
+// Firewall statuses reported in the observation
+const (
+	StatusSynced  = "synced"
+	StatusPending = "pending"
+)
+
+// Hostinger API rule values that differ from the provider's spelling
+const (
+	apiActionAccept = "accept"
+	apiActionDrop   = "drop"
+	apiSourceAny    = "any"
+	apiSourceCustom = "custom"
+)
+
+// Firewall represents an account-level Hostinger VPS firewall
+type Firewall struct {
+	ID        string
+	Name      string
+	Synced    bool
+	Rules     []Rule
+	CreatedAt *string
+	UpdatedAt *string
+}
+
+// firewall is the firewall as returned by the Hostinger API
+type firewall struct {
+	ID        int     `json:"id"`
+	Name      string  `json:"name"`
+	IsSynced  bool    `json:"is_synced"`
+	Rules     []rule  `json:"rules"`
+	CreatedAt *string `json:"created_at"`
+	UpdatedAt *string `json:"updated_at"`
+}
+
+// rule is a firewall rule as sent to and returned by the Hostinger API.
+// Hostinger firewalls only filter inbound traffic.
+type rule struct {
+	ID           int    `json:"id,omitempty"`
+	Action       string `json:"action"`
+	Protocol     string `json:"protocol"`
+	Port         string `json:"port"`
+	Source       string `json:"source"`
+	SourceDetail string `json:"source_detail,omitempty"`
+}
+
+// virtualMachine is the subset of a VPS instance needed to find its firewall
+type virtualMachine struct {
+	ID              int  `json:"id"`
+	FirewallGroupID *int `json:"firewall_group_id"`
+}
+
+// createRequest is the payload for creating a firewall
+type createRequest struct {
+	Name string `json:"name"`
+}
+
+// Client defines operations for managing Hostinger VPS firewalls
+type Client interface {
+	// Get retrieves a firewall and its rules
+	Get(ctx context.Context, firewallID string) (*Firewall, error)
+
+	// Create creates an empty firewall
+	Create(ctx context.Context, name string) (*Firewall, error)
+
+	// Delete deletes a firewall
+	Delete(ctx context.Context, firewallID string) error
+
+	// CreateRule adds a rule to a firewall
+	CreateRule(ctx context.Context, firewallID string, r Rule) error
+
+	// DeleteRule removes a rule from a firewall
+	DeleteRule(ctx context.Context, firewallID, ruleID string) error
+
+	// Activate activates a firewall on an instance
+	Activate(ctx context.Context, firewallID, instanceID string) error
+
+	// Deactivate deactivates a firewall on an instance
+	Deactivate(ctx context.Context, firewallID, instanceID string) error
+
+	// Sync applies the current rules of a firewall to an instance
+	Sync(ctx context.Context, firewallID, instanceID string) error
+
+	// ActiveInstances returns the IDs of the instances a firewall is
+	// active on
+	ActiveInstances(ctx context.Context, firewallID string) ([]string, error)
+}
+
+// FirewallClient implements the Client interface
+type FirewallClient struct {
+	hostingerClient *clients.HostingerClient
+}
+
+// NewFirewallClient creates a new firewall client
+func NewFirewallClient(hostingerClient *clients.HostingerClient) *FirewallClient {
+	return &FirewallClient{
+		hostingerClient: hostingerClient,
+	}
+}
+
+// Get retrieves a firewall and its rules
+func (fc *FirewallClient) Get(ctx context.Context, firewallID string) (*Firewall, error) {
+	fw := &firewall{}
+	if err := fc.hostingerClient.DoJSON(ctx, http.MethodGet, "/vps/firewall/"+firewallID, nil, fw); err != nil {
+		return nil, err
+	}
+	return toFirewall(fw)
+}
+
+// Create creates an empty firewall
+func (fc *FirewallClient) Create(ctx context.Context, name string) (*Firewall, error) {
+	fw := &firewall{}
+	if err := fc.hostingerClient.DoJSON(ctx, http.MethodPost, "/vps/firewall", &createRequest{Name: name}, fw); err != nil {
+		return nil, err
+	}
+	return toFirewall(fw)
+}
+
+// Delete deletes a firewall
+func (fc *FirewallClient) Delete(ctx context.Context, firewallID string) error {
+	return fc.hostingerClient.DoJSON(ctx, http.MethodDelete, "/vps/firewall/"+firewallID, nil, nil)
+}
+
+// CreateRule adds a rule to a firewall
+func (fc *FirewallClient) CreateRule(ctx context.Context, firewallID string, r Rule) error {
+	req, err := toAPIRule(r)
+	if err != nil {
+		return err
+	}
+	return fc.hostingerClient.DoJSON(ctx, http.MethodPost, "/vps/firewall/"+firewallID+"/rules", req, nil)
+}
+
+// DeleteRule removes a rule from a firewall
+func (fc *FirewallClient) DeleteRule(ctx context.Context, firewallID, ruleID string) error {
+	return fc.hostingerClient.DoJSON(ctx, http.MethodDelete, "/vps/firewall/"+firewallID+"/rules/"+ruleID, nil, nil)
+}
+
+// Activate activates a firewall on an instance
+func (fc *FirewallClient) Activate(ctx context.Context, firewallID, instanceID string) error {
+	return fc.hostingerClient.DoJSON(ctx, http.MethodPost, "/vps/firewall/"+firewallID+"/activate/"+instanceID, nil, nil)
+}
+
+// Deactivate deactivates a firewall on an instance
+func (fc *FirewallClient) Deactivate(ctx context.Context, firewallID, instanceID string) error {
+	return fc.hostingerClient.DoJSON(ctx, http.MethodPost, "/vps/firewall/"+firewallID+"/deactivate/"+instanceID, nil, nil)
+}
+
+// Sync applies the current rules of a firewall to an instance
+func (fc *FirewallClient) Sync(ctx context.Context, firewallID, instanceID string) error {
+	return fc.hostingerClient.DoJSON(ctx, http.MethodPost, "/vps/firewall/"+firewallID+"/sync/"+instanceID, nil, nil)
+}
+
+// ActiveInstances returns the IDs of the instances a firewall is active on
+func (fc *FirewallClient) ActiveInstances(ctx context.Context, firewallID string) ([]string, error) {
+	vms := []virtualMachine{}
+	if err := fc.hostingerClient.DoJSON(ctx, http.MethodGet, "/vps/virtual-machines", nil, &vms); err != nil {
+		return nil, err
+	}
+	instanceIDs := []string{}
+	for _, vm := range vms {
+		if vm.FirewallGroupID != nil && strconv.Itoa(*vm.FirewallGroupID) == firewallID {
+			instanceIDs = append(instanceIDs, strconv.Itoa(vm.ID))
+		}
+	}
+	return instanceIDs, nil
+}
+
+// GetObservation maps a Firewall to FirewallRuleObservation
+func GetObservation(fw *Firewall) *v1beta1.FirewallRuleObservation {
+	ruleCount := int32(len(fw.Rules))
+	status := StatusPending
+	if fw.Synced {
+		status = StatusSynced
+	}
+	return &v1beta1.FirewallRuleObservation{
+		ID:          fw.ID,
+		Status:      status,
+		AppliedDate: clients.ParseTime(fw.UpdatedAt),
+		RuleCount:   &ruleCount,
+	}
+}
+
+// toFirewall maps the API representation of a firewall to a Firewall with
+// its rules in canonical form
+func toFirewall(fw *firewall) (*Firewall, error) {
+	rules := make([]Rule, 0, len(fw.Rules))
+	for i := range fw.Rules {
+		r, err := fromAPIRule(&fw.Rules[i])
+		if err != nil {
+			return nil, fmt.Errorf("firewall %d rule %d: %w", fw.ID, fw.Rules[i].ID, err)
+		}
+		rules = append(rules, r)
+	}
+	return &Firewall{
+		ID:        strconv.Itoa(fw.ID),
+		Name:      fw.Name,
+		Synced:    fw.IsSynced,
+		Rules:     rules,
+		CreatedAt: fw.CreatedAt,
+		UpdatedAt: fw.UpdatedAt,
+	}, nil
+}
+
+// fromAPIRule maps an API rule to its canonical form
+func fromAPIRule(r *rule) (Rule, error) {
+	protocol := strings.ToLower(r.Protocol)
+	port, err := NormalizePort(protocol, r.Port)
+	if err != nil {
+		return Rule{}, err
+	}
+	source := Any
+	if r.Source == apiSourceCustom {
+		if source, err = NormalizeAddress(r.SourceDetail); err != nil {
+			return Rule{}, err
+		}
+	}
+	action := string(v1beta1.FirewallActionAllow)
+	if strings.EqualFold(r.Action, apiActionDrop) {
+		action = string(v1beta1.FirewallActionDeny)
+	}
+	return Rule{
+		ID:          strconv.Itoa(r.ID),
+		Protocol:    protocol,
+		Port:        port,
+		Direction:   string(v1beta1.FirewallDirectionInbound),
+		Action:      action,
+		Source:      source,
+		Destination: Any,
+	}, nil
+}
+
+// toAPIRule maps a canonical rule to the API representation
+func toAPIRule(r Rule) (*rule, error) {
+	if r.Direction != string(v1beta1.FirewallDirectionInbound) || r.Destination != Any {
+		return nil, fmt.Errorf("hostinger firewalls only filter inbound traffic, cannot apply rule %s", r.Key())
+	}
+	req := &rule{
+		Action:   apiActionAccept,
+		Protocol: strings.ToUpper(r.Protocol),
+		Port:     strings.Replace(r.Port, "-", ":", 1),
+		Source:   apiSourceAny,
+	}
+	if r.Action == string(v1beta1.FirewallActionDeny) {
+		req.Action = apiActionDrop
+	}
+	if r.Source != Any {
+		req.Source = apiSourceCustom
+		req.SourceDetail = r.Source
+	}
+	return req, nil
+}
@@ -0,0 +1,124 @@
+/*
+Copyright 2025 Ross Golder.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package firewall
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/rossigee/provider-hostinger/internal/clients"
+	"github.com/rossigee/provider-hostinger/internal/clients/auth"
+)
+
+func newTestClient(server *httptest.Server) *FirewallClient {
+	cfg := clients.DefaultHTTPClientConfig()
+	cfg.MaxRetries = 0
+	return NewFirewallClient(clients.NewHostingerClient(auth.NewV1KeyAuth("key", "customer", server.URL), cfg))
+}
+
+func TestGet(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/vps/firewall/42" {
+			t.Errorf("unexpected path %s", r.URL.Path)
+		}
+		_, _ = w.Write([]byte(`{
+			"id": 42, "name": "web", "is_synced": true,
+			"rules": [
+				{"id": 1, "action": "accept", "protocol": "TCP", "port": "8000:9000", "source": "any", "source_detail": "any"},
+				{"id": 2, "action": "drop", "protocol": "TCP", "port": "22", "source": "custom", "source_detail": "10.0.0.1"}
+			],
+			"updated_at": "2025-02-27T11:54:22Z"
+		}`))
+	}))
+	defer server.Close()
+
+	fw, err := newTestClient(server).Get(context.Background(), "42")
+	if err != nil {
+		t.Fatalf("Get() error = %v", err)
+	}
+	if fw.ID != "42" || !fw.Synced || len(fw.Rules) != 2 {
+		t.Fatalf("Get() = %+v, want synced firewall 42 with 2 rules", fw)
+	}
+	want := Rule{ID: "2", Protocol: "tcp", Port: "22", Direction: "inbound", Action: "deny", Source: "10.0.0.1/32", Destination: "any"}
+	if fw.Rules[1] != want {
+		t.Errorf("Get() rule = %+v, want %+v", fw.Rules[1], want)
+	}
+	if fw.Rules[0].Port != "8000-9000" {
+		t.Errorf("Get() port = %q, want 8000-9000", fw.Rules[0].Port)
+	}
+
+	obs := GetObservation(fw)
+	if obs.Status != StatusSynced || obs.RuleCount == nil || *obs.RuleCount != 2 || obs.AppliedDate == nil {
+		t.Errorf("GetObservation() = %+v, want synced with 2 rules", obs)
+	}
+}
+
+func TestCreateRule(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/vps/firewall/42/rules" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		req := rule{}
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Fatalf("cannot decode request: %v", err)
+		}
+		want := rule{Action: "accept", Protocol: "TCP", Port: "8000:9000", Source: "custom", SourceDetail: "2001:db8::/32"}
+		if req != want {
+			t.Errorf("request = %+v, want %+v", req, want)
+		}
+		_, _ = w.Write([]byte(`{}`))
+	}))
+	defer server.Close()
+
+	r := Rule{Protocol: "tcp", Port: "8000-9000", Direction: "inbound", Action: "allow", Source: "2001:db8::/32", Destination: "any"}
+	if err := newTestClient(server).CreateRule(context.Background(), "42", r); err != nil {
+		t.Fatalf("CreateRule() error = %v", err)
+	}
+}
+
+func TestCreateRule_Outbound(t *testing.T) {
+	r := Rule{Protocol: "tcp", Port: "443", Direction: "outbound", Action: "allow", Source: "any", Destination: "any"}
+	if err := NewFirewallClient(nil).CreateRule(context.Background(), "42", r); err == nil {
+		t.Error("CreateRule() error = nil, want error for an outbound rule")
+	}
+}
+
+func TestActiveInstances(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/vps/virtual-machines" {
+			t.Errorf("unexpected path %s", r.URL.Path)
+		}
+		_, _ = w.Write([]byte(`[
+			{"id": 1, "firewall_group_id": 42},
+			{"id": 2, "firewall_group_id": 7},
+			{"id": 3, "firewall_group_id": null},
+			{"id": 4, "firewall_group_id": 42}
+		]`))
+	}))
+	defer server.Close()
+
+	ids, err := newTestClient(server).ActiveInstances(context.Background(), "42")
+	if err != nil {
+		t.Fatalf("ActiveInstances() error = %v", err)
+	}
+	if len(ids) != 2 || ids[0] != "1" || ids[1] != "4" {
+		t.Errorf("ActiveInstances() = %v, want [1 4]", ids)
+	}
+}
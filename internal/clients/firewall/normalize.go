@@ -0,0 +1,183 @@
+/*
+Copyright 2025 Ross Golder.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package firewall
+
+import (
+	"fmt"
+	"net/netip"
+	"strconv"
+	"strings"
+
+	"github.com/rossigee/provider-hostinger/apis/firewall/v1beta1"
+)
+
+// Any matches every port or address
+const Any = "any"
+
+// Rule is a firewall rule in canonical form, so that equivalent spellings
+// of the same rule compare equal
+type Rule struct {
+	// ID is the ID of the remote rule, or empty for desired rules
+	ID          string
+	Protocol    string
+	Port        string
+	Direction   string
+	Action      string
+	Source      string
+	Destination string
+}
+
+// Key identifies a rule by everything but its remote ID
+func (r Rule) Key() string {
+	return strings.Join([]string{r.Direction, r.Protocol, r.Port, r.Source, r.Destination, r.Action}, "|")
+}
+
+// Spec returns the rule as a FirewallRuleSpec
+func (r Rule) Spec() v1beta1.FirewallRuleSpec {
+	action := v1beta1.FirewallAction(r.Action)
+	source := r.Source
+	destination := r.Destination
+	return v1beta1.FirewallRuleSpec{
+		Port:        r.Port,
+		Protocol:    v1beta1.FirewallProtocol(r.Protocol),
+		Direction:   v1beta1.FirewallDirection(r.Direction),
+		Action:      &action,
+		Source:      &source,
+		Destination: &destination,
+	}
+}
+
+// NormalizeRule validates a rule and returns its canonical form
+func NormalizeRule(spec v1beta1.FirewallRuleSpec) (Rule, error) {
+	protocol := strings.ToLower(string(spec.Protocol))
+	port, err := NormalizePort(protocol, spec.Port)
+	if err != nil {
+		return Rule{}, err
+	}
+
+	rule := Rule{
+		Protocol:    protocol,
+		Port:        port,
+		Direction:   strings.ToLower(string(spec.Direction)),
+		Action:      string(v1beta1.FirewallActionAllow),
+		Source:      Any,
+		Destination: Any,
+	}
+	if spec.Action != nil {
+		rule.Action = strings.ToLower(string(*spec.Action))
+	}
+	if spec.Source != nil {
+		if rule.Source, err = NormalizeAddress(*spec.Source); err != nil {
+			return Rule{}, fmt.Errorf("invalid source: %w", err)
+		}
+	}
+	if spec.Destination != nil {
+		if rule.Destination, err = NormalizeAddress(*spec.Destination); err != nil {
+			return Rule{}, fmt.Errorf("invalid destination: %w", err)
+		}
+	}
+	return rule, nil
+}
+
+// NormalizeRules validates rules and returns their canonical form
+func NormalizeRules(specs []v1beta1.FirewallRuleSpec) ([]Rule, error) {
+	rules := make([]Rule, 0, len(specs))
+	for i, spec := range specs {
+		rule, err := NormalizeRule(spec)
+		if err != nil {
+			return nil, fmt.Errorf("rule %d: %w", i, err)
+		}
+		rules = append(rules, rule)
+	}
+	return rules, nil
+}
+
+// NormalizePort returns a port in canonical form: "any", a single port, or
+// an ascending "from-to" range. Both "-" and ":" are accepted as range
+// separators, and a range covering every port is "any". Ports are not
+// allowed for icmp.
+func NormalizePort(protocol, port string) (string, error) {
+	port = strings.TrimSpace(strings.ToLower(port))
+	if port == "" || port == Any || port == "*" {
+		return Any, nil
+	}
+	if protocol == string(v1beta1.FirewallProtocolICMP) {
+		return "", fmt.Errorf("icmp rules cannot have ports, got %q", port)
+	}
+
+	from, to, isRange := strings.Cut(strings.ReplaceAll(port, ":", "-"), "-")
+	low, err := parsePort(from)
+	if err != nil {
+		return "", err
+	}
+	high := low
+	if isRange {
+		if high, err = parsePort(to); err != nil {
+			return "", err
+		}
+	}
+
+	switch {
+	case low > high:
+		return "", fmt.Errorf("port range %q is not in ascending order", port)
+	case low == 1 && high == 65535:
+		return Any, nil
+	case low == high:
+		return strconv.Itoa(low), nil
+	}
+	return fmt.Sprintf("%d-%d", low, high), nil
+}
+
+// parsePort parses a single port number between 1 and 65535
+func parsePort(s string) (int, error) {
+	port, err := strconv.Atoi(strings.TrimSpace(s))
+	if err != nil || port < 1 || port > 65535 {
+		return 0, fmt.Errorf("port %q must be a number between 1 and 65535", s)
+	}
+	return port, nil
+}
+
+// NormalizeAddress returns an IPv4 or IPv6 address or CIDR in canonical
+// form: "any", or a masked CIDR. Single addresses become /32 or /128
+// prefixes, and the 0.0.0.0/0 and ::/0 prefixes are "any".
+func NormalizeAddress(addr string) (string, error) {
+	addr = strings.TrimSpace(strings.ToLower(addr))
+	if addr == "" || addr == Any {
+		return Any, nil
+	}
+
+	if strings.Contains(addr, "/") {
+		prefix, err := netip.ParsePrefix(addr)
+		if err != nil {
+			return "", fmt.Errorf("%q is not a valid CIDR", addr)
+		}
+		if prefix.Addr().Is4In6() && prefix.Bits() >= 96 {
+			prefix = netip.PrefixFrom(prefix.Addr().Unmap(), prefix.Bits()-96)
+		}
+		if prefix.Bits() == 0 {
+			return Any, nil
+		}
+		return prefix.Masked().String(), nil
+	}
+
+	ip, err := netip.ParseAddr(addr)
+	if err != nil {
+		return "", fmt.Errorf("%q is not a valid IP address or CIDR", addr)
+	}
+	ip = ip.Unmap()
+	return netip.PrefixFrom(ip, ip.BitLen()).String(), nil
+}
@@ -0,0 +1,161 @@
+/*
+Copyright 2025 Ross Golder.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package firewall
+
+import (
+	"testing"
+
+	"github.com/rossigee/provider-hostinger/apis/firewall/v1beta1"
+)
+
+func TestNormalizePort(t *testing.T) {
+	cases := map[string]struct {
+		protocol string
+		port     string
+		want     string
+		wantErr  bool
+	}{
+		"Single":          {protocol: "tcp", port: "80", want: "80"},
+		"Range":           {protocol: "tcp", port: "8000-9000", want: "8000-9000"},
+		"ColonRange":      {protocol: "udp", port: "8000:9000", want: "8000-9000"},
+		"SinglePortRange": {protocol: "tcp", port: "443-443", want: "443"},
+		"FullRange":       {protocol: "tcp", port: "1-65535", want: "any"},
+		"Any":             {protocol: "tcp", port: "ANY", want: "any"},
+		"Empty":           {protocol: "tcp", port: "", want: "any"},
+		"LeadingZero":     {protocol: "tcp", port: "0080", want: "80"},
+		"ICMPNoPort":      {protocol: "icmp", port: "", want: "any"},
+		"ICMPWithPort":    {protocol: "icmp", port: "80", wantErr: true},
+		"Zero":            {protocol: "tcp", port: "0", wantErr: true},
+		"TooHigh":         {protocol: "tcp", port: "65536", wantErr: true},
+		"Descending":      {protocol: "tcp", port: "9000-8000", wantErr: true},
+		"NotANumber":      {protocol: "tcp", port: "http", wantErr: true},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, err := NormalizePort(tc.protocol, tc.port)
+			if (err != nil) != tc.wantErr {
+				t.Fatalf("NormalizePort() error = %v, wantErr %v", err, tc.wantErr)
+			}
+			if got != tc.want {
+				t.Errorf("NormalizePort() = %q, want %q", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestNormalizeAddress(t *testing.T) {
+	cases := map[string]struct {
+		addr    string
+		want    string
+		wantErr bool
+	}{
+		"Any":          {addr: "any", want: "any"},
+		"IPv4":         {addr: "203.0.113.10", want: "203.0.113.10/32"},
+		"IPv4CIDR":     {addr: "203.0.113.10/24", want: "203.0.113.0/24"},
+		"IPv4AnyCIDR":  {addr: "0.0.0.0/0", want: "any"},
+		"IPv6AnyCIDR":  {addr: "::/0", want: "any"},
+		"IPv6":         {addr: "2001:DB8::1", want: "2001:db8::1/128"},
+		"IPv6CIDR":     {addr: "2001:db8:0:0::/32", want: "2001:db8::/32"},
+		"IPv4Mapped":   {addr: "::ffff:203.0.113.10", want: "203.0.113.10/32"},
+		"InvalidIP":    {addr: "203.0.113.300", wantErr: true},
+		"InvalidCIDR":  {addr: "203.0.113.0/33", wantErr: true},
+		"NotAnAddress": {addr: "example.com", wantErr: true},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, err := NormalizeAddress(tc.addr)
+			if (err != nil) != tc.wantErr {
+				t.Fatalf("NormalizeAddress() error = %v, wantErr %v", err, tc.wantErr)
+			}
+			if got != tc.want {
+				t.Errorf("NormalizeAddress() = %q, want %q", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestNormalizeRule(t *testing.T) {
+	source := "10.0.0.1"
+	deny := v1beta1.FirewallActionDeny
+	got, err := NormalizeRule(v1beta1.FirewallRuleSpec{
+		Port:      "22-22",
+		Protocol:  v1beta1.FirewallProtocolTCP,
+		Direction: v1beta1.FirewallDirectionInbound,
+		Action:    &deny,
+		Source:    &source,
+	})
+	if err != nil {
+		t.Fatalf("NormalizeRule() error = %v", err)
+	}
+	want := Rule{Protocol: "tcp", Port: "22", Direction: "inbound", Action: "deny", Source: "10.0.0.1/32", Destination: "any"}
+	if got != want {
+		t.Errorf("NormalizeRule() = %+v, want %+v", got, want)
+	}
+
+	if _, err := NormalizeRules([]v1beta1.FirewallRuleSpec{{Protocol: v1beta1.FirewallProtocolICMP, Port: "8", Direction: v1beta1.FirewallDirectionInbound}}); err == nil {
+		t.Error("NormalizeRules() error = nil, want error for icmp rule with a port")
+	}
+}
+
+func TestDiff(t *testing.T) {
+	ssh := Rule{Protocol: "tcp", Port: "22", Direction: "inbound", Action: "allow", Source: "10.0.0.0/8", Destination: "any"}
+	http := Rule{Protocol: "tcp", Port: "80", Direction: "inbound", Action: "allow", Source: "any", Destination: "any"}
+	dns := Rule{Protocol: "udp", Port: "53", Direction: "inbound", Action: "allow", Source: "any", Destination: "any"}
+
+	remoteSSH, remoteDNS, remoteDNS2 := ssh, dns, dns
+	remoteSSH.ID, remoteDNS.ID, remoteDNS2.ID = "1", "2", "3"
+
+	missing, extra := Diff([]Rule{ssh, http, dns}, []Rule{remoteSSH, remoteDNS, remoteDNS2})
+	if len(missing) != 1 || missing[0].Key() != http.Key() {
+		t.Errorf("Diff() missing = %+v, want the http rule", missing)
+	}
+	if len(extra) != 1 || extra[0].ID != "3" {
+		t.Errorf("Diff() extra = %+v, want the duplicate dns rule", extra)
+	}
+}
+
+func TestCompare(t *testing.T) {
+	ssh := Rule{Protocol: "tcp", Port: "22", Direction: "inbound", Action: "allow", Source: "10.0.0.0/8", Destination: "any"}
+	http := Rule{Protocol: "tcp", Port: "80", Direction: "inbound", Action: "allow", Source: "any", Destination: "any"}
+	dns := Rule{Protocol: "udp", Port: "53", Direction: "inbound", Action: "allow", Source: "any", Destination: "any"}
+
+	remoteSSH, remoteHTTP := ssh, http
+	remoteSSH.ID, remoteSSH.Source = "1", "192.168.0.0/16"
+	remoteHTTP.ID = "2"
+	remoteDNS := Rule{ID: "3", Protocol: "udp", Port: "5353", Direction: "inbound", Action: "allow", Source: "any", Destination: "any"}
+
+	d := Compare([]Rule{ssh, http, dns}, []Rule{remoteSSH, remoteHTTP, remoteDNS})
+	if len(d.Modified) != 1 || d.Modified[0].Index != 0 || d.Modified[0].Actual.ID != "1" {
+		t.Errorf("Compare() modified = %+v, want ssh rule 0 modified as rule 1", d.Modified)
+	}
+	if len(d.Missing) != 1 || d.Missing[0].Index != 2 {
+		t.Errorf("Compare() missing = %+v, want dns rule 2", d.Missing)
+	}
+	if len(d.Extra) != 1 || d.Extra[0].Actual.ID != "3" {
+		t.Errorf("Compare() extra = %+v, want rule 3", d.Extra)
+	}
+	if d.Empty() {
+		t.Error("Compare() drift should not be empty")
+	}
+
+	if d := Compare([]Rule{ssh}, []Rule{remoteSSH}); GetDrift(d).Modified[0].RuleID != "1" {
+		t.Errorf("GetDrift() = %+v, want modified rule 1", GetDrift(d))
+	}
+	if GetDrift(Compare([]Rule{http}, []Rule{remoteHTTP})) != nil {
+		t.Error("GetDrift() should be nil when rules match")
+	}
+}
@@ -0,0 +1,215 @@
+/*
+Copyright 2025 Ross Golder.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package firewall
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/rossigee/provider-hostinger/apis/firewall/v1beta1"
+	instancev1beta1 "github.com/rossigee/provider-hostinger/apis/instance/v1beta1"
+	"github.com/rossigee/provider-hostinger/internal/clients"
+)
+
+// ExpandSources replaces each rule whose source comes from another object
+// with one rule per address read from that object. It returns the expanded
+// rules and, for each of them, the index of the rule it came from.
+func ExpandSources(ctx context.Context, kube client.Reader, namespace string, specs []v1beta1.FirewallRuleSpec) ([]v1beta1.FirewallRuleSpec, []int, error) {
+	expanded := make([]v1beta1.FirewallRuleSpec, 0, len(specs))
+	origin := make([]int, 0, len(specs))
+	for i, spec := range specs {
+		if spec.SourceFrom == nil {
+			expanded = append(expanded, spec)
+			origin = append(origin, i)
+			continue
+		}
+
+		sources, err := resolveSources(ctx, kube, namespace, spec.SourceFrom)
+		if err != nil {
+			return nil, nil, fmt.Errorf("rule %d: %w", i, err)
+		}
+		for _, source := range sources {
+			r := *spec.DeepCopy()
+			r.SourceFrom = nil
+			r.Source = &source
+			expanded = append(expanded, r)
+			origin = append(origin, i)
+		}
+	}
+	return expanded, origin, nil
+}
+
+// DesiredRules expands rule sources taken from other objects and returns the
+// rules in canonical form, with the index of the spec rule each came from.
+func DesiredRules(ctx context.Context, kube client.Reader, namespace string, specs []v1beta1.FirewallRuleSpec) ([]Rule, []int, error) {
+	expanded, origin, err := ExpandSources(ctx, kube, namespace, specs)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to resolve firewall rule sources: %w", err)
+	}
+	desired, err := NormalizeRules(expanded)
+	if err != nil {
+		return nil, nil, fmt.Errorf("invalid firewall rules: %w", err)
+	}
+	return desired, origin, nil
+}
+
+// SourceUsers returns a handler.MapFunc that maps a ConfigMap or Instance to
+// the Firewalls or FirewallRules, listed into lists made by newList, with
+// rules that take their sources from it, so that changes are applied without
+// waiting for the next poll.
+func SourceUsers(kube client.Reader, newList func() client.ObjectList) handler.MapFunc {
+	return func(ctx context.Context, obj client.Object) []reconcile.Request {
+		list := newList()
+		if err := kube.List(ctx, list); err != nil {
+			return nil
+		}
+		items, err := apimeta.ExtractList(list)
+		if err != nil {
+			return nil
+		}
+		requests := []reconcile.Request{}
+		for _, item := range items {
+			o, ok := item.(client.Object)
+			if ok && UsesSource(rules(item), o.GetNamespace(), obj) {
+				requests = append(requests, reconcile.Request{NamespacedName: types.NamespacedName{Namespace: o.GetNamespace(), Name: o.GetName()}})
+			}
+		}
+		return requests
+	}
+}
+
+// rules returns the rules of a Firewall or FirewallRule
+func rules(obj runtime.Object) []v1beta1.FirewallRuleSpec {
+	switch o := obj.(type) {
+	case *v1beta1.Firewall:
+		return o.Spec.ForProvider.Rules
+	case *v1beta1.FirewallRule:
+		return o.Spec.ForProvider.Rules
+	}
+	return nil
+}
+
+// UsesSource reports whether any of the rules of a resource in namespace take
+// their sources from obj, a ConfigMap or an Instance.
+func UsesSource(specs []v1beta1.FirewallRuleSpec, namespace string, obj client.Object) bool {
+	for _, spec := range specs {
+		from := spec.SourceFrom
+		if from == nil {
+			continue
+		}
+		switch obj.(type) {
+		case *corev1.ConfigMap:
+			ref := from.ConfigMapKeyRef
+			if ref != nil && ref.Name == obj.GetName() && clients.DefaultNamespace(ref.Namespace, namespace) == obj.GetNamespace() {
+				return true
+			}
+		case *instancev1beta1.Instance:
+			ref := from.InstanceRef
+			if ref != nil && ref.Name == obj.GetName() && clients.DefaultNamespace(ref.Namespace, namespace) == obj.GetNamespace() {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// ParseSources splits a list of addresses or CIDRs separated by commas or
+// whitespace, skipping comment lines and duplicates
+func ParseSources(value string) []string {
+	sources := []string{}
+	seen := map[string]bool{}
+	for _, line := range strings.Split(value, "\n") {
+		if strings.HasPrefix(strings.TrimSpace(line), "#") {
+			continue
+		}
+		fields := strings.FieldsFunc(line, func(r rune) bool {
+			return r == ',' || r == ' ' || r == '\t' || r == '\r'
+		})
+		for _, f := range fields {
+			if !seen[f] {
+				seen[f] = true
+				sources = append(sources, f)
+			}
+		}
+	}
+	return sources
+}
+
+// resolveSources reads the addresses selected by a FirewallRuleSourceFrom
+func resolveSources(ctx context.Context, kube client.Reader, namespace string, from *v1beta1.FirewallRuleSourceFrom) ([]string, error) {
+	var sources []string
+	var origin string
+
+	switch {
+	case from.ConfigMapKeyRef != nil:
+		ref := from.ConfigMapKeyRef
+		key := types.NamespacedName{Namespace: clients.DefaultNamespace(ref.Namespace, namespace), Name: ref.Name}
+		origin = fmt.Sprintf("configmap %s key %s", key, ref.Key)
+
+		cm := &corev1.ConfigMap{}
+		if err := kube.Get(ctx, key, cm); err != nil {
+			return nil, fmt.Errorf("failed to get %s: %w", origin, err)
+		}
+		value, ok := cm.Data[ref.Key]
+		if !ok {
+			return nil, fmt.Errorf("%s not found", origin)
+		}
+		sources = ParseSources(value)
+		// A rule without sources would be dropped, so an empty list must
+		// not silently remove a deny rule
+		if len(sources) == 0 {
+			return nil, fmt.Errorf("%s has no addresses", origin)
+		}
+
+	case from.InstanceRef != nil:
+		ref := from.InstanceRef
+		key := types.NamespacedName{Namespace: clients.DefaultNamespace(ref.Namespace, namespace), Name: ref.Name}
+		origin = fmt.Sprintf("instance %s", key)
+
+		inst := &instancev1beta1.Instance{}
+		if err := kube.Get(ctx, key, inst); err != nil {
+			return nil, fmt.Errorf("failed to get %s: %w", origin, err)
+		}
+		for _, ip := range []string{inst.Status.AtProvider.IPAddress, inst.Status.AtProvider.IPv6Address} {
+			if ip != "" {
+				sources = append(sources, ip)
+			}
+		}
+		if len(sources) == 0 {
+			return nil, fmt.Errorf("%s has no IP address yet", origin)
+		}
+
+	default:
+		return nil, fmt.Errorf("sourceFrom must set configMapKeyRef or instanceRef")
+	}
+
+	for _, source := range sources {
+		if _, err := NormalizeAddress(source); err != nil {
+			return nil, fmt.Errorf("%s: %w", origin, err)
+		}
+	}
+	return sources, nil
+}
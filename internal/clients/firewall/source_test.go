@@ -0,0 +1,170 @@
+/*
+Copyright 2025 Ross Golder.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package firewall
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+
+	"github.com/rossigee/provider-hostinger/apis/firewall/v1beta1"
+	instancev1beta1 "github.com/rossigee/provider-hostinger/apis/instance/v1beta1"
+)
+
+func newTestKube(t *testing.T, objs ...client.Object) client.Client {
+	s := runtime.NewScheme()
+	if err := clientgoscheme.AddToScheme(s); err != nil {
+		t.Fatalf("AddToScheme() error = %v", err)
+	}
+	if err := instancev1beta1.SchemeBuilder.AddToScheme(s); err != nil {
+		t.Fatalf("AddToScheme() error = %v", err)
+	}
+	if err := v1beta1.SchemeBuilder.AddToScheme(s); err != nil {
+		t.Fatalf("AddToScheme() error = %v", err)
+	}
+	return fake.NewClientBuilder().WithScheme(s).WithObjects(objs...).Build()
+}
+
+func TestParseSources(t *testing.T) {
+	got := ParseSources("# office\n203.0.113.0/24, 198.51.100.7\n\n2001:db8::/32 203.0.113.0/24\n")
+	want := []string{"203.0.113.0/24", "198.51.100.7", "2001:db8::/32"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("ParseSources() = %v, want %v", got, want)
+	}
+}
+
+func TestExpandSources(t *testing.T) {
+	cm := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "egress"},
+		Data:       map[string]string{"cidrs": "203.0.113.0/24\n198.51.100.7"},
+	}
+	inst := &instancev1beta1.Instance{ObjectMeta: metav1.ObjectMeta{Namespace: "other", Name: "web"}}
+	inst.Status.AtProvider.IPAddress = "192.0.2.10"
+	kube := newTestKube(t, cm, inst)
+
+	anySource := "any"
+	specs := []v1beta1.FirewallRuleSpec{
+		{Port: "80", Protocol: v1beta1.FirewallProtocolTCP, Direction: v1beta1.FirewallDirectionInbound, Source: &anySource},
+		{Port: "22", Protocol: v1beta1.FirewallProtocolTCP, Direction: v1beta1.FirewallDirectionInbound, SourceFrom: &v1beta1.FirewallRuleSourceFrom{
+			ConfigMapKeyRef: &v1beta1.ConfigMapKeySelector{Name: "egress", Key: "cidrs"},
+		}},
+		{Port: "5432", Protocol: v1beta1.FirewallProtocolTCP, Direction: v1beta1.FirewallDirectionInbound, SourceFrom: &v1beta1.FirewallRuleSourceFrom{
+			InstanceRef: &xpv1.NamespacedReference{Name: "web", Namespace: "other"},
+		}},
+	}
+
+	expanded, origin, err := ExpandSources(context.Background(), kube, "default", specs)
+	if err != nil {
+		t.Fatalf("ExpandSources() error = %v", err)
+	}
+	sources := []string{}
+	for _, r := range expanded {
+		if r.SourceFrom != nil {
+			t.Errorf("expanded rule %+v should not have sourceFrom", r)
+		}
+		sources = append(sources, *r.Source)
+	}
+	if want := []string{"any", "203.0.113.0/24", "198.51.100.7", "192.0.2.10"}; !reflect.DeepEqual(sources, want) {
+		t.Errorf("ExpandSources() sources = %v, want %v", sources, want)
+	}
+	if want := []int{0, 1, 1, 2}; !reflect.DeepEqual(origin, want) {
+		t.Errorf("ExpandSources() origin = %v, want %v", origin, want)
+	}
+	if specs[1].Source != nil {
+		t.Error("ExpandSources() should not modify the spec rules")
+	}
+}
+
+func TestExpandSources_Errors(t *testing.T) {
+	cm := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "egress"},
+		Data:       map[string]string{"cidrs": "203.0.113.0/33", "empty": "# no addresses yet\n"},
+	}
+	pending := &instancev1beta1.Instance{ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "pending"}}
+	kube := newTestKube(t, cm, pending)
+
+	cases := map[string]v1beta1.FirewallRuleSourceFrom{
+		"InvalidCIDR":      {ConfigMapKeyRef: &v1beta1.ConfigMapKeySelector{Name: "egress", Key: "cidrs"}},
+		"NoAddresses":      {ConfigMapKeyRef: &v1beta1.ConfigMapKeySelector{Name: "egress", Key: "empty"}},
+		"MissingKey":       {ConfigMapKeyRef: &v1beta1.ConfigMapKeySelector{Name: "egress", Key: "other"}},
+		"MissingConfigMap": {ConfigMapKeyRef: &v1beta1.ConfigMapKeySelector{Name: "absent", Key: "cidrs"}},
+		"InstanceNoIP":     {InstanceRef: &xpv1.NamespacedReference{Name: "pending"}},
+		"MissingInstance":  {InstanceRef: &xpv1.NamespacedReference{Name: "absent"}},
+	}
+	for name, from := range cases {
+		t.Run(name, func(t *testing.T) {
+			specs := []v1beta1.FirewallRuleSpec{{Port: "22", Protocol: v1beta1.FirewallProtocolTCP, Direction: v1beta1.FirewallDirectionInbound, SourceFrom: &from}}
+			if _, _, err := ExpandSources(context.Background(), kube, "default", specs); err == nil {
+				t.Error("ExpandSources() error = nil, want error")
+			}
+		})
+	}
+}
+
+func TestUsesSource(t *testing.T) {
+	specs := []v1beta1.FirewallRuleSpec{
+		{Port: "22", Protocol: v1beta1.FirewallProtocolTCP, Direction: v1beta1.FirewallDirectionInbound, SourceFrom: &v1beta1.FirewallRuleSourceFrom{
+			ConfigMapKeyRef: &v1beta1.ConfigMapKeySelector{Name: "egress", Key: "cidrs"},
+		}},
+		{Port: "5432", Protocol: v1beta1.FirewallProtocolTCP, Direction: v1beta1.FirewallDirectionInbound, SourceFrom: &v1beta1.FirewallRuleSourceFrom{
+			InstanceRef: &xpv1.NamespacedReference{Name: "web", Namespace: "other"},
+		}},
+	}
+
+	cases := map[string]struct {
+		obj  client.Object
+		want bool
+	}{
+		"ConfigMap":               {obj: &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "egress"}}, want: true},
+		"ConfigMapOtherNamespace": {obj: &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Namespace: "other", Name: "egress"}}},
+		"Instance":                {obj: &instancev1beta1.Instance{ObjectMeta: metav1.ObjectMeta{Namespace: "other", Name: "web"}}, want: true},
+		"OtherInstance":           {obj: &instancev1beta1.Instance{ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "web"}}},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if got := UsesSource(specs, "default", tc.obj); got != tc.want {
+				t.Errorf("UsesSource() = %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestSourceUsers(t *testing.T) {
+	user := &v1beta1.Firewall{ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "office"}}
+	user.Spec.ForProvider.Rules = []v1beta1.FirewallRuleSpec{
+		{Port: "22", Protocol: v1beta1.FirewallProtocolTCP, Direction: v1beta1.FirewallDirectionInbound, SourceFrom: &v1beta1.FirewallRuleSourceFrom{
+			ConfigMapKeyRef: &v1beta1.ConfigMapKeySelector{Name: "egress", Key: "cidrs"},
+		}},
+	}
+	other := &v1beta1.Firewall{ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "web"}}
+	kube := newTestKube(t, user, other)
+
+	mapFn := SourceUsers(kube, func() client.ObjectList { return &v1beta1.FirewallList{} })
+	got := mapFn(context.Background(), &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "egress"}})
+	if len(got) != 1 || got[0].Name != "office" || got[0].Namespace != "default" {
+		t.Errorf("SourceUsers() = %v, want default/office", got)
+	}
+}
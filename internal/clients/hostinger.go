@@ -17,9 +17,13 @@ limitations under the License.
 package clients
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
 	"fmt"
+	"io"
 	"net/http"
+	"net/http/httptrace"
 	"time"
 
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -91,6 +95,15 @@ func (cf *ClientFactory) CreateHostingerClient(ctx context.Context, config *v1be
 	}, nil
 }
 
+// NewHostingerClient creates a Hostinger API client from an existing authenticator
+func NewHostingerClient(authenticator auth.Authenticator, cfg HTTPClientConfig) *HostingerClient {
+	return &HostingerClient{
+		authenticator: authenticator,
+		httpClient:    &http.Client{Timeout: cfg.Timeout},
+		config:        cfg,
+	}
+}
+
 // GetAuthenticator returns the configured authenticator
 func (hc *HostingerClient) GetAuthenticator() auth.Authenticator {
 	return hc.authenticator
@@ -132,7 +145,10 @@ func (hc *HostingerClient) PrepareRequest(ctx context.Context, req *http.Request
 	return nil
 }
 
-// Do performs an HTTP request with error handling and retry logic
+// Do performs an HTTP request with error handling and retry logic.
+// Idempotent requests are retried on transport errors, 429 and 5xx. Other
+// requests, such as purchases, may already have succeeded when the response
+// is lost, so they are only retried on 429 or when no connection was made.
 func (hc *HostingerClient) Do(ctx context.Context, req *http.Request) (*http.Response, error) {
 	// Prepare request with authentication
 	if err := hc.PrepareRequest(ctx, req); err != nil {
@@ -142,24 +158,40 @@ func (hc *HostingerClient) Do(ctx context.Context, req *http.Request) (*http.Res
 	// Perform request with retry logic
 	var resp *http.Response
 	var err error
+	idempotent := isIdempotent(req.Method)
 
 	for attempt := 0; attempt <= hc.config.MaxRetries; attempt++ {
-		resp, err = hc.httpClient.Do(req)
+		// Rewind the request body before retrying
+		if attempt > 0 && req.GetBody != nil {
+			body, bodyErr := req.GetBody()
+			if bodyErr != nil {
+				return nil, fmt.Errorf("failed to rewind request body: %w", bodyErr)
+			}
+			req.Body = body
+		}
+
+		connected := false
+		trace := &httptrace.ClientTrace{GotConn: func(httptrace.GotConnInfo) { connected = true }}
+		resp, err = hc.httpClient.Do(req.WithContext(httptrace.WithClientTrace(req.Context(), trace)))
 		if err != nil {
-			if attempt < hc.config.MaxRetries {
-				time.Sleep(hc.config.RetryWaitTime * time.Duration(attempt+1))
+			if attempt < hc.config.MaxRetries && (idempotent || !connected) {
+				if waitErr := hc.wait(ctx, attempt); waitErr != nil {
+					return nil, waitErr
+				}
 				continue
 			}
-			return nil, fmt.Errorf("request failed after %d retries: %w", hc.config.MaxRetries, err)
+			return nil, fmt.Errorf("request failed after %d attempts: %w", attempt+1, err)
 		}
 
 		// Check if response indicates a retryable error
-		if resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode >= http.StatusInternalServerError {
+		retryable := resp.StatusCode == http.StatusTooManyRequests ||
+			(idempotent && resp.StatusCode >= http.StatusInternalServerError)
+		if retryable && attempt < hc.config.MaxRetries {
 			_ = resp.Body.Close()
-			if attempt < hc.config.MaxRetries {
-				time.Sleep(hc.config.RetryWaitTime * time.Duration(attempt+1))
-				continue
+			if waitErr := hc.wait(ctx, attempt); waitErr != nil {
+				return nil, waitErr
 			}
+			continue
 		}
 
 		// Success or non-retryable error
@@ -169,6 +201,91 @@ func (hc *HostingerClient) Do(ctx context.Context, req *http.Request) (*http.Res
 	return resp, nil
 }
 
+// wait sleeps before the retry following attempt, returning early with an
+// error if ctx is done
+func (hc *HostingerClient) wait(ctx context.Context, attempt int) error {
+	timer := time.NewTimer(hc.config.RetryWaitTime * time.Duration(attempt+1))
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// isIdempotent reports whether repeating a request with method has the same
+// effect as sending it once
+func isIdempotent(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return true
+	}
+	return false
+}
+
+// DoJSON performs a JSON request against a path relative to the API endpoint.
+// A non-nil body is encoded as the request payload and a non-nil out receives
+// the decoded response. Non-2xx responses are returned as *HostingerError.
+func (hc *HostingerClient) DoJSON(ctx context.Context, method, path string, body, out interface{}) error {
+	var reader io.Reader
+	if body != nil {
+		payload, err := json.Marshal(body)
+		if err != nil {
+			return fmt.Errorf("failed to encode request body: %w", err)
+		}
+		reader = bytes.NewReader(payload)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, hc.GetEndpoint()+path, reader)
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	resp, err := hc.Do(ctx, req)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return fmt.Errorf("failed to read response body: %w", err)
+	}
+
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		return ClassifyError(resp.StatusCode, errorMessage(data, resp.Status))
+	}
+
+	if out == nil || len(data) == 0 {
+		return nil
+	}
+	if err := json.Unmarshal(data, out); err != nil {
+		return fmt.Errorf("failed to decode response body: %w", err)
+	}
+	return nil
+}
+
+// errorMessage extracts the message from a Hostinger error response body
+func errorMessage(data []byte, fallback string) string {
+	var apiErr struct {
+		Message string `json:"message"`
+		Error   string `json:"error"`
+	}
+	if err := json.Unmarshal(data, &apiErr); err == nil {
+		if apiErr.Message != "" {
+			return apiErr.Message
+		}
+		if apiErr.Error != "" {
+			return apiErr.Error
+		}
+	}
+	return fallback
+}
+
 // GetProviderConfig returns the ProviderConfig used to create this client
 func (hc *HostingerClient) GetProviderConfig() *v1beta1.ProviderConfig {
 	return hc.providerCfg
@@ -670,3 +670,190 @@ func TestDo_RequestModificationPreserved(t *testing.T) {
 		}
 	}
 }
+
+func TestDo_RetryRewindsBody(t *testing.T) {
+	callCount := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		callCount++
+		body, _ := io.ReadAll(r.Body)
+		if string(body) != `{"hostname":"vps.example.com"}` {
+			t.Errorf("attempt %d body = %q, want full payload", callCount, string(body))
+		}
+		if callCount < 2 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	cfg := HTTPClientConfig{
+		Timeout:       10 * time.Second,
+		MaxRetries:    2,
+		RetryWaitTime: 10 * time.Millisecond,
+		UserAgent:     "test-agent",
+	}
+	client := NewHostingerClient(&MockAuthenticator{authHeader: "Bearer test-token"}, cfg)
+
+	req, _ := http.NewRequest("PUT", server.URL+"/instances", strings.NewReader(`{"hostname":"vps.example.com"}`))
+	resp, err := client.Do(context.Background(), req)
+	if err != nil {
+		t.Fatalf("Do() error = %v, want nil", err)
+	}
+	_ = resp.Body.Close()
+
+	if callCount != 2 {
+		t.Errorf("callCount = %v, want 2", callCount)
+	}
+}
+
+func TestDoJSON_DecodesResponse(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/vps/virtual-machines/123" {
+			t.Errorf("path = %v, want /vps/virtual-machines/123", r.URL.Path)
+		}
+		if r.Header.Get("Content-Type") != "application/json" {
+			t.Errorf("Content-Type = %v, want application/json", r.Header.Get("Content-Type"))
+		}
+		_, _ = w.Write([]byte(`{"id": 123, "hostname": "vps.example.com"}`))
+	}))
+	defer server.Close()
+
+	client := NewHostingerClient(&MockAuthenticator{authHeader: "Bearer test-token", endpoint: server.URL}, DefaultHTTPClientConfig())
+
+	var out struct {
+		ID       int    `json:"id"`
+		Hostname string `json:"hostname"`
+	}
+	in := map[string]string{"hostname": "vps.example.com"}
+	if err := client.DoJSON(context.Background(), http.MethodPut, "/vps/virtual-machines/123", in, &out); err != nil {
+		t.Fatalf("DoJSON() error = %v", err)
+	}
+	if out.ID != 123 || out.Hostname != "vps.example.com" {
+		t.Errorf("DoJSON() decoded %+v", out)
+	}
+}
+
+func TestDoJSON_ClassifiesError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = w.Write([]byte(`{"message": "Virtual machine not found"}`))
+	}))
+	defer server.Close()
+
+	client := NewHostingerClient(&MockAuthenticator{authHeader: "Bearer test-token", endpoint: server.URL}, DefaultHTTPClientConfig())
+
+	err := client.DoJSON(context.Background(), http.MethodGet, "/vps/virtual-machines/123", nil, nil)
+	if !IsNotFound(err) {
+		t.Fatalf("DoJSON() error = %v, want NotFound", err)
+	}
+	if !strings.Contains(err.Error(), "Virtual machine not found") {
+		t.Errorf("DoJSON() error = %v, want API message", err)
+	}
+}
+
+func TestDo_NoRetryOfPOSTOn5xx(t *testing.T) {
+	callCount := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		callCount++
+		w.WriteHeader(http.StatusBadGateway)
+	}))
+	defer server.Close()
+
+	cfg := HTTPClientConfig{
+		Timeout:       10 * time.Second,
+		MaxRetries:    3,
+		RetryWaitTime: 10 * time.Millisecond,
+		UserAgent:     "test-agent",
+	}
+	client := NewHostingerClient(&MockAuthenticator{authHeader: "Bearer test-token"}, cfg)
+
+	req, _ := http.NewRequest("POST", server.URL+"/domains/portfolio", strings.NewReader(`{"domain":"example.com"}`))
+	resp, err := client.Do(context.Background(), req)
+	if err != nil {
+		t.Fatalf("Do() error = %v, want nil", err)
+	}
+	_ = resp.Body.Close()
+
+	if callCount != 1 {
+		t.Errorf("callCount = %v, want a POST that may have succeeded not to be repeated", callCount)
+	}
+}
+
+func TestDo_RetryOfPOSTOn429(t *testing.T) {
+	callCount := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		callCount++
+		if callCount < 2 {
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	cfg := HTTPClientConfig{
+		Timeout:       10 * time.Second,
+		MaxRetries:    3,
+		RetryWaitTime: 10 * time.Millisecond,
+		UserAgent:     "test-agent",
+	}
+	client := NewHostingerClient(&MockAuthenticator{authHeader: "Bearer test-token"}, cfg)
+
+	req, _ := http.NewRequest("POST", server.URL+"/domains/portfolio", strings.NewReader(`{"domain":"example.com"}`))
+	resp, err := client.Do(context.Background(), req)
+	if err != nil {
+		t.Fatalf("Do() error = %v, want nil", err)
+	}
+	_ = resp.Body.Close()
+
+	if callCount != 2 {
+		t.Errorf("callCount = %v, want a rejected POST to be retried", callCount)
+	}
+}
+
+func TestDo_RetryOfPOSTBeforeConnection(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	url := server.URL
+	server.Close()
+
+	cfg := HTTPClientConfig{
+		Timeout:       time.Second,
+		MaxRetries:    2,
+		RetryWaitTime: time.Millisecond,
+		UserAgent:     "test-agent",
+	}
+	client := NewHostingerClient(&MockAuthenticator{authHeader: "Bearer test-token"}, cfg)
+
+	req, _ := http.NewRequest("POST", url+"/domains/portfolio", strings.NewReader(`{}`))
+	_, err := client.Do(context.Background(), req)
+	if err == nil || !strings.Contains(err.Error(), "after 3 attempts") {
+		t.Errorf("Do() error = %v, want a refused connection to be retried", err)
+	}
+}
+
+func TestDo_RetryWaitHonoursContext(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	cfg := HTTPClientConfig{
+		Timeout:       10 * time.Second,
+		MaxRetries:    3,
+		RetryWaitTime: time.Hour,
+		UserAgent:     "test-agent",
+	}
+	client := NewHostingerClient(&MockAuthenticator{authHeader: "Bearer test-token"}, cfg)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	req, _ := http.NewRequestWithContext(ctx, "GET", server.URL+"/instances", nil)
+	start := time.Now()
+	if _, err := client.Do(ctx, req); err == nil {
+		t.Error("Do() error = nil, want the cancelled context")
+	}
+	if time.Since(start) > 5*time.Second {
+		t.Error("Do() waited past the context deadline")
+	}
+}
@@ -0,0 +1,69 @@
+/*
+Copyright 2025 Ross Golder.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package instance
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+// DataCenter is a Hostinger location that VPS instances can be placed in
+type DataCenter struct {
+	ID        int    `json:"id"`
+	Name      string `json:"name"`
+	Location  string `json:"location"`
+	City      string `json:"city"`
+	Continent string `json:"continent"`
+}
+
+// ListDataCenters returns the data centers available for new VPS instances
+func (ic *InstanceClient) ListDataCenters(ctx context.Context) ([]*DataCenter, error) {
+	dataCenters := []*DataCenter{}
+	if err := ic.hostingerClient.DoJSON(ctx, http.MethodGet, "/vps/data-centers", nil, &dataCenters); err != nil {
+		return nil, err
+	}
+	return dataCenters, nil
+}
+
+// resolveDataCenter validates a data center ID against the live list so that
+// typos fail before an instance is purchased
+func (ic *InstanceClient) resolveDataCenter(ctx context.Context, id *string) (*int, error) {
+	if id == nil {
+		return nil, nil
+	}
+
+	dataCenters, err := ic.ListDataCenters(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list data centers: %w", err)
+	}
+	return selectDataCenter(dataCenters, *id)
+}
+
+// selectDataCenter finds the data center with the given ID
+func selectDataCenter(dataCenters []*DataCenter, id string) (*int, error) {
+	available := make([]string, 0, len(dataCenters))
+	for _, dc := range dataCenters {
+		if strconv.Itoa(dc.ID) == id {
+			return &dc.ID, nil
+		}
+		available = append(available, fmt.Sprintf("%d (%s, %s)", dc.ID, dc.Name, dc.City))
+	}
+	return nil, fmt.Errorf("data center %q not found (available: %s)", id, strings.Join(available, ", "))
+}
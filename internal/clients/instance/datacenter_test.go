@@ -0,0 +1,128 @@
+/*
+Copyright 2025 Ross Golder.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package instance
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	v1beta1 "github.com/rossigee/provider-hostinger/apis/instance/v1beta1"
+)
+
+const testDataCenters = `[
+	{"id": 9, "name": "nl-srv-1", "location": "nl", "city": "Amsterdam", "continent": "Europe"},
+	{"id": 17, "name": "us-srv-2", "location": "us", "city": "Boston", "continent": "North America"}
+]`
+
+func TestListDataCenters(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/vps/data-centers" {
+			t.Errorf("unexpected path %s", r.URL.Path)
+		}
+		_, _ = w.Write([]byte(testDataCenters))
+	}))
+	defer server.Close()
+
+	dataCenters, err := newTestClient(server).ListDataCenters(context.Background())
+	if err != nil {
+		t.Fatalf("ListDataCenters() error = %v", err)
+	}
+	if len(dataCenters) != 2 || dataCenters[1].ID != 17 || dataCenters[1].City != "Boston" {
+		t.Errorf("ListDataCenters() = %+v", dataCenters)
+	}
+}
+
+func TestSelectDataCenter(t *testing.T) {
+	dataCenters := []*DataCenter{}
+	if err := json.Unmarshal([]byte(testDataCenters), &dataCenters); err != nil {
+		t.Fatalf("failed to decode test data centers: %v", err)
+	}
+
+	id, err := selectDataCenter(dataCenters, "17")
+	if err != nil || *id != 17 {
+		t.Errorf("selectDataCenter(17) = %v, %v", id, err)
+	}
+
+	_, err = selectDataCenter(dataCenters, "99")
+	if err == nil {
+		t.Fatal("selectDataCenter(99) error = nil, want error")
+	}
+	if !strings.Contains(err.Error(), "9 (nl-srv-1, Amsterdam)") {
+		t.Errorf("error %q does not list available data centers", err)
+	}
+}
+
+func TestCreate_DataCenter(t *testing.T) {
+	var body map[string]interface{}
+	purchased := false
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/billing/catalog":
+			_, _ = w.Write([]byte(testCatalog))
+		case "/vps/data-centers":
+			_, _ = w.Write([]byte(testDataCenters))
+		case "/vps/virtual-machines":
+			purchased = true
+			if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+				t.Errorf("failed to decode body: %v", err)
+			}
+			_, _ = w.Write([]byte(`{"virtual_machine": {"id": 456, "data_center_id": 17}}`))
+		default:
+			t.Errorf("unexpected path %s", r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	plan := "KVM 1"
+	dataCenter := "17"
+	params := &v1beta1.InstanceParameters{Hostname: "vps.example.com", OSId: "1002", Plan: &plan, DataCenterID: &dataCenter}
+	instance, err := newTestClient(server).Create(context.Background(), params, "s3cret")
+	if err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+	if instance.DataCenterID != "17" {
+		t.Errorf("DataCenterID = %v, want 17", instance.DataCenterID)
+	}
+	setup, _ := body["setup"].(map[string]interface{})
+	if setup["data_center_id"] != float64(17) {
+		t.Errorf("data_center_id = %v, want 17", setup["data_center_id"])
+	}
+
+	purchased = false
+	unknown := "99"
+	params.DataCenterID = &unknown
+	if _, err := newTestClient(server).Create(context.Background(), params, "s3cret"); err == nil {
+		t.Error("Create() error = nil, want error for unknown data center")
+	}
+	if purchased {
+		t.Error("Create() purchased an instance in an unknown data center")
+	}
+}
+
+func TestLateInitialize_DataCenter(t *testing.T) {
+	params := &v1beta1.InstanceParameters{}
+	if !NewInstanceClient(nil).LateInitialize(&Instance{DataCenterID: "9"}, params) {
+		t.Error("LateInitialize() = false, want true")
+	}
+	if params.DataCenterID == nil || *params.DataCenterID != "9" {
+		t.Errorf("DataCenterID = %v, want 9", params.DataCenterID)
+	}
+}
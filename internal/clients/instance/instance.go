@@ -19,9 +19,9 @@ package instance
 import (
 	"context"
 	"fmt"
-	"time"
-
-	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"net/http"
+	"strconv"
+	"strings"
 
 	v1beta1 "github.com/rossigee/provider-hostinger/apis/instance/v1beta1"
 	"github.com/rossigee/provider-hostinger/internal/clients"
@@ -29,27 +29,98 @@ import (
 
 // Instance represents a Hostinger VPS instance
 type Instance struct {
-	ID              string
-	Hostname        string
-	Status          string
-	IPAddress       string
-	IPv6Address     string
-	OSId            string
-	CPUCount        int32
-	RAM             int32
-	DiskSize        int32
-	Bandwidth       *int32
-	CreationDate    *string
-	ExpirationDate  *string
-	RootPassword    *string
-	IPv6Enabled     bool
-	Inodes          *int32
+	ID             string
+	Hostname       string
+	Status         string
+	IPAddress      string
+	IPv6Address    string
+	IPv4ID         string
+	IPv6ID         string
+	IPv4PTR        string
+	IPv6PTR        string
+	OSId           string
+	CPUCount       int32
+	RAM            int32
+	DiskSize       int32
+	Bandwidth      *int32
+	CreationDate   *string
+	ExpirationDate *string
+	RootPassword   *string
+	IPv6Enabled    bool
+	Inodes         *int32
+	Plan           string
+	DataCenterID   string
+}
+
+// Instance states reported by the Hostinger API
+const (
+	StateRunning    = "running"
+	StateRecreating = "recreating"
+	StateRecovery   = "recovery"
+)
+
+// virtualMachine is the VPS representation returned by the Hostinger API
+type virtualMachine struct {
+	ID           int         `json:"id"`
+	Plan         string      `json:"plan"`
+	Hostname     string      `json:"hostname"`
+	State        string      `json:"state"`
+	CPUs         int32       `json:"cpus"`
+	Memory       int32       `json:"memory"`
+	Disk         int32       `json:"disk"`
+	Bandwidth    *int32      `json:"bandwidth"`
+	IPv4         []ipAddress `json:"ipv4"`
+	IPv6         []ipAddress `json:"ipv6"`
+	Template     *template   `json:"template"`
+	DataCenterID *int        `json:"data_center_id"`
+	CreatedAt    *string     `json:"created_at"`
+}
+
+// ipAddress is an IP address assigned to a virtual machine
+type ipAddress struct {
+	ID      int    `json:"id"`
+	Address string `json:"address"`
+	PTR     string `json:"ptr"`
+}
+
+// template is the operating system template installed on a virtual machine
+type template struct {
+	ID   int    `json:"id"`
+	Name string `json:"name"`
+}
+
+// recreateRequest is the payload for the virtual machine recreate action
+type recreateRequest struct {
+	TemplateID          int    `json:"template_id"`
+	Password            string `json:"password,omitempty"`
+	PostInstallScriptID *int   `json:"post_install_script_id,omitempty"`
+}
+
+// purchaseRequest is the payload for purchasing a new virtual machine
+type purchaseRequest struct {
+	ItemID          string       `json:"item_id"`
+	PaymentMethodID *int64       `json:"payment_method_id,omitempty"`
+	Setup           setupRequest `json:"setup"`
+}
+
+// setupRequest is the initial configuration of a purchased virtual machine
+type setupRequest struct {
+	TemplateID          int    `json:"template_id"`
+	DataCenterID        *int   `json:"data_center_id,omitempty"`
+	Hostname            string `json:"hostname,omitempty"`
+	Password            string `json:"password,omitempty"`
+	PostInstallScriptID *int   `json:"post_install_script_id,omitempty"`
+}
+
+// purchaseResponse is returned when a virtual machine has been ordered
+type purchaseResponse struct {
+	VirtualMachine virtualMachine `json:"virtual_machine"`
 }
 
 // Client defines operations for managing Hostinger VPS instances
 type Client interface {
-	// Create creates a new VPS instance
-	Create(ctx context.Context, params *v1beta1.InstanceParameters) (*Instance, error)
+	// Create purchases a new VPS instance
+	Create(ctx context.Context, params *v1beta1.InstanceParameters, password string) (*Instance, error)
 
 	// Get retrieves a VPS instance by ID
 	Get(ctx context.Context, instanceID string) (*Instance, error)
@@ -71,6 +142,27 @@ type Client interface {
 
 	// UpToDate checks if local spec matches remote instance
 	UpToDate(instance *Instance, params *v1beta1.InstanceParameters) bool
+
+	// Recreate reinstalls the operating system of a VPS instance, wiping its disk
+	Recreate(ctx context.Context, instanceID string, params *v1beta1.InstanceParameters, password string) (*clients.Action, error)
+
+	// GetAction retrieves the state of an action started on a VPS instance
+	GetAction(ctx context.Context, instanceID string, actionID int64) (*clients.Action, error)
+
+	// ResolvePlan finds the catalog plan described by the parameters
+	ResolvePlan(ctx context.Context, params *v1beta1.InstanceParameters) (*Plan, error)
+
+	// Upgrade moves a VPS instance to a bigger plan
+	Upgrade(ctx context.Context, instanceID string, plan *Plan, paymentMethodID *int64) (*clients.Action, error)
+
+	// ListDataCenters returns the data centers available for new instances
+	ListDataCenters(ctx context.Context) ([]*DataCenter, error)
+
+	// StartRecovery boots a VPS instance into the rescue system
+	StartRecovery(ctx context.Context, instanceID, rootPassword string) (*clients.Action, error)
+
+	// StopRecovery boots a VPS instance back into its own operating system
+	StopRecovery(ctx context.Context, instanceID string) (*clients.Action, error)
 }
 
 // InstanceClient implements the Client interface
@@ -85,22 +177,69 @@ func NewInstanceClient(hostingerClient *clients.HostingerClient) *InstanceClient
 	}
 }
 
-// Create creates a new VPS instance
-func (ic *InstanceClient) Create(ctx context.Context, params *v1beta1.InstanceParameters) (*Instance, error) {
-	// Implementation stub - will call Hostinger API
-	return nil, fmt.Errorf("not implemented yet")
+// Create purchases a new VPS instance on the plan described by params
+func (ic *InstanceClient) Create(ctx context.Context, params *v1beta1.InstanceParameters, password string) (*Instance, error) {
+	templateID, err := strconv.Atoi(params.OSId)
+	if err != nil {
+		return nil, fmt.Errorf("invalid osId %q: %w", params.OSId, err)
+	}
+	scriptID, err := parseOptionalID("postInstallScriptId", params.PostInstallScriptID)
+	if err != nil {
+		return nil, err
+	}
+
+	plan, err := ic.ResolvePlan(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	dataCenterID, err := ic.resolveDataCenter(ctx, params.DataCenterID)
+	if err != nil {
+		return nil, err
+	}
+
+	req := &purchaseRequest{
+		ItemID:          plan.PriceItemID,
+		PaymentMethodID: params.PaymentMethodID,
+		Setup: setupRequest{
+			TemplateID:          templateID,
+			DataCenterID:        dataCenterID,
+			Hostname:            params.Hostname,
+			Password:            password,
+			PostInstallScriptID: scriptID,
+		},
+	}
+
+	resp := &purchaseResponse{}
+	if err := ic.hostingerClient.DoJSON(ctx, http.MethodPost, "/vps/virtual-machines", req, resp); err != nil {
+		return nil, err
+	}
+	return toInstance(&resp.VirtualMachine), nil
 }
 
 // Get retrieves a VPS instance by ID
 func (ic *InstanceClient) Get(ctx context.Context, instanceID string) (*Instance, error) {
-	// Implementation stub - will call Hostinger API
-	return nil, fmt.Errorf("not implemented yet")
+	vm := &virtualMachine{}
+	if err := ic.hostingerClient.DoJSON(ctx, http.MethodGet, "/vps/virtual-machines/"+instanceID, nil, vm); err != nil {
+		return nil, err
+	}
+	return toInstance(vm), nil
 }
 
-// Update modifies an existing VPS instance
+// Update applies in-place changes to a VPS instance: its hostname and the
+// reverse DNS records of its primary addresses
 func (ic *InstanceClient) Update(ctx context.Context, instanceID string, params *v1beta1.InstanceParameters) error {
-	// Implementation stub - will call Hostinger API
-	return fmt.Errorf("not implemented yet")
+	instance, err := ic.Get(ctx, instanceID)
+	if err != nil {
+		return err
+	}
+
+	if params.Hostname != "" && params.Hostname != instance.Hostname {
+		if err := ic.setHostname(ctx, instanceID, params.Hostname); err != nil {
+			return fmt.Errorf("failed to set hostname: %w", err)
+		}
+	}
+
+	return ic.updatePTRRecords(ctx, instance, params.PTRRecords)
 }
 
 // Delete terminates a VPS instance
@@ -115,6 +254,90 @@ func (ic *InstanceClient) List(ctx context.Context) ([]*Instance, error) {
 	return nil, fmt.Errorf("not implemented yet")
 }
 
+// Recreate reinstalls the operating system of a VPS instance from params.OSId
+func (ic *InstanceClient) Recreate(ctx context.Context, instanceID string, params *v1beta1.InstanceParameters, password string) (*clients.Action, error) {
+	templateID, err := strconv.Atoi(params.OSId)
+	if err != nil {
+		return nil, fmt.Errorf("invalid osId %q: %w", params.OSId, err)
+	}
+
+	scriptID, err := parseOptionalID("postInstallScriptId", params.PostInstallScriptID)
+	if err != nil {
+		return nil, err
+	}
+
+	req := &recreateRequest{
+		TemplateID:          templateID,
+		Password:            password,
+		PostInstallScriptID: scriptID,
+	}
+
+	action := &clients.Action{}
+	if err := ic.hostingerClient.DoJSON(ctx, http.MethodPost, "/vps/virtual-machines/"+instanceID+"/recreate", req, action); err != nil {
+		return nil, err
+	}
+	return action, nil
+}
+
+// GetAction retrieves the state of an action started on a VPS instance
+func (ic *InstanceClient) GetAction(ctx context.Context, instanceID string, actionID int64) (*clients.Action, error) {
+	return ic.hostingerClient.GetAction(ctx, instanceID, actionID)
+}
+
+// parseOptionalID converts an optional string ID field to the numeric form
+// expected by the Hostinger API
+func parseOptionalID(field string, id *string) (*int, error) {
+	if id == nil {
+		return nil, nil
+	}
+	n, err := strconv.Atoi(*id)
+	if err != nil {
+		return nil, fmt.Errorf("invalid %s %q: %w", field, *id, err)
+	}
+	return &n, nil
+}
+
+// toInstance maps a Hostinger API virtual machine to an Instance
+func toInstance(vm *virtualMachine) *Instance {
+	instance := &Instance{
+		ID:           strconv.Itoa(vm.ID),
+		Hostname:     vm.Hostname,
+		Plan:         vm.Plan,
+		Status:       vm.State,
+		CPUCount:     vm.CPUs,
+		RAM:          vm.Memory,
+		DiskSize:     vm.Disk / 1024,
+		Bandwidth:    vm.Bandwidth,
+		CreationDate: vm.CreatedAt,
+		IPv6Enabled:  len(vm.IPv6) > 0,
+	}
+	if len(vm.IPv4) > 0 {
+		instance.IPAddress = vm.IPv4[0].Address
+		instance.IPv4ID = strconv.Itoa(vm.IPv4[0].ID)
+		instance.IPv4PTR = vm.IPv4[0].PTR
+	}
+	if len(vm.IPv6) > 0 {
+		instance.IPv6Address = vm.IPv6[0].Address
+		instance.IPv6ID = strconv.Itoa(vm.IPv6[0].ID)
+		instance.IPv6PTR = vm.IPv6[0].PTR
+	}
+	if vm.Template != nil {
+		instance.OSId = strconv.Itoa(vm.Template.ID)
+	}
+	if vm.DataCenterID != nil {
+		instance.DataCenterID = strconv.Itoa(*vm.DataCenterID)
+	}
+	return instance
+}
+
+// NeedsRecreate returns true if the desired OS differs from the installed one
+func NeedsRecreate(instance *Instance, params *v1beta1.InstanceParameters) bool {
+	if instance == nil {
+		return false
+	}
+	return params.OSId != "" && instance.OSId != "" && params.OSId != instance.OSId
+}
+
 // GetObservation maps an Instance to the observation status
 func (ic *InstanceClient) GetObservation(instance *Instance) *v1beta1.InstanceObservation {
 	if instance == nil {
@@ -122,34 +345,27 @@ func (ic *InstanceClient) GetObservation(instance *Instance) *v1beta1.InstanceOb
 	}
 
 	obs := &v1beta1.InstanceObservation{
-		ID:                 instance.ID,
-		Status:             instance.Status,
-		IPAddress:          instance.IPAddress,
-		IPv6Address:        instance.IPv6Address,
-		CurrentHostname:    instance.Hostname,
-		CurrentCPUCount:    instance.CPUCount,
-		CurrentRAM:         instance.RAM,
-		CurrentDiskSize:    instance.DiskSize,
-		CreationDate:       parseTime(instance.CreationDate),
-		ExpirationDate:     parseTime(instance.ExpirationDate),
+		ID:              instance.ID,
+		Status:          instance.Status,
+		IPAddress:       instance.IPAddress,
+		IPv6Address:     instance.IPv6Address,
+		CurrentHostname: instance.Hostname,
+		CurrentIPv4PTR:  instance.IPv4PTR,
+		CurrentIPv6PTR:  instance.IPv6PTR,
+		RecoveryMode:    instance.Status == StateRecovery,
+		CurrentOSId:     instance.OSId,
+		CurrentPlan:     instance.Plan,
+		DataCenterID:    instance.DataCenterID,
+		CurrentCPUCount: instance.CPUCount,
+		CurrentRAM:      instance.RAM,
+		CurrentDiskSize: instance.DiskSize,
+		CreationDate:    clients.ParseTime(instance.CreationDate),
+		ExpirationDate:  clients.ParseTime(instance.ExpirationDate),
 	}
 
 	return obs
 }
 
-// parseTime parses an ISO 8601 time string to metav1.Time
-func parseTime(timeStr *string) *metav1.Time {
-	if timeStr == nil || *timeStr == "" {
-		return nil
-	}
-	t, err := time.Parse(time.RFC3339, *timeStr)
-	if err != nil {
-		return nil
-	}
-	mt := metav1.NewTime(t)
-	return &mt
-}
-
 // LateInitialize updates unset fields from the remote instance
 func (ic *InstanceClient) LateInitialize(instance *Instance, params *v1beta1.InstanceParameters) bool {
 	if instance == nil {
@@ -158,6 +374,23 @@ func (ic *InstanceClient) LateInitialize(instance *Instance, params *v1beta1.Ins
 
 	changed := false
 
+	// Plan - initialize if neither it nor the size is set, so the instance
+	// is sized by plan from now on. An instance sized by cpuCount, ram and
+	// diskSize keeps being sized by them, so that changing them still
+	// selects another plan.
+	if params.Plan == nil && !sizeSet(params) && instance.Plan != "" {
+		plan := instance.Plan
+		params.Plan = &plan
+		changed = true
+	}
+
+	// DataCenterID - initialize if not set
+	if params.DataCenterID == nil && instance.DataCenterID != "" {
+		dataCenterID := instance.DataCenterID
+		params.DataCenterID = &dataCenterID
+		changed = true
+	}
+
 	// OSId - initialize if not set
 	if params.OSId == "" && instance.OSId != "" {
 		params.OSId = instance.OSId
@@ -186,6 +419,17 @@ func (ic *InstanceClient) LateInitialize(instance *Instance, params *v1beta1.Ins
 	return changed
 }
 
+// sizeSet returns true if any of cpuCount, ram or diskSize is set
+func sizeSet(params *v1beta1.InstanceParameters) bool {
+	return params.CPUCount != 0 || params.RAM != 0 || params.DiskSize != 0
+}
+
+// DataCenterChanged returns true if a data center is configured and differs
+// from the one the instance is located in. Instances cannot be moved.
+func DataCenterChanged(instance *Instance, params *v1beta1.InstanceParameters) bool {
+	return params.DataCenterID != nil && instance.DataCenterID != "" && *params.DataCenterID != instance.DataCenterID
+}
+
 // UpToDate checks if local spec matches remote instance
 func (ic *InstanceClient) UpToDate(instance *Instance, params *v1beta1.InstanceParameters) bool {
 	if instance == nil {
@@ -197,30 +441,37 @@ func (ic *InstanceClient) UpToDate(instance *Instance, params *v1beta1.InstanceP
 		return false
 	}
 
-	// Check CPU count
-	if params.CPUCount > 0 && params.CPUCount != instance.CPUCount {
+	// Check reverse DNS
+	if !ptrRecordsMatch(instance, params.PTRRecords) {
 		return false
 	}
 
-	// Check RAM
-	if params.RAM > 0 && params.RAM != instance.RAM {
+	// Check recovery mode
+	if RecoveryModeChanged(params, instance.Status == StateRecovery) {
 		return false
 	}
 
-	// Check disk size
-	if params.DiskSize > 0 && params.DiskSize != instance.DiskSize {
+	// Check operating system
+	if NeedsRecreate(instance, params) {
 		return false
 	}
 
-	// Check bandwidth
-	if params.Bandwidth != nil && *params.Bandwidth != *instance.Bandwidth {
+	// Check data center, which can only be reported as drift
+	if DataCenterChanged(instance, params) {
 		return false
 	}
 
-	// Check IPv6
-	if params.IPv6Enabled != nil && *params.IPv6Enabled != instance.IPv6Enabled {
+	// Check plan, which governs the instance size when set
+	if params.Plan != nil {
+		if !strings.EqualFold(*params.Plan, instance.Plan) {
+			return false
+		}
+	} else if !sizeMatches(instance, params) {
 		return false
 	}
 
+	// Bandwidth, IPv6 and inodes come with the plan and cannot be changed
+	// through the API, so they are observed but not compared
+
 	return true
 }
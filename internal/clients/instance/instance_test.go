@@ -18,13 +18,23 @@ package instance
 
 import (
 	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
 	"testing"
-	"time"
 
 	v1beta1 "github.com/rossigee/provider-hostinger/apis/instance/v1beta1"
 	"github.com/rossigee/provider-hostinger/internal/clients"
+	"github.com/rossigee/provider-hostinger/internal/clients/auth"
 )
 
+// newTestClient creates an InstanceClient talking to the given test server
+func newTestClient(server *httptest.Server) *InstanceClient {
+	cfg := clients.DefaultHTTPClientConfig()
+	cfg.MaxRetries = 0
+	return NewInstanceClient(clients.NewHostingerClient(auth.NewV1KeyAuth("key", "customer", server.URL), cfg))
+}
+
 func TestNewInstanceClient(t *testing.T) {
 	mockHostingerClient := &clients.HostingerClient{}
 	client := NewInstanceClient(mockHostingerClient)
@@ -57,19 +67,19 @@ func TestGetObservation_ValidInstance(t *testing.T) {
 	bandwidth := int32(1000)
 
 	instance := &Instance{
-		ID:              "inst-123",
-		Hostname:        "vps.example.com",
-		Status:          "active",
-		IPAddress:       "192.168.1.100",
-		IPv6Address:     "2001:db8::1",
-		OSId:            "ubuntu20",
-		CPUCount:        4,
-		RAM:             8,
-		DiskSize:        100,
-		Bandwidth:       &bandwidth,
-		CreationDate:    &creationDate,
-		ExpirationDate:  &expirationDate,
-		IPv6Enabled:     true,
+		ID:             "inst-123",
+		Hostname:       "vps.example.com",
+		Status:         "active",
+		IPAddress:      "192.168.1.100",
+		IPv6Address:    "2001:db8::1",
+		OSId:           "ubuntu20",
+		CPUCount:       4,
+		RAM:            8,
+		DiskSize:       100,
+		Bandwidth:      &bandwidth,
+		CreationDate:   &creationDate,
+		ExpirationDate: &expirationDate,
+		IPv6Enabled:    true,
 	}
 
 	client := NewInstanceClient(nil)
@@ -126,43 +136,6 @@ func TestGetObservation_NilDates(t *testing.T) {
 	}
 }
 
-func TestParseTime_Valid(t *testing.T) {
-	dateStr := "2024-01-08T10:30:45Z"
-	result := parseTime(&dateStr)
-
-	if result == nil {
-		t.Fatal("parseTime returned nil for valid date")
-	}
-
-	expected := time.Date(2024, 1, 8, 10, 30, 45, 0, time.UTC)
-	if !result.Time.Equal(expected) {
-		t.Errorf("parseTime = %v, want %v", result.Time, expected)
-	}
-}
-
-func TestParseTime_Nil(t *testing.T) {
-	result := parseTime(nil)
-	if result != nil {
-		t.Errorf("parseTime(nil) = %v, want nil", result)
-	}
-}
-
-func TestParseTime_Empty(t *testing.T) {
-	empty := ""
-	result := parseTime(&empty)
-	if result != nil {
-		t.Errorf("parseTime(empty) = %v, want nil", result)
-	}
-}
-
-func TestParseTime_Invalid(t *testing.T) {
-	invalid := "not-a-date"
-	result := parseTime(&invalid)
-	if result != nil {
-		t.Errorf("parseTime(invalid) = %v, want nil", result)
-	}
-}
-
 func TestLateInitialize_NilInstance(t *testing.T) {
 	params := &v1beta1.InstanceParameters{}
 	client := NewInstanceClient(nil)
@@ -210,6 +183,46 @@ func TestLateInitialize_OSId(t *testing.T) {
 	}
 }
 
+func TestLateInitialize_Plan(t *testing.T) {
+	instance := &Instance{
+		OSId: "1002",
+		Plan: "KVM 2",
+	}
+	params := &v1beta1.InstanceParameters{
+		OSId: "1002",
+	}
+	client := NewInstanceClient(nil)
+
+	changed := client.LateInitialize(instance, params)
+
+	if !changed {
+		t.Error("LateInitialize should return true when Plan is initialized")
+	}
+	if params.Plan == nil || *params.Plan != "KVM 2" {
+		t.Errorf("Plan = %v, want KVM 2", params.Plan)
+	}
+}
+
+func TestLateInitialize_PlanNotSetWhenSized(t *testing.T) {
+	instance := &Instance{
+		OSId: "1002",
+		Plan: "KVM 2",
+	}
+	params := &v1beta1.InstanceParameters{
+		OSId:     "1002",
+		CPUCount: 2,
+		RAM:      8192,
+		DiskSize: 100,
+	}
+	client := NewInstanceClient(nil)
+
+	client.LateInitialize(instance, params)
+
+	if params.Plan != nil {
+		t.Errorf("Plan = %v, want nil for an instance sized by cpuCount, ram and diskSize", *params.Plan)
+	}
+}
+
 func TestLateInitialize_Bandwidth(t *testing.T) {
 	bandwidth := int32(1000)
 	instance := &Instance{
@@ -335,6 +348,25 @@ func TestUpToDate_AllMatching(t *testing.T) {
 	}
 }
 
+func TestUpToDate_IgnoresObserveOnlyFields(t *testing.T) {
+	bandwidth, inodes := int32(1000), int32(50000)
+	ipv6 := true
+
+	// The API may leave out bandwidth
+	instance := &Instance{Hostname: "vps.example.com"}
+	params := &v1beta1.InstanceParameters{
+		Hostname:    "vps.example.com",
+		Bandwidth:   &bandwidth,
+		IPv6Enabled: &ipv6,
+		Inodes:      &inodes,
+	}
+	client := NewInstanceClient(nil)
+
+	if !client.UpToDate(instance, params) {
+		t.Error("UpToDate should ignore bandwidth, IPv6 and inodes, which Update cannot change")
+	}
+}
+
 func TestUpToDate_HostnameMismatch(t *testing.T) {
 	instance := &Instance{
 		Hostname: "vps1.example.com",
@@ -351,6 +383,77 @@ func TestUpToDate_HostnameMismatch(t *testing.T) {
 	}
 }
 
+func TestUpToDate_DataCenterMismatch(t *testing.T) {
+	dataCenter := "19"
+	instance := &Instance{
+		DataCenterID: "17",
+	}
+	params := &v1beta1.InstanceParameters{
+		DataCenterID: &dataCenter,
+	}
+	client := NewInstanceClient(nil)
+
+	upToDate := client.UpToDate(instance, params)
+
+	if upToDate {
+		t.Error("UpToDate should return false when the data center doesn't match")
+	}
+}
+
+func TestUpToDate_OSIdMismatch(t *testing.T) {
+	instance := &Instance{
+		OSId: "1001",
+	}
+	params := &v1beta1.InstanceParameters{
+		OSId: "1002",
+	}
+	client := NewInstanceClient(nil)
+
+	upToDate := client.UpToDate(instance, params)
+
+	if upToDate {
+		t.Error("UpToDate should return false when osId doesn't match")
+	}
+}
+
+func TestUpToDate_PlanMismatch(t *testing.T) {
+	plan := "KVM 4"
+	instance := &Instance{
+		Plan:     "KVM 2",
+		CPUCount: 2,
+	}
+	params := &v1beta1.InstanceParameters{
+		Plan: &plan,
+	}
+	client := NewInstanceClient(nil)
+
+	if client.UpToDate(instance, params) {
+		t.Error("UpToDate should return false when plan doesn't match")
+	}
+
+	plan = "kvm 2"
+	if !client.UpToDate(instance, params) {
+		t.Error("UpToDate should compare plan names case-insensitively")
+	}
+}
+
+func TestUpToDate_PlanGovernsSize(t *testing.T) {
+	plan := "KVM 2"
+	instance := &Instance{
+		Plan:     "KVM 2",
+		DiskSize: 99,
+	}
+	params := &v1beta1.InstanceParameters{
+		Plan:     &plan,
+		DiskSize: 100,
+	}
+	client := NewInstanceClient(nil)
+
+	if !client.UpToDate(instance, params) {
+		t.Error("UpToDate should ignore size fields when plan is set")
+	}
+}
+
 func TestUpToDate_CPUMismatch(t *testing.T) {
 	instance := &Instance{
 		CPUCount: 4,
@@ -413,8 +516,8 @@ func TestUpToDate_BandwidthMismatch(t *testing.T) {
 
 	upToDate := client.UpToDate(instance, params)
 
-	if upToDate {
-		t.Error("UpToDate should return false when bandwidth doesn't match")
+	if !upToDate {
+		t.Error("UpToDate should ignore bandwidth, which Update cannot change")
 	}
 }
 
@@ -431,8 +534,8 @@ func TestUpToDate_IPv6Mismatch(t *testing.T) {
 
 	upToDate := client.UpToDate(instance, params)
 
-	if upToDate {
-		t.Error("UpToDate should return false when IPv6Enabled doesn't match")
+	if !upToDate {
+		t.Error("UpToDate should ignore IPv6Enabled, which Update cannot change")
 	}
 }
 
@@ -444,8 +547,8 @@ func TestUpToDate_OptionalFieldsNotSet(t *testing.T) {
 	params := &v1beta1.InstanceParameters{
 		Hostname:    "vps.example.com",
 		CPUCount:    4,
-		Bandwidth:   nil,      // Not set in params
-		IPv6Enabled: nil,      // Not set in params
+		Bandwidth:   nil, // Not set in params
+		IPv6Enabled: nil, // Not set in params
 	}
 	client := NewInstanceClient(nil)
 
@@ -461,30 +564,158 @@ func TestInstanceClientImplementsInterface(t *testing.T) {
 	var _ Client = (*InstanceClient)(nil)
 }
 
-func TestCreateNotImplemented(t *testing.T) {
+func TestCreate_InvalidOSId(t *testing.T) {
 	client := NewInstanceClient(nil)
-	_, err := client.Create(context.Background(), &v1beta1.InstanceParameters{})
+	_, err := client.Create(context.Background(), &v1beta1.InstanceParameters{}, "")
 
 	if err == nil {
-		t.Error("Create should return error (not implemented)")
+		t.Error("Create should return error for a missing osId")
 	}
 }
 
-func TestGetNotImplemented(t *testing.T) {
-	client := NewInstanceClient(nil)
-	_, err := client.Get(context.Background(), "inst-123")
+func TestCreate(t *testing.T) {
+	var body map[string]interface{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/billing/catalog":
+			_, _ = w.Write([]byte(testCatalog))
+		case "/vps/virtual-machines":
+			if r.Method != http.MethodPost {
+				t.Errorf("unexpected method %s", r.Method)
+			}
+			if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+				t.Errorf("failed to decode body: %v", err)
+			}
+			_, _ = w.Write([]byte(`{"order": {"id": 1}, "virtual_machine": {"id": 456, "plan": "KVM 2", "hostname": "vps.example.com", "state": "initial"}}`))
+		default:
+			t.Errorf("unexpected path %s", r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	plan := "KVM 2"
+	params := &v1beta1.InstanceParameters{Hostname: "vps.example.com", OSId: "1002", Plan: &plan}
+	instance, err := newTestClient(server).Create(context.Background(), params, "s3cret")
+	if err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+	if instance.ID != "456" || instance.Plan != "KVM 2" {
+		t.Errorf("Create() = %+v", instance)
+	}
+	if body["item_id"] != "hostingercom-vps-kvm2-usd-1m" {
+		t.Errorf("item_id = %v, want hostingercom-vps-kvm2-usd-1m", body["item_id"])
+	}
+	setup, _ := body["setup"].(map[string]interface{})
+	if setup["template_id"] != float64(1002) || setup["hostname"] != "vps.example.com" || setup["password"] != "s3cret" {
+		t.Errorf("unexpected setup %v", setup)
+	}
+}
 
-	if err == nil {
-		t.Error("Get should return error (not implemented)")
+func TestGet(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/vps/virtual-machines/123" {
+			t.Errorf("unexpected path %s", r.URL.Path)
+		}
+		_, _ = w.Write([]byte(`{
+			"id": 123,
+			"hostname": "vps.example.com",
+			"state": "running",
+			"cpus": 2,
+			"memory": 8192,
+			"disk": 102400,
+			"ipv4": [{"id": 1, "address": "192.0.2.10"}],
+			"ipv6": [{"id": 2, "address": "2001:db8::10"}],
+			"template": {"id": 1002, "name": "Ubuntu 24.04"},
+			"created_at": "2024-01-08T10:00:00Z"
+		}`))
+	}))
+	defer server.Close()
+
+	instance, err := newTestClient(server).Get(context.Background(), "123")
+	if err != nil {
+		t.Fatalf("Get() error = %v", err)
+	}
+	if instance.ID != "123" || instance.Hostname != "vps.example.com" || instance.Status != StateRunning {
+		t.Errorf("Get() = %+v, unexpected identity fields", instance)
+	}
+	if instance.OSId != "1002" {
+		t.Errorf("OSId = %v, want 1002", instance.OSId)
+	}
+	if instance.DiskSize != 100 {
+		t.Errorf("DiskSize = %v, want 100", instance.DiskSize)
+	}
+	if instance.IPAddress != "192.0.2.10" || instance.IPv6Address != "2001:db8::10" || !instance.IPv6Enabled {
+		t.Errorf("Get() = %+v, unexpected addresses", instance)
+	}
+}
+
+func TestGet_NotFound(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = w.Write([]byte(`{"message": "Virtual machine not found"}`))
+	}))
+	defer server.Close()
+
+	_, err := newTestClient(server).Get(context.Background(), "123")
+	if !clients.IsNotFound(err) {
+		t.Errorf("Get() error = %v, want NotFound", err)
+	}
+}
+
+func TestRecreate(t *testing.T) {
+	var body map[string]interface{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/vps/virtual-machines/123/recreate" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Errorf("failed to decode body: %v", err)
+		}
+		_, _ = w.Write([]byte(`{"id": 9001, "name": "recreate", "state": "initiated"}`))
+	}))
+	defer server.Close()
+
+	scriptID := "7"
+	params := &v1beta1.InstanceParameters{OSId: "1002", PostInstallScriptID: &scriptID}
+	action, err := newTestClient(server).Recreate(context.Background(), "123", params, "s3cret")
+	if err != nil {
+		t.Fatalf("Recreate() error = %v", err)
+	}
+	if action.ID != 9001 {
+		t.Errorf("action ID = %v, want 9001", action.ID)
+	}
+	if body["template_id"] != float64(1002) || body["password"] != "s3cret" || body["post_install_script_id"] != float64(7) {
+		t.Errorf("unexpected recreate body %v", body)
 	}
 }
 
-func TestUpdateNotImplemented(t *testing.T) {
+func TestRecreate_InvalidOSId(t *testing.T) {
 	client := NewInstanceClient(nil)
-	err := client.Update(context.Background(), "inst-123", &v1beta1.InstanceParameters{})
+	_, err := client.Recreate(context.Background(), "123", &v1beta1.InstanceParameters{OSId: "ubuntu"}, "")
 
 	if err == nil {
-		t.Error("Update should return error (not implemented)")
+		t.Error("Recreate should return error for a non-numeric osId")
+	}
+}
+
+func TestNeedsRecreate(t *testing.T) {
+	cases := map[string]struct {
+		instance *Instance
+		osID     string
+		want     bool
+	}{
+		"NilInstance":   {instance: nil, osID: "1002", want: false},
+		"Matching":      {instance: &Instance{OSId: "1002"}, osID: "1002", want: false},
+		"Changed":       {instance: &Instance{OSId: "1001"}, osID: "1002", want: true},
+		"UnknownRemote": {instance: &Instance{}, osID: "1002", want: false},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := NeedsRecreate(tc.instance, &v1beta1.InstanceParameters{OSId: tc.osID})
+			if got != tc.want {
+				t.Errorf("NeedsRecreate() = %v, want %v", got, tc.want)
+			}
+		})
 	}
 }
 
@@ -0,0 +1,195 @@
+/*
+Copyright 2025 Ross Golder.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package instance
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	v1beta1 "github.com/rossigee/provider-hostinger/apis/instance/v1beta1"
+	"github.com/rossigee/provider-hostinger/internal/clients"
+	"github.com/rossigee/provider-hostinger/internal/clients/billing"
+)
+
+// periodUnitYear is the catalog period unit of yearly prices; VPS plans are
+// otherwise priced by the month
+const periodUnitYear = "year"
+
+// Plan is a fixed VPS size sold through the Hostinger billing catalog
+type Plan struct {
+	Name        string
+	ItemID      string
+	PriceItemID string
+	CPUCount    int32
+	RAM         int32
+	DiskSize    int32
+}
+
+// upgradeRequest is the payload for upgrading a virtual machine plan
+type upgradeRequest struct {
+	ItemID          string `json:"item_id"`
+	PaymentMethodID *int64 `json:"payment_method_id,omitempty"`
+}
+
+// ResolvePlan finds the catalog plan named by params.Plan, or the plan whose
+// size exactly matches CPUCount, RAM and DiskSize when no plan is named
+func (ic *InstanceClient) ResolvePlan(ctx context.Context, params *v1beta1.InstanceParameters) (*Plan, error) {
+	items, err := billing.NewBillingClient(ic.hostingerClient).ListCatalog(ctx, billing.CategoryVPS)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list VPS plans: %w", err)
+	}
+	return selectPlan(items, params)
+}
+
+// Upgrade moves a VPS instance to a bigger plan through the purchase flow
+func (ic *InstanceClient) Upgrade(ctx context.Context, instanceID string, plan *Plan, paymentMethodID *int64) (*clients.Action, error) {
+	action := &clients.Action{}
+	req := &upgradeRequest{
+		ItemID:          plan.PriceItemID,
+		PaymentMethodID: paymentMethodID,
+	}
+	if err := ic.hostingerClient.DoJSON(ctx, http.MethodPost, "/vps/virtual-machines/"+instanceID+"/upgrade", req, action); err != nil {
+		return nil, err
+	}
+	return action, nil
+}
+
+// IsSmallerThan returns true if any resource of the plan is below the
+// instance's current size. Hostinger does not support downgrading plans.
+func (p *Plan) IsSmallerThan(cpuCount, ram, diskSize int32) bool {
+	return p.CPUCount < cpuCount || p.RAM < ram || p.DiskSize < diskSize
+}
+
+// selectPlan picks the plan described by params from the catalog items,
+// priced for the billing period and currency in params
+func selectPlan(items []*billing.CatalogItem, params *v1beta1.InstanceParameters) (*Plan, error) {
+	priced := make([]*billing.CatalogItem, 0, len(items))
+	names := make([]string, 0, len(items))
+	for _, item := range items {
+		if len(item.Prices) == 0 {
+			continue
+		}
+		priced = append(priced, item)
+		names = append(names, item.Name)
+	}
+
+	if params.Plan != nil {
+		for _, item := range priced {
+			if !strings.EqualFold(item.Name, *params.Plan) {
+				continue
+			}
+			plan := toPlan(item)
+			if err := validateSize(plan, params); err != nil {
+				return nil, err
+			}
+			return withPrice(plan, item, params)
+		}
+		return nil, fmt.Errorf("plan %q not found in the VPS catalog (available: %s)", *params.Plan, strings.Join(names, ", "))
+	}
+
+	for _, item := range priced {
+		plan := toPlan(item)
+		if plan.CPUCount == params.CPUCount && plan.RAM == params.RAM && plan.DiskSize == params.DiskSize {
+			return withPrice(plan, item, params)
+		}
+	}
+	return nil, fmt.Errorf("no VPS plan offers %d CPUs, %d MB RAM and %d GB disk (available: %s)",
+		params.CPUCount, params.RAM, params.DiskSize, strings.Join(names, ", "))
+}
+
+// withPrice sets the price item of a plan to the one price of its catalog
+// item matching the billing period and currency in params
+func withPrice(plan *Plan, item *billing.CatalogItem, params *v1beta1.InstanceParameters) (*Plan, error) {
+	matches := make([]string, 0, len(item.Prices))
+	for _, p := range item.Prices {
+		if params.BillingPeriod != nil && periodMonths(p) != *params.BillingPeriod {
+			continue
+		}
+		if params.Currency != nil && !strings.EqualFold(p.Currency, *params.Currency) {
+			continue
+		}
+		matches = append(matches, p.ID)
+	}
+
+	switch len(matches) {
+	case 0:
+		return nil, fmt.Errorf("plan %q has no price for the requested billing period and currency (available: %s)", plan.Name, priceIDs(item))
+	case 1:
+		plan.PriceItemID = matches[0]
+		return plan, nil
+	default:
+		return nil, fmt.Errorf("plan %q has several matching prices, set billingPeriod and currency to pick one (matching: %s)", plan.Name, strings.Join(matches, ", "))
+	}
+}
+
+// periodMonths returns the billing period of a price in months
+func periodMonths(p billing.Price) int32 {
+	if p.PeriodUnit == periodUnitYear {
+		return p.Period * 12
+	}
+	return p.Period
+}
+
+// priceIDs lists the price item IDs of a catalog item
+func priceIDs(item *billing.CatalogItem) string {
+	ids := make([]string, 0, len(item.Prices))
+	for _, p := range item.Prices {
+		ids = append(ids, p.ID)
+	}
+	return strings.Join(ids, ", ")
+}
+
+// validateSize checks that any explicitly requested size matches the plan
+func validateSize(plan *Plan, params *v1beta1.InstanceParameters) error {
+	if params.CPUCount > 0 && params.CPUCount != plan.CPUCount {
+		return fmt.Errorf("cpuCount %d does not match plan %q (%d)", params.CPUCount, plan.Name, plan.CPUCount)
+	}
+	if params.RAM > 0 && params.RAM != plan.RAM {
+		return fmt.Errorf("ram %d does not match plan %q (%d)", params.RAM, plan.Name, plan.RAM)
+	}
+	if params.DiskSize > 0 && params.DiskSize != plan.DiskSize {
+		return fmt.Errorf("diskSize %d does not match plan %q (%d)", params.DiskSize, plan.Name, plan.DiskSize)
+	}
+	return nil
+}
+
+// toPlan maps a VPS catalog item to a Plan, without choosing a price
+func toPlan(item *billing.CatalogItem) *Plan {
+	return &Plan{
+		Name:     item.Name,
+		ItemID:   item.ID,
+		CPUCount: item.MetadataInt("cpus"),
+		RAM:      item.MetadataInt("memory"),
+		DiskSize: item.MetadataInt("disk_space") / 1024,
+	}
+}
+
+// sizeMatches returns true if any explicitly requested size matches the instance
+func sizeMatches(instance *Instance, params *v1beta1.InstanceParameters) bool {
+	if params.CPUCount > 0 && params.CPUCount != instance.CPUCount {
+		return false
+	}
+	if params.RAM > 0 && params.RAM != instance.RAM {
+		return false
+	}
+	if params.DiskSize > 0 && params.DiskSize != instance.DiskSize {
+		return false
+	}
+	return true
+}
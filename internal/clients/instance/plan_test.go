@@ -0,0 +1,238 @@
+/*
+Copyright 2025 Ross Golder.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package instance
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	v1beta1 "github.com/rossigee/provider-hostinger/apis/instance/v1beta1"
+	"github.com/rossigee/provider-hostinger/internal/clients/billing"
+)
+
+const testCatalog = `[
+	{
+		"id": "hostingercom-vps-kvm1",
+		"name": "KVM 1",
+		"category": "VPS",
+		"metadata": {"cpus": "1", "memory": "4096", "disk_space": "51200"},
+		"prices": [{"id": "hostingercom-vps-kvm1-usd-1m", "currency": "USD", "price": 699, "period": 1, "period_unit": "month"}]
+	},
+	{
+		"id": "hostingercom-vps-kvm2",
+		"name": "KVM 2",
+		"category": "VPS",
+		"metadata": {"cpus": "2", "memory": "8192", "disk_space": "102400"},
+		"prices": [{"id": "hostingercom-vps-kvm2-usd-1m", "currency": "USD", "price": 899, "period": 1, "period_unit": "month"}]
+	},
+	{
+		"id": "hostingercom-vps-kvm4",
+		"name": "KVM 4",
+		"category": "VPS",
+		"metadata": {"cpus": "4", "memory": "16384", "disk_space": "204800"},
+		"prices": [
+			{"id": "hostingercom-vps-kvm4-usd-1m", "currency": "USD", "price": 1599, "period": 1, "period_unit": "month"},
+			{"id": "hostingercom-vps-kvm4-usd-1y", "currency": "USD", "price": 15999, "period": 1, "period_unit": "year"},
+			{"id": "hostingercom-vps-kvm4-eur-1m", "currency": "EUR", "price": 1499, "period": 1, "period_unit": "month"}
+		]
+	}
+]`
+
+func testCatalogItems(t *testing.T) []*billing.CatalogItem {
+	items := []*billing.CatalogItem{}
+	if err := json.Unmarshal([]byte(testCatalog), &items); err != nil {
+		t.Fatalf("failed to decode test catalog: %v", err)
+	}
+	return items
+}
+
+func TestSelectPlan(t *testing.T) {
+	kvm2 := "KVM 2"
+	lower := "kvm 1"
+	unknown := "KVM 99"
+
+	cases := map[string]struct {
+		params  *v1beta1.InstanceParameters
+		want    string
+		wantErr bool
+	}{
+		"ByName": {
+			params: &v1beta1.InstanceParameters{Plan: &kvm2},
+			want:   "KVM 2",
+		},
+		"ByNameCaseInsensitive": {
+			params: &v1beta1.InstanceParameters{Plan: &lower},
+			want:   "KVM 1",
+		},
+		"ByNameWithMatchingSize": {
+			params: &v1beta1.InstanceParameters{Plan: &kvm2, CPUCount: 2, RAM: 8192, DiskSize: 100},
+			want:   "KVM 2",
+		},
+		"ByNameWithMismatchedSize": {
+			params:  &v1beta1.InstanceParameters{Plan: &kvm2, CPUCount: 4},
+			wantErr: true,
+		},
+		"UnknownName": {
+			params:  &v1beta1.InstanceParameters{Plan: &unknown},
+			wantErr: true,
+		},
+		"BySize": {
+			params: &v1beta1.InstanceParameters{CPUCount: 1, RAM: 4096, DiskSize: 50},
+			want:   "KVM 1",
+		},
+		"ImpossibleSize": {
+			params:  &v1beta1.InstanceParameters{CPUCount: 3, RAM: 1024, DiskSize: 10},
+			wantErr: true,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			plan, err := selectPlan(testCatalogItems(t)[:2], tc.params)
+			if tc.wantErr {
+				if err == nil {
+					t.Errorf("selectPlan() error = nil, want error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("selectPlan() error = %v", err)
+			}
+			if plan.Name != tc.want {
+				t.Errorf("selectPlan() = %v, want %v", plan.Name, tc.want)
+			}
+		})
+	}
+}
+
+func TestSelectPlanPrice(t *testing.T) {
+	kvm2 := "KVM 2"
+	kvm4 := "KVM 4"
+	monthly := int32(1)
+	yearly := int32(12)
+	biennial := int32(24)
+	usd := "USD"
+	eur := "EUR"
+
+	cases := map[string]struct {
+		params  *v1beta1.InstanceParameters
+		want    string
+		wantErr bool
+	}{
+		"SinglePrice": {
+			params: &v1beta1.InstanceParameters{Plan: &kvm2},
+			want:   "hostingercom-vps-kvm2-usd-1m",
+		},
+		"Ambiguous": {
+			params:  &v1beta1.InstanceParameters{Plan: &kvm4},
+			wantErr: true,
+		},
+		"AmbiguousCurrency": {
+			params:  &v1beta1.InstanceParameters{Plan: &kvm4, BillingPeriod: &monthly},
+			wantErr: true,
+		},
+		"ByPeriodAndCurrency": {
+			params: &v1beta1.InstanceParameters{Plan: &kvm4, BillingPeriod: &monthly, Currency: &eur},
+			want:   "hostingercom-vps-kvm4-eur-1m",
+		},
+		"YearlyInMonths": {
+			params: &v1beta1.InstanceParameters{Plan: &kvm4, BillingPeriod: &yearly},
+			want:   "hostingercom-vps-kvm4-usd-1y",
+		},
+		"BySize": {
+			params: &v1beta1.InstanceParameters{CPUCount: 4, RAM: 16384, DiskSize: 200, BillingPeriod: &yearly, Currency: &usd},
+			want:   "hostingercom-vps-kvm4-usd-1y",
+		},
+		"NoSuchPeriod": {
+			params:  &v1beta1.InstanceParameters{Plan: &kvm2, BillingPeriod: &biennial},
+			wantErr: true,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			plan, err := selectPlan(testCatalogItems(t), tc.params)
+			if tc.wantErr {
+				if err == nil {
+					t.Errorf("selectPlan() error = nil, want error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("selectPlan() error = %v", err)
+			}
+			if plan.PriceItemID != tc.want {
+				t.Errorf("selectPlan() price = %v, want %v", plan.PriceItemID, tc.want)
+			}
+		})
+	}
+}
+
+func TestToPlan(t *testing.T) {
+	plan := toPlan(testCatalogItems(t)[1])
+
+	if plan.CPUCount != 2 || plan.RAM != 8192 || plan.DiskSize != 100 {
+		t.Errorf("toPlan() = %+v, unexpected size", plan)
+	}
+	if plan.ItemID != "hostingercom-vps-kvm2" {
+		t.Errorf("toPlan() = %+v, unexpected item ID", plan)
+	}
+}
+
+func TestPlanIsSmallerThan(t *testing.T) {
+	plan := &Plan{CPUCount: 2, RAM: 8192, DiskSize: 100}
+
+	if plan.IsSmallerThan(2, 8192, 100) {
+		t.Error("IsSmallerThan should be false for an identical size")
+	}
+	if plan.IsSmallerThan(1, 4096, 50) {
+		t.Error("IsSmallerThan should be false for a smaller instance")
+	}
+	if !plan.IsSmallerThan(2, 8192, 200) {
+		t.Error("IsSmallerThan should be true when the disk would shrink")
+	}
+}
+
+func TestUpgrade(t *testing.T) {
+	var body map[string]interface{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/vps/virtual-machines/123/upgrade" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Errorf("failed to decode body: %v", err)
+		}
+		_, _ = w.Write([]byte(`{"id": 9001, "name": "upgrade", "state": "initiated"}`))
+	}))
+	defer server.Close()
+
+	paymentMethodID := int64(77)
+	plan := &Plan{Name: "KVM 2", PriceItemID: "hostingercom-vps-kvm2-usd-1m"}
+	action, err := newTestClient(server).Upgrade(context.Background(), "123", plan, &paymentMethodID)
+	if err != nil {
+		t.Fatalf("Upgrade() error = %v", err)
+	}
+	if action.ID != 9001 {
+		t.Errorf("Upgrade() action ID = %d, want 9001", action.ID)
+	}
+	if body["item_id"] != "hostingercom-vps-kvm2-usd-1m" || body["payment_method_id"] != float64(77) {
+		t.Errorf("unexpected upgrade body %v", body)
+	}
+}
@@ -0,0 +1,99 @@
+/*
+Copyright 2025 Ross Golder.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package instance
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	v1beta1 "github.com/rossigee/provider-hostinger/apis/instance/v1beta1"
+)
+
+// hostnameRequest is the payload for setting the hostname of a virtual machine
+type hostnameRequest struct {
+	Hostname string `json:"hostname"`
+}
+
+// ptrRequest is the payload for setting the PTR record of an IP address
+type ptrRequest struct {
+	Domain string `json:"domain"`
+}
+
+// setHostname changes the hostname of a VPS instance
+func (ic *InstanceClient) setHostname(ctx context.Context, instanceID, hostname string) error {
+	req := &hostnameRequest{Hostname: hostname}
+	return ic.hostingerClient.DoJSON(ctx, http.MethodPut, "/vps/virtual-machines/"+instanceID+"/hostname", req, nil)
+}
+
+// setPTRRecord creates or replaces the PTR record of an instance IP address
+func (ic *InstanceClient) setPTRRecord(ctx context.Context, instanceID, ipAddressID, domain string) error {
+	req := &ptrRequest{Domain: domain}
+	return ic.hostingerClient.DoJSON(ctx, http.MethodPost, "/vps/virtual-machines/"+instanceID+"/ptr/"+ipAddressID, req, nil)
+}
+
+// deletePTRRecord removes the PTR record of an instance IP address
+func (ic *InstanceClient) deletePTRRecord(ctx context.Context, instanceID, ipAddressID string) error {
+	return ic.hostingerClient.DoJSON(ctx, http.MethodDelete, "/vps/virtual-machines/"+instanceID+"/ptr/"+ipAddressID, nil, nil)
+}
+
+// updatePTRRecords reconciles the reverse DNS records of the primary IPv4 and
+// IPv6 addresses with the desired records
+func (ic *InstanceClient) updatePTRRecords(ctx context.Context, instance *Instance, ptr *v1beta1.PTRRecords) error {
+	if ptr == nil {
+		return nil
+	}
+	if err := ic.updatePTRRecord(ctx, instance.ID, "IPv4", instance.IPv4ID, instance.IPv4PTR, ptr.IPv4); err != nil {
+		return err
+	}
+	return ic.updatePTRRecord(ctx, instance.ID, "IPv6", instance.IPv6ID, instance.IPv6PTR, ptr.IPv6)
+}
+
+// updatePTRRecord sets or removes a single PTR record if it has drifted
+func (ic *InstanceClient) updatePTRRecord(ctx context.Context, instanceID, family, ipAddressID, current string, desired *string) error {
+	if desired == nil || *desired == current {
+		return nil
+	}
+	if ipAddressID == "" {
+		return fmt.Errorf("cannot set %s PTR record: instance has no %s address", family, family)
+	}
+
+	if *desired == "" {
+		if err := ic.deletePTRRecord(ctx, instanceID, ipAddressID); err != nil {
+			return fmt.Errorf("failed to delete %s PTR record: %w", family, err)
+		}
+		return nil
+	}
+	if err := ic.setPTRRecord(ctx, instanceID, ipAddressID, *desired); err != nil {
+		return fmt.Errorf("failed to set %s PTR record: %w", family, err)
+	}
+	return nil
+}
+
+// ptrRecordsMatch returns true if every desired PTR record is in place
+func ptrRecordsMatch(instance *Instance, ptr *v1beta1.PTRRecords) bool {
+	if ptr == nil {
+		return true
+	}
+	if ptr.IPv4 != nil && *ptr.IPv4 != instance.IPv4PTR {
+		return false
+	}
+	if ptr.IPv6 != nil && *ptr.IPv6 != instance.IPv6PTR {
+		return false
+	}
+	return true
+}
@@ -0,0 +1,134 @@
+/*
+Copyright 2025 Ross Golder.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package instance
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	v1beta1 "github.com/rossigee/provider-hostinger/apis/instance/v1beta1"
+)
+
+const testVirtualMachine = `{
+	"id": 123,
+	"hostname": "old.example.com",
+	"state": "running",
+	"ipv4": [{"id": 11, "address": "192.0.2.10", "ptr": "old.example.com"}],
+	"ipv6": [{"id": 12, "address": "2001:db8::10", "ptr": ""}]
+}`
+
+func TestUpdate(t *testing.T) {
+	requests := map[string]map[string]interface{}{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodGet {
+			_, _ = w.Write([]byte(testVirtualMachine))
+			return
+		}
+		body := map[string]interface{}{}
+		if r.Body != nil {
+			_ = json.NewDecoder(r.Body).Decode(&body)
+		}
+		requests[r.Method+" "+r.URL.Path] = body
+	}))
+	defer server.Close()
+
+	mail := "mail.example.com"
+	params := &v1beta1.InstanceParameters{
+		Hostname:   "mail.example.com",
+		PTRRecords: &v1beta1.PTRRecords{IPv4: &mail, IPv6: &mail},
+	}
+	if err := newTestClient(server).Update(context.Background(), "123", params); err != nil {
+		t.Fatalf("Update() error = %v", err)
+	}
+
+	if got := requests["PUT /vps/virtual-machines/123/hostname"]; got["hostname"] != "mail.example.com" {
+		t.Errorf("hostname request = %v", got)
+	}
+	if got := requests["POST /vps/virtual-machines/123/ptr/11"]; got["domain"] != "mail.example.com" {
+		t.Errorf("IPv4 PTR request = %v", got)
+	}
+	if got := requests["POST /vps/virtual-machines/123/ptr/12"]; got["domain"] != "mail.example.com" {
+		t.Errorf("IPv6 PTR request = %v", got)
+	}
+}
+
+func TestUpdate_RemovesPTRRecord(t *testing.T) {
+	var deleted string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet:
+			_, _ = w.Write([]byte(testVirtualMachine))
+		case http.MethodDelete:
+			deleted = r.URL.Path
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	empty := ""
+	params := &v1beta1.InstanceParameters{
+		Hostname:   "old.example.com",
+		PTRRecords: &v1beta1.PTRRecords{IPv4: &empty},
+	}
+	if err := newTestClient(server).Update(context.Background(), "123", params); err != nil {
+		t.Fatalf("Update() error = %v", err)
+	}
+	if deleted != "/vps/virtual-machines/123/ptr/11" {
+		t.Errorf("deleted = %q, want IPv4 PTR record", deleted)
+	}
+}
+
+func TestUpdate_NoIPv6Address(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		_, _ = w.Write([]byte(`{"id": 123, "hostname": "vps.example.com", "ipv6": []}`))
+	}))
+	defer server.Close()
+
+	ptr := "vps.example.com"
+	params := &v1beta1.InstanceParameters{PTRRecords: &v1beta1.PTRRecords{IPv6: &ptr}}
+	if err := newTestClient(server).Update(context.Background(), "123", params); err == nil {
+		t.Error("Update() error = nil, want error for an instance without IPv6")
+	}
+}
+
+func TestUpToDate_PTRRecords(t *testing.T) {
+	client := NewInstanceClient(nil)
+	instance := &Instance{Hostname: "mail.example.com", IPv4PTR: "mail.example.com"}
+	mail := "mail.example.com"
+	other := "relay.example.com"
+
+	if !client.UpToDate(instance, &v1beta1.InstanceParameters{PTRRecords: &v1beta1.PTRRecords{IPv4: &mail}}) {
+		t.Error("UpToDate() = false for matching PTR record")
+	}
+	if client.UpToDate(instance, &v1beta1.InstanceParameters{PTRRecords: &v1beta1.PTRRecords{IPv4: &other}}) {
+		t.Error("UpToDate() = true for drifted PTR record")
+	}
+}
+
+func TestGetObservation_PTRRecords(t *testing.T) {
+	obs := NewInstanceClient(nil).GetObservation(&Instance{IPv4PTR: "mail.example.com", IPv6PTR: "mail6.example.com"})
+	if obs.CurrentIPv4PTR != "mail.example.com" || obs.CurrentIPv6PTR != "mail6.example.com" {
+		t.Errorf("GetObservation() PTR records = %q, %q", obs.CurrentIPv4PTR, obs.CurrentIPv6PTR)
+	}
+}
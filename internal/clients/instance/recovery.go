@@ -0,0 +1,55 @@
+/*
+Copyright 2025 Ross Golder.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package instance
+
+import (
+	"context"
+	"net/http"
+
+	v1beta1 "github.com/rossigee/provider-hostinger/apis/instance/v1beta1"
+	"github.com/rossigee/provider-hostinger/internal/clients"
+)
+
+// recoveryRequest is the payload for starting recovery mode
+type recoveryRequest struct {
+	RootPassword string `json:"root_password"`
+}
+
+// StartRecovery boots a VPS instance into the rescue system
+func (ic *InstanceClient) StartRecovery(ctx context.Context, instanceID, rootPassword string) (*clients.Action, error) {
+	action := &clients.Action{}
+	req := &recoveryRequest{RootPassword: rootPassword}
+	if err := ic.hostingerClient.DoJSON(ctx, http.MethodPost, "/vps/virtual-machines/"+instanceID+"/recovery", req, action); err != nil {
+		return nil, err
+	}
+	return action, nil
+}
+
+// StopRecovery boots a VPS instance back into its own operating system
+func (ic *InstanceClient) StopRecovery(ctx context.Context, instanceID string) (*clients.Action, error) {
+	action := &clients.Action{}
+	if err := ic.hostingerClient.DoJSON(ctx, http.MethodDelete, "/vps/virtual-machines/"+instanceID+"/recovery", nil, action); err != nil {
+		return nil, err
+	}
+	return action, nil
+}
+
+// RecoveryModeChanged returns true if recovery mode is configured and differs
+// from the mode the instance is currently in
+func RecoveryModeChanged(params *v1beta1.InstanceParameters, inRecovery bool) bool {
+	return params.RecoveryMode != nil && params.RecoveryMode.Enabled != inRecovery
+}
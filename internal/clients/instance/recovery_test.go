@@ -0,0 +1,81 @@
+/*
+Copyright 2025 Ross Golder.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package instance
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	v1beta1 "github.com/rossigee/provider-hostinger/apis/instance/v1beta1"
+)
+
+func TestStartRecovery(t *testing.T) {
+	var body recoveryRequest
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/vps/virtual-machines/123/recovery" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Errorf("failed to decode body: %v", err)
+		}
+		_, _ = w.Write([]byte(`{"id": 8123, "name": "recovery_start", "state": "initiated"}`))
+	}))
+	defer server.Close()
+
+	action, err := newTestClient(server).StartRecovery(context.Background(), "123", "r3scue")
+	if err != nil {
+		t.Fatalf("StartRecovery() error = %v", err)
+	}
+	if action.ID != 8123 || body.RootPassword != "r3scue" {
+		t.Errorf("StartRecovery() = %+v, body %+v", action, body)
+	}
+}
+
+func TestStopRecovery(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodDelete || r.URL.Path != "/vps/virtual-machines/123/recovery" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		_, _ = w.Write([]byte(`{"id": 8124, "name": "recovery_stop", "state": "initiated"}`))
+	}))
+	defer server.Close()
+
+	if _, err := newTestClient(server).StopRecovery(context.Background(), "123"); err != nil {
+		t.Fatalf("StopRecovery() error = %v", err)
+	}
+}
+
+func TestRecoveryModeChanged(t *testing.T) {
+	enabled := &v1beta1.InstanceParameters{RecoveryMode: &v1beta1.RecoveryMode{Enabled: true}}
+	disabled := &v1beta1.InstanceParameters{RecoveryMode: &v1beta1.RecoveryMode{Enabled: false}}
+
+	if RecoveryModeChanged(&v1beta1.InstanceParameters{}, false) {
+		t.Error("RecoveryModeChanged() = true without a recoveryMode block")
+	}
+	if !RecoveryModeChanged(enabled, false) {
+		t.Error("RecoveryModeChanged() = false when recovery should start")
+	}
+	if RecoveryModeChanged(enabled, true) {
+		t.Error("RecoveryModeChanged() = true when already in recovery")
+	}
+	if !RecoveryModeChanged(disabled, true) {
+		t.Error("RecoveryModeChanged() = false when recovery should stop")
+	}
+}
@@ -0,0 +1,148 @@
+/*
+Copyright 2025 Ross Golder.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package postinstallscript
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"strconv"
+
+	v1beta1 "github.com/rossigee/provider-hostinger/apis/postinstallscript/v1beta1"
+	"github.com/rossigee/provider-hostinger/internal/clients"
+)
+
+// Script represents a Hostinger VPS post-install script
+type Script struct {
+	ID        string
+	Name      string
+	Content   string
+	CreatedAt *string
+	UpdatedAt *string
+}
+
+// script is the post-install script as returned by the Hostinger API
+type script struct {
+	ID        int     `json:"id"`
+	Name      string  `json:"name"`
+	Content   string  `json:"content"`
+	CreatedAt *string `json:"created_at"`
+	UpdatedAt *string `json:"updated_at"`
+}
+
+// scriptRequest is the payload for creating or updating a post-install script
+type scriptRequest struct {
+	Name    string `json:"name"`
+	Content string `json:"content"`
+}
+
+// Client defines operations for managing Hostinger post-install scripts
+type Client interface {
+	// Create creates a new post-install script with the given content
+	Create(ctx context.Context, params *v1beta1.PostInstallScriptParameters, content string) (*Script, error)
+
+	// Get retrieves a post-install script by ID
+	Get(ctx context.Context, id string) (*Script, error)
+
+	// Update replaces the name and content of a post-install script
+	Update(ctx context.Context, id string, params *v1beta1.PostInstallScriptParameters, content string) error
+
+	// Delete deletes a post-install script
+	Delete(ctx context.Context, id string) error
+
+	// GetObservation maps a Script to PostInstallScriptObservation
+	GetObservation(script *Script) *v1beta1.PostInstallScriptObservation
+
+	// UpToDate checks if the script matches the desired name and content
+	UpToDate(script *Script, params *v1beta1.PostInstallScriptParameters, content string) bool
+}
+
+// PostInstallScriptClient implements the Client interface
+type PostInstallScriptClient struct {
+	hostingerClient *clients.HostingerClient
+}
+
+// NewPostInstallScriptClient creates a new post-install script client
+func NewPostInstallScriptClient(hostingerClient *clients.HostingerClient) *PostInstallScriptClient {
+	return &PostInstallScriptClient{
+		hostingerClient: hostingerClient,
+	}
+}
+
+// Create creates a new post-install script with the given content
+func (pc *PostInstallScriptClient) Create(ctx context.Context, params *v1beta1.PostInstallScriptParameters, content string) (*Script, error) {
+	resp := &script{}
+	req := &scriptRequest{Name: params.Name, Content: content}
+	if err := pc.hostingerClient.DoJSON(ctx, http.MethodPost, "/vps/post-install-scripts", req, resp); err != nil {
+		return nil, err
+	}
+	return toScript(resp), nil
+}
+
+// Get retrieves a post-install script by ID
+func (pc *PostInstallScriptClient) Get(ctx context.Context, id string) (*Script, error) {
+	resp := &script{}
+	if err := pc.hostingerClient.DoJSON(ctx, http.MethodGet, "/vps/post-install-scripts/"+id, nil, resp); err != nil {
+		return nil, err
+	}
+	return toScript(resp), nil
+}
+
+// Update replaces the name and content of a post-install script
+func (pc *PostInstallScriptClient) Update(ctx context.Context, id string, params *v1beta1.PostInstallScriptParameters, content string) error {
+	req := &scriptRequest{Name: params.Name, Content: content}
+	return pc.hostingerClient.DoJSON(ctx, http.MethodPut, "/vps/post-install-scripts/"+id, req, nil)
+}
+
+// Delete deletes a post-install script
+func (pc *PostInstallScriptClient) Delete(ctx context.Context, id string) error {
+	return pc.hostingerClient.DoJSON(ctx, http.MethodDelete, "/vps/post-install-scripts/"+id, nil, nil)
+}
+
+// GetObservation maps a Script to PostInstallScriptObservation
+func (pc *PostInstallScriptClient) GetObservation(script *Script) *v1beta1.PostInstallScriptObservation {
+	return &v1beta1.PostInstallScriptObservation{
+		ID:          script.ID,
+		Name:        script.Name,
+		ContentHash: ContentHash(script.Content),
+		CreatedAt:   clients.ParseTime(script.CreatedAt),
+		UpdatedAt:   clients.ParseTime(script.UpdatedAt),
+	}
+}
+
+// UpToDate checks if the script matches the desired name and content
+func (pc *PostInstallScriptClient) UpToDate(script *Script, params *v1beta1.PostInstallScriptParameters, content string) bool {
+	return script.Name == params.Name && script.Content == content
+}
+
+// ContentHash returns the hex encoded SHA-256 hash of script content
+func ContentHash(content string) string {
+	sum := sha256.Sum256([]byte(content))
+	return hex.EncodeToString(sum[:])
+}
+
+// toScript maps the API representation of a post-install script to a Script
+func toScript(s *script) *Script {
+	return &Script{
+		ID:        strconv.Itoa(s.ID),
+		Name:      s.Name,
+		Content:   s.Content,
+		CreatedAt: s.CreatedAt,
+		UpdatedAt: s.UpdatedAt,
+	}
+}
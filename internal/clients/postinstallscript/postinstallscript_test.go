@@ -0,0 +1,129 @@
+/*
+Copyright 2025 Ross Golder.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package postinstallscript
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	v1beta1 "github.com/rossigee/provider-hostinger/apis/postinstallscript/v1beta1"
+	"github.com/rossigee/provider-hostinger/internal/clients"
+	"github.com/rossigee/provider-hostinger/internal/clients/auth"
+)
+
+func newTestClient(server *httptest.Server) *PostInstallScriptClient {
+	cfg := clients.DefaultHTTPClientConfig()
+	cfg.MaxRetries = 0
+	return NewPostInstallScriptClient(clients.NewHostingerClient(auth.NewV1KeyAuth("key", "customer", server.URL), cfg))
+}
+
+func TestCreate(t *testing.T) {
+	var body scriptRequest
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/vps/post-install-scripts" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Errorf("failed to decode body: %v", err)
+		}
+		_, _ = w.Write([]byte(`{"id": 325, "name": "bootstrap", "content": "#!/bin/sh\necho hi", "created_at": "2025-02-27T11:54:22Z"}`))
+	}))
+	defer server.Close()
+
+	params := &v1beta1.PostInstallScriptParameters{Name: "bootstrap"}
+	script, err := newTestClient(server).Create(context.Background(), params, "#!/bin/sh\necho hi")
+	if err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+	if script.ID != "325" {
+		t.Errorf("ID = %v, want 325", script.ID)
+	}
+	if body.Name != "bootstrap" || body.Content != "#!/bin/sh\necho hi" {
+		t.Errorf("unexpected create body %+v", body)
+	}
+}
+
+func TestGet_NotFound(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = w.Write([]byte(`{"message": "Post install script not found"}`))
+	}))
+	defer server.Close()
+
+	_, err := newTestClient(server).Get(context.Background(), "404")
+	if !clients.IsNotFound(err) {
+		t.Errorf("Get() error = %v, want not found", err)
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPut || r.URL.Path != "/vps/post-install-scripts/325" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	params := &v1beta1.PostInstallScriptParameters{Name: "bootstrap"}
+	if err := newTestClient(server).Update(context.Background(), "325", params, "echo hi"); err != nil {
+		t.Fatalf("Update() error = %v", err)
+	}
+}
+
+func TestDelete(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodDelete || r.URL.Path != "/vps/post-install-scripts/325" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	if err := newTestClient(server).Delete(context.Background(), "325"); err != nil {
+		t.Fatalf("Delete() error = %v", err)
+	}
+}
+
+func TestUpToDate(t *testing.T) {
+	client := NewPostInstallScriptClient(nil)
+	script := &Script{Name: "bootstrap", Content: "echo hi"}
+	params := &v1beta1.PostInstallScriptParameters{Name: "bootstrap"}
+
+	if !client.UpToDate(script, params, "echo hi") {
+		t.Error("UpToDate() = false for matching script")
+	}
+	if client.UpToDate(script, params, "echo bye") {
+		t.Error("UpToDate() = true for changed content")
+	}
+	if client.UpToDate(script, &v1beta1.PostInstallScriptParameters{Name: "other"}, "echo hi") {
+		t.Error("UpToDate() = true for changed name")
+	}
+}
+
+func TestGetObservation(t *testing.T) {
+	created := "2025-02-27T11:54:22Z"
+	obs := NewPostInstallScriptClient(nil).GetObservation(&Script{ID: "325", Name: "bootstrap", Content: "echo hi", CreatedAt: &created})
+
+	if obs.ID != "325" || obs.ContentHash != ContentHash("echo hi") {
+		t.Errorf("GetObservation() = %+v", obs)
+	}
+	if obs.CreatedAt == nil || obs.UpdatedAt != nil {
+		t.Errorf("GetObservation() timestamps = %v, %v", obs.CreatedAt, obs.UpdatedAt)
+	}
+}
@@ -0,0 +1,57 @@
+/*
+Copyright 2025 Ross Golder.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clients
+
+import (
+	"context"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+)
+
+// DefaultNamespace returns namespace, or fallback if it is empty. References
+// of namespaced resources default to the namespace of the resource.
+func DefaultNamespace(namespace, fallback string) string {
+	if namespace == "" {
+		return fallback
+	}
+	return namespace
+}
+
+// GetSecretData returns the data of a referenced secret, looked up in
+// namespace when the reference does not name one. Errors of the Kubernetes
+// client are returned unwrapped so that callers can check for NotFound.
+func GetSecretData(ctx context.Context, kube client.Reader, ref xpv1.SecretReference, namespace string) (map[string][]byte, error) {
+	secret := &corev1.Secret{}
+	if err := kube.Get(ctx, types.NamespacedName{Namespace: DefaultNamespace(ref.Namespace, namespace), Name: ref.Name}, secret); err != nil {
+		return nil, err
+	}
+	return secret.Data, nil
+}
+
+// GetSecretKey returns the value of a key of a referenced secret, or an empty
+// string if the key is not set
+func GetSecretKey(ctx context.Context, kube client.Reader, ref xpv1.SecretKeySelector, namespace string) (string, error) {
+	data, err := GetSecretData(ctx, kube, ref.SecretReference, namespace)
+	if err != nil {
+		return "", err
+	}
+	return string(data[ref.Key]), nil
+}
@@ -0,0 +1,131 @@
+/*
+Copyright 2025 Ross Golder.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package snapshot
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+
+	v1beta1 "github.com/rossigee/provider-hostinger/apis/snapshot/v1beta1"
+	"github.com/rossigee/provider-hostinger/internal/clients"
+)
+
+// Snapshot represents the snapshot of a Hostinger VPS instance
+type Snapshot struct {
+	ID        string
+	CreatedAt *string
+	ExpiresAt *string
+}
+
+// snapshot is the VPS snapshot as returned by the Hostinger API
+type snapshot struct {
+	ID        int     `json:"id"`
+	CreatedAt *string `json:"created_at"`
+	ExpiresAt *string `json:"expires_at"`
+}
+
+// Client defines operations for managing Hostinger VPS snapshots. Each
+// instance has at most one snapshot, so snapshots are addressed by instance.
+type Client interface {
+	// Get retrieves the snapshot of an instance
+	Get(ctx context.Context, instanceID string) (*Snapshot, error)
+
+	// Create takes a new snapshot of an instance, replacing any existing one
+	Create(ctx context.Context, instanceID string) (*clients.Action, error)
+
+	// Restore restores an instance from its snapshot
+	Restore(ctx context.Context, instanceID string) (*clients.Action, error)
+
+	// Delete deletes the snapshot of an instance
+	Delete(ctx context.Context, instanceID string) error
+
+	// GetAction retrieves the state of an action started on an instance
+	GetAction(ctx context.Context, instanceID string, actionID int64) (*clients.Action, error)
+
+	// GetObservation maps a Snapshot to SnapshotObservation
+	GetObservation(snapshot *Snapshot) *v1beta1.SnapshotObservation
+}
+
+// SnapshotClient implements the Client interface
+type SnapshotClient struct {
+	hostingerClient *clients.HostingerClient
+}
+
+// NewSnapshotClient creates a new snapshot client
+func NewSnapshotClient(hostingerClient *clients.HostingerClient) *SnapshotClient {
+	return &SnapshotClient{
+		hostingerClient: hostingerClient,
+	}
+}
+
+// Get retrieves the snapshot of an instance
+func (sc *SnapshotClient) Get(ctx context.Context, instanceID string) (*Snapshot, error) {
+	resp := &snapshot{}
+	if err := sc.hostingerClient.DoJSON(ctx, http.MethodGet, snapshotPath(instanceID), nil, resp); err != nil {
+		return nil, err
+	}
+	return &Snapshot{
+		ID:        strconv.Itoa(resp.ID),
+		CreatedAt: resp.CreatedAt,
+		ExpiresAt: resp.ExpiresAt,
+	}, nil
+}
+
+// Create takes a new snapshot of an instance, replacing any existing one
+func (sc *SnapshotClient) Create(ctx context.Context, instanceID string) (*clients.Action, error) {
+	return sc.startAction(ctx, http.MethodPost, snapshotPath(instanceID))
+}
+
+// Restore restores an instance from its snapshot
+func (sc *SnapshotClient) Restore(ctx context.Context, instanceID string) (*clients.Action, error) {
+	return sc.startAction(ctx, http.MethodPost, snapshotPath(instanceID)+"/restore")
+}
+
+// Delete deletes the snapshot of an instance
+func (sc *SnapshotClient) Delete(ctx context.Context, instanceID string) error {
+	_, err := sc.startAction(ctx, http.MethodDelete, snapshotPath(instanceID))
+	return err
+}
+
+// GetAction retrieves the state of an action started on an instance
+func (sc *SnapshotClient) GetAction(ctx context.Context, instanceID string, actionID int64) (*clients.Action, error) {
+	return sc.hostingerClient.GetAction(ctx, instanceID, actionID)
+}
+
+// GetObservation maps a Snapshot to SnapshotObservation
+func (sc *SnapshotClient) GetObservation(snapshot *Snapshot) *v1beta1.SnapshotObservation {
+	return &v1beta1.SnapshotObservation{
+		ID:        snapshot.ID,
+		CreatedAt: clients.ParseTime(snapshot.CreatedAt),
+		ExpiresAt: clients.ParseTime(snapshot.ExpiresAt),
+	}
+}
+
+// startAction calls a snapshot endpoint that starts an asynchronous action
+func (sc *SnapshotClient) startAction(ctx context.Context, method, path string) (*clients.Action, error) {
+	action := &clients.Action{}
+	if err := sc.hostingerClient.DoJSON(ctx, method, path, nil, action); err != nil {
+		return nil, err
+	}
+	return action, nil
+}
+
+// snapshotPath returns the API path of the snapshot of an instance
+func snapshotPath(instanceID string) string {
+	return "/vps/virtual-machines/" + instanceID + "/snapshot"
+}
@@ -0,0 +1,103 @@
+/*
+Copyright 2025 Ross Golder.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package snapshot
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/rossigee/provider-hostinger/internal/clients"
+	"github.com/rossigee/provider-hostinger/internal/clients/auth"
+)
+
+func newTestClient(server *httptest.Server) *SnapshotClient {
+	cfg := clients.DefaultHTTPClientConfig()
+	cfg.MaxRetries = 0
+	return NewSnapshotClient(clients.NewHostingerClient(auth.NewV1KeyAuth("key", "customer", server.URL), cfg))
+}
+
+func TestGet(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet || r.URL.Path != "/vps/virtual-machines/123/snapshot" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		_, _ = w.Write([]byte(`{"id": 9, "created_at": "2025-02-27T11:54:22Z", "expires_at": "2025-03-19T11:54:22Z"}`))
+	}))
+	defer server.Close()
+
+	client := newTestClient(server)
+	snapshot, err := client.Get(context.Background(), "123")
+	if err != nil {
+		t.Fatalf("Get() error = %v", err)
+	}
+
+	obs := client.GetObservation(snapshot)
+	if obs.ID != "9" || obs.CreatedAt == nil || obs.ExpiresAt == nil {
+		t.Errorf("GetObservation() = %+v", obs)
+	}
+	if !obs.ExpiresAt.After(obs.CreatedAt.Time) {
+		t.Errorf("ExpiresAt %v is not after CreatedAt %v", obs.ExpiresAt, obs.CreatedAt)
+	}
+}
+
+func TestGet_NotFound(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer server.Close()
+
+	if _, err := newTestClient(server).Get(context.Background(), "123"); !clients.IsNotFound(err) {
+		t.Errorf("Get() error = %v, want not found", err)
+	}
+}
+
+func TestActions(t *testing.T) {
+	var requests []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.Method+" "+r.URL.Path)
+		_, _ = w.Write([]byte(`{"id": 8123, "name": "snapshot", "state": "initiated"}`))
+	}))
+	defer server.Close()
+
+	client := newTestClient(server)
+	action, err := client.Create(context.Background(), "123")
+	if err != nil || action.ID != 8123 {
+		t.Fatalf("Create() = %v, %v", action, err)
+	}
+	if _, err := client.Restore(context.Background(), "123"); err != nil {
+		t.Fatalf("Restore() error = %v", err)
+	}
+	if err := client.Delete(context.Background(), "123"); err != nil {
+		t.Fatalf("Delete() error = %v", err)
+	}
+
+	want := []string{
+		"POST /vps/virtual-machines/123/snapshot",
+		"POST /vps/virtual-machines/123/snapshot/restore",
+		"DELETE /vps/virtual-machines/123/snapshot",
+	}
+	if len(requests) != len(want) {
+		t.Fatalf("requests = %v, want %v", requests, want)
+	}
+	for i := range want {
+		if requests[i] != want[i] {
+			t.Errorf("request %d = %q, want %q", i, requests[i], want[i])
+		}
+	}
+}
@@ -0,0 +1,94 @@
+/*
+Copyright 2025 Ross Golder.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sshkey
+
+import (
+	"crypto"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/pem"
+	"fmt"
+	"strings"
+
+	"golang.org/x/crypto/ssh"
+)
+
+// DefaultRSABits is the size of generated RSA keys when none is given
+const DefaultRSABits = 4096
+
+// Connection secret keys for generated keypairs
+const (
+	ConnectionKeyPrivateKey  = "private-key"
+	ConnectionKeyPublicKey   = "public-key"
+	ConnectionKeyFingerprint = "fingerprint"
+)
+
+// KeyPair is a generated SSH keypair in OpenSSH formats
+type KeyPair struct {
+	// PublicKey is the public key in authorized_keys format
+	PublicKey string
+	// PrivateKey is the PEM encoded OpenSSH private key
+	PrivateKey []byte
+	// Fingerprint is the OpenSSH SHA256 fingerprint of the public key
+	Fingerprint string
+}
+
+// Generate generates an ed25519 or RSA keypair. Bits only applies to RSA
+// keys and defaults to DefaultRSABits. The comment is appended to the
+// public key.
+func Generate(keyType string, bits int, comment string) (*KeyPair, error) {
+	var priv crypto.Signer
+	switch keyType {
+	case "ed25519":
+		_, k, err := ed25519.GenerateKey(rand.Reader)
+		if err != nil {
+			return nil, fmt.Errorf("failed to generate ed25519 key: %w", err)
+		}
+		priv = k
+	case "rsa":
+		if bits == 0 {
+			bits = DefaultRSABits
+		}
+		k, err := rsa.GenerateKey(rand.Reader, bits)
+		if err != nil {
+			return nil, fmt.Errorf("failed to generate rsa key: %w", err)
+		}
+		priv = k
+	default:
+		return nil, fmt.Errorf("unsupported key type %q", keyType)
+	}
+
+	pub, err := ssh.NewPublicKey(priv.Public())
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode public key: %w", err)
+	}
+	block, err := ssh.MarshalPrivateKey(priv, comment)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode private key: %w", err)
+	}
+
+	publicKey := strings.TrimSpace(string(ssh.MarshalAuthorizedKey(pub)))
+	if comment != "" {
+		publicKey += " " + comment
+	}
+	return &KeyPair{
+		PublicKey:   publicKey,
+		PrivateKey:  pem.EncodeToMemory(block),
+		Fingerprint: ssh.FingerprintSHA256(pub),
+	}, nil
+}
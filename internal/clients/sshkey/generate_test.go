@@ -0,0 +1,61 @@
+/*
+Copyright 2025 Ross Golder.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sshkey
+
+import (
+	"strings"
+	"testing"
+
+	"golang.org/x/crypto/ssh"
+)
+
+func TestGenerate(t *testing.T) {
+	cases := map[string]struct {
+		keyType string
+		bits    int
+		prefix  string
+	}{
+		"ED25519": {keyType: "ed25519", prefix: "ssh-ed25519 "},
+		"RSA":     {keyType: "rsa", bits: 2048, prefix: "ssh-rsa "},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			kp, err := Generate(tc.keyType, tc.bits, "ci key")
+			if err != nil {
+				t.Fatalf("Generate() error = %v", err)
+			}
+			if !strings.HasPrefix(kp.PublicKey, tc.prefix) || !strings.HasSuffix(kp.PublicKey, " ci key") {
+				t.Errorf("Generate() public key = %q, want %s key with comment", kp.PublicKey, tc.keyType)
+			}
+			if !strings.HasPrefix(kp.Fingerprint, "SHA256:") {
+				t.Errorf("Generate() fingerprint = %q, want OpenSSH SHA256 format", kp.Fingerprint)
+			}
+
+			signer, err := ssh.ParsePrivateKey(kp.PrivateKey)
+			if err != nil {
+				t.Fatalf("ParsePrivateKey() error = %v", err)
+			}
+			if fp := ssh.FingerprintSHA256(signer.PublicKey()); fp != kp.Fingerprint {
+				t.Errorf("Generate() fingerprint = %q, want the fingerprint of the private key %q", kp.Fingerprint, fp)
+			}
+		})
+	}
+
+	if _, err := Generate("dsa", 0, ""); err == nil {
+		t.Error("Generate() error = nil, want error for unsupported type")
+	}
+}
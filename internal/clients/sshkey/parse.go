@@ -0,0 +1,151 @@
+/*
+Copyright 2025 Ross Golder.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sshkey
+
+import (
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"strings"
+
+	"golang.org/x/crypto/ssh"
+)
+
+// MinRSABits is the smallest RSA key size that is accepted
+const MinRSABits = 2048
+
+// supportedTypes are the key types that can be uploaded
+var supportedTypes = map[string]bool{
+	ssh.KeyAlgoED25519:    true,
+	ssh.KeyAlgoSKED25519:  true,
+	ssh.KeyAlgoECDSA256:   true,
+	ssh.KeyAlgoECDSA384:   true,
+	ssh.KeyAlgoECDSA521:   true,
+	ssh.KeyAlgoSKECDSA256: true,
+	ssh.KeyAlgoRSA:        true,
+}
+
+// errPrivateKey deliberately does not include any of the key material
+var errPrivateKey = errors.New("the secret contains a private key; only the public key must be provided")
+
+// PublicKey is a parsed and validated SSH public key
+type PublicKey struct {
+	// AuthorizedKey is the key in authorized_keys format, with its comment
+	AuthorizedKey string
+	// Type is the key type, such as ssh-ed25519
+	Type string
+	// Bits is the key size
+	Bits int
+	// Comment is the comment after the key, if any
+	Comment string
+	// Fingerprint is the OpenSSH SHA256 fingerprint
+	Fingerprint string
+	// Hash is a hex SHA256 hash of the key without its comment
+	Hash string
+}
+
+// ParsePublicKey parses a single public key in authorized_keys format. It
+// rejects private keys, unsupported key types and RSA keys shorter than
+// MinRSABits.
+func ParsePublicKey(data string) (*PublicKey, error) {
+	data = strings.TrimSpace(data)
+	if isPrivateKey(data) {
+		return nil, errPrivateKey
+	}
+
+	pub, comment, _, rest, err := ssh.ParseAuthorizedKey([]byte(data))
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse public key: %w", err)
+	}
+	if len(strings.TrimSpace(string(rest))) > 0 {
+		return nil, errors.New("expected a single public key, found more than one")
+	}
+	if !supportedTypes[pub.Type()] {
+		return nil, fmt.Errorf("unsupported key type %q", pub.Type())
+	}
+
+	bits, err := keyBits(pub)
+	if err != nil {
+		return nil, err
+	}
+	if pub.Type() == ssh.KeyAlgoRSA && bits < MinRSABits {
+		return nil, fmt.Errorf("rsa key is %d bits, at least %d bits are required", bits, MinRSABits)
+	}
+
+	authorizedKey := strings.TrimSpace(string(ssh.MarshalAuthorizedKey(pub)))
+	if comment != "" {
+		authorizedKey += " " + comment
+	}
+	return &PublicKey{
+		AuthorizedKey: authorizedKey,
+		Type:          pub.Type(),
+		Bits:          bits,
+		Comment:       comment,
+		Fingerprint:   ssh.FingerprintSHA256(pub),
+		Hash:          hash(pub),
+	}, nil
+}
+
+// PublicKeyHash returns a hex SHA256 hash of a public key in
+// authorized_keys format. The comment is not part of the hash. Unlike
+// ParsePublicKey it accepts any key type, so that keys uploaded by other
+// means can be compared.
+func PublicKeyHash(publicKey string) (string, error) {
+	pub, _, _, _, err := ssh.ParseAuthorizedKey([]byte(publicKey))
+	if err != nil {
+		return "", fmt.Errorf("failed to parse public key: %w", err)
+	}
+	return hash(pub), nil
+}
+
+// hash returns a hex SHA256 hash of the wire format of a public key
+func hash(pub ssh.PublicKey) string {
+	sum := sha256.Sum256(pub.Marshal())
+	return hex.EncodeToString(sum[:])
+}
+
+// isPrivateKey returns true if data looks like a PEM or PuTTY private key
+func isPrivateKey(data string) bool {
+	if strings.HasPrefix(data, "PuTTY-User-Key-File") {
+		return true
+	}
+	block, _ := pem.Decode([]byte(data))
+	return block != nil && strings.Contains(block.Type, "PRIVATE KEY")
+}
+
+// keyBits returns the size of a public key
+func keyBits(pub ssh.PublicKey) (int, error) {
+	cpk, ok := pub.(ssh.CryptoPublicKey)
+	if !ok {
+		return 0, fmt.Errorf("unsupported key type %q", pub.Type())
+	}
+	switch k := cpk.CryptoPublicKey().(type) {
+	case *rsa.PublicKey:
+		return k.N.BitLen(), nil
+	case *ecdsa.PublicKey:
+		return k.Curve.Params().BitSize, nil
+	case ed25519.PublicKey:
+		return 256, nil
+	default:
+		return 0, fmt.Errorf("unsupported key type %q", pub.Type())
+	}
+}
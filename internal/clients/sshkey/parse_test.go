@@ -0,0 +1,120 @@
+/*
+Copyright 2025 Ross Golder.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sshkey
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/pem"
+	"strings"
+	"testing"
+
+	"golang.org/x/crypto/ssh"
+)
+
+func TestParsePublicKey(t *testing.T) {
+	ed, err := Generate("ed25519", 0, "alice@laptop")
+	if err != nil {
+		t.Fatalf("Generate() error = %v", err)
+	}
+	rsa3072, err := Generate("rsa", 3072, "")
+	if err != nil {
+		t.Fatalf("Generate() error = %v", err)
+	}
+
+	pk, err := ParsePublicKey("  " + ed.PublicKey + "\n")
+	if err != nil {
+		t.Fatalf("ParsePublicKey() error = %v", err)
+	}
+	if pk.Type != ssh.KeyAlgoED25519 || pk.Bits != 256 || pk.Comment != "alice@laptop" || pk.Fingerprint != ed.Fingerprint {
+		t.Errorf("ParsePublicKey() = %+v, want an ed25519 key with its comment and fingerprint", pk)
+	}
+	if pk.AuthorizedKey != ed.PublicKey {
+		t.Errorf("AuthorizedKey = %q, want %q", pk.AuthorizedKey, ed.PublicKey)
+	}
+
+	pk, err = ParsePublicKey(rsa3072.PublicKey)
+	if err != nil {
+		t.Fatalf("ParsePublicKey() error = %v", err)
+	}
+	if pk.Type != ssh.KeyAlgoRSA || pk.Bits != 3072 || pk.Comment != "" {
+		t.Errorf("ParsePublicKey() = %+v, want a 3072 bit rsa key", pk)
+	}
+}
+
+func TestParsePublicKey_Invalid(t *testing.T) {
+	ed, err := Generate("ed25519", 0, "")
+	if err != nil {
+		t.Fatalf("Generate() error = %v", err)
+	}
+	weak, err := rsa.GenerateKey(rand.Reader, 1024)
+	if err != nil {
+		t.Fatalf("GenerateKey() error = %v", err)
+	}
+	weakPub, err := ssh.NewPublicKey(&weak.PublicKey)
+	if err != nil {
+		t.Fatalf("NewPublicKey() error = %v", err)
+	}
+	legacy := pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: []byte("secret")})
+
+	cases := map[string]struct {
+		data string
+		want string
+	}{
+		"OpenSSHPrivateKey": {data: string(ed.PrivateKey), want: "private key"},
+		"PEMPrivateKey":     {data: string(legacy), want: "private key"},
+		"PuTTYPrivateKey":   {data: "PuTTY-User-Key-File-3: ssh-ed25519\n", want: "private key"},
+		"WeakRSA":           {data: string(ssh.MarshalAuthorizedKey(weakPub)), want: "1024 bits"},
+		"DSA":               {data: "ssh-dss AAAAB3NzaC1kc3MAAACBAP1/U4EddRIpUt9KnC7s5Of2EbdSPO9EAMMeP4C2USZpRV1AIlH7WT2NWPq/xfW6MPbLm1Vs14E7gB00b/JmYLdrmVClpJ+f6AR7ECLCT7up1/63xhv4O1fnxqimFQ8E+4P208UewwI1VBNaFpEy9nXzrith1yrv8iIDGZ3RSAHHAAAAFQCXYFCPFSMLzLKSuYKi64QL8Fgc9QAAAIEA9+GghdabPd7LvKtcNrhXuXmUr7v6OuqC+VdMCz0HgmdRWVeOutRZT+ZxBxCBgLRJFnEj6EwoFhO3zwkyjMim4TwWeotUfI0o4KOuHiuzpnWRbqN/C/ohNWLx+2J6ASQ7zKTxvqhRkImog9/hWuWfBpKLZl6Ae1UlZAFMO/7PSSoAAACBAOYm9dHW3YqSiqdOxm66Xzgaa3BmuJEsUQmKR4CDvf1RK/hXbHYK4NAWehMk6jFJFjwlLO8qMbYc9WUm3LVSeN1rEx+XLUCR8aYHiVHeDjUJnGXj3y+JRwBL8DtoxsMcILd4RrJavLKBy14eRIHAqPTT8YTohfJhAO2U8gamXADi", want: "unsupported key type"},
+		"Garbage":           {data: "not a key", want: "failed to parse"},
+		"MultipleKeys":      {data: ed.PublicKey + "\n" + ed.PublicKey, want: "more than one"},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			_, err := ParsePublicKey(tc.data)
+			if err == nil || !strings.Contains(err.Error(), tc.want) {
+				t.Errorf("ParsePublicKey() error = %v, want error containing %q", err, tc.want)
+			}
+			if err != nil && strings.Contains(err.Error(), "BEGIN") {
+				t.Errorf("ParsePublicKey() error should not include key material: %v", err)
+			}
+		})
+	}
+}
+
+func TestPublicKeyHash(t *testing.T) {
+	kp, err := Generate("ed25519", 0, "one")
+	if err != nil {
+		t.Fatalf("Generate() error = %v", err)
+	}
+	withComment, _ := PublicKeyHash(kp.PublicKey)
+	otherComment, _ := PublicKeyHash(strings.TrimSuffix(kp.PublicKey, " one") + " two")
+	if withComment == "" || withComment != otherComment {
+		t.Errorf("PublicKeyHash() = %q and %q, want the comment to be ignored", withComment, otherComment)
+	}
+	if pk, _ := ParsePublicKey(kp.PublicKey); pk.Hash != withComment {
+		t.Errorf("ParsePublicKey() hash = %q, want %q", pk.Hash, withComment)
+	}
+
+	rotated, _ := Generate("ed25519", 0, "one")
+	if h, _ := PublicKeyHash(rotated.PublicKey); h == withComment {
+		t.Error("PublicKeyHash() should differ for a rotated key")
+	}
+	if _, err := PublicKeyHash("not a key"); err == nil {
+		t.Error("PublicKeyHash() error = nil, want error for an invalid key")
+	}
+}
@@ -0,0 +1,171 @@
+/*
+Copyright 2025 Ross Golder.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sshkey
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/rossigee/provider-hostinger/internal/clients"
+)
+
+// Key represents a public key uploaded to the Hostinger account
+type Key struct {
+	ID   string
+	Name string
+	Key  string
+}
+
+// key is the public key as returned by the Hostinger API
+type key struct {
+	ID   int    `json:"id"`
+	Name string `json:"name"`
+	Key  string `json:"key"`
+}
+
+// keyPage is a page of public keys returned by the Hostinger API
+type keyPage struct {
+	Data []key `json:"data"`
+	Meta struct {
+		CurrentPage int `json:"current_page"`
+		LastPage    int `json:"last_page"`
+	} `json:"meta"`
+}
+
+// createRequest is the payload for uploading a public key
+type createRequest struct {
+	Name string `json:"name"`
+	Key  string `json:"key"`
+}
+
+// attachRequest is the payload for attaching public keys to an instance
+type attachRequest struct {
+	IDs []int `json:"ids"`
+}
+
+// Client defines operations for managing Hostinger public keys
+type Client interface {
+	// Get retrieves a public key
+	Get(ctx context.Context, keyID string) (*Key, error)
+
+	// Create uploads a public key
+	Create(ctx context.Context, name, publicKey string) (*Key, error)
+
+	// Delete deletes a public key
+	Delete(ctx context.Context, keyID string) error
+
+	// Attach attaches a public key to an instance
+	Attach(ctx context.Context, keyID, instanceID string) (*clients.Action, error)
+
+	// AttachedKeys returns the IDs of the public keys attached to an instance
+	AttachedKeys(ctx context.Context, instanceID string) ([]string, error)
+}
+
+// SSHKeyClient implements the Client interface
+type SSHKeyClient struct {
+	hostingerClient *clients.HostingerClient
+}
+
+// NewSSHKeyClient creates a new public key client
+func NewSSHKeyClient(hostingerClient *clients.HostingerClient) *SSHKeyClient {
+	return &SSHKeyClient{
+		hostingerClient: hostingerClient,
+	}
+}
+
+// Get retrieves a public key. The API has no endpoint for a single key, so
+// the account's keys are listed.
+func (sc *SSHKeyClient) Get(ctx context.Context, keyID string) (*Key, error) {
+	keys, err := sc.list(ctx, "/vps/public-keys")
+	if err != nil {
+		return nil, err
+	}
+	for _, k := range keys {
+		if k.ID == keyID {
+			return k, nil
+		}
+	}
+	return nil, clients.ClassifyError(http.StatusNotFound, fmt.Sprintf("public key %s not found", keyID))
+}
+
+// Create uploads a public key
+func (sc *SSHKeyClient) Create(ctx context.Context, name, publicKey string) (*Key, error) {
+	k := &key{}
+	if err := sc.hostingerClient.DoJSON(ctx, http.MethodPost, "/vps/public-keys", &createRequest{Name: name, Key: publicKey}, k); err != nil {
+		return nil, err
+	}
+	return toKey(k), nil
+}
+
+// Delete deletes a public key
+func (sc *SSHKeyClient) Delete(ctx context.Context, keyID string) error {
+	return sc.hostingerClient.DoJSON(ctx, http.MethodDelete, "/vps/public-keys/"+keyID, nil, nil)
+}
+
+// Attach attaches a public key to an instance
+func (sc *SSHKeyClient) Attach(ctx context.Context, keyID, instanceID string) (*clients.Action, error) {
+	id, err := strconv.Atoi(keyID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid public key ID %q: %w", keyID, err)
+	}
+	action := &clients.Action{}
+	if err := sc.hostingerClient.DoJSON(ctx, http.MethodPost, "/vps/public-keys/attach/"+instanceID, &attachRequest{IDs: []int{id}}, action); err != nil {
+		return nil, err
+	}
+	return action, nil
+}
+
+// AttachedKeys returns the IDs of the public keys attached to an instance
+func (sc *SSHKeyClient) AttachedKeys(ctx context.Context, instanceID string) ([]string, error) {
+	keys, err := sc.list(ctx, "/vps/virtual-machines/"+instanceID+"/public-keys")
+	if err != nil {
+		return nil, err
+	}
+	ids := make([]string, 0, len(keys))
+	for _, k := range keys {
+		ids = append(ids, k.ID)
+	}
+	return ids, nil
+}
+
+// list returns the public keys at a path, following pagination
+func (sc *SSHKeyClient) list(ctx context.Context, path string) ([]*Key, error) {
+	keys := []*Key{}
+	for page := 1; ; page++ {
+		resp := &keyPage{}
+		if err := sc.hostingerClient.DoJSON(ctx, http.MethodGet, fmt.Sprintf("%s?page=%d", path, page), nil, resp); err != nil {
+			return nil, err
+		}
+		for i := range resp.Data {
+			keys = append(keys, toKey(&resp.Data[i]))
+		}
+		if resp.Meta.LastPage <= page {
+			return keys, nil
+		}
+	}
+}
+
+// toKey maps the API representation of a public key to a Key
+func toKey(k *key) *Key {
+	return &Key{
+		ID:   strconv.Itoa(k.ID),
+		Name: k.Name,
+		Key:  k.Key,
+	}
+}
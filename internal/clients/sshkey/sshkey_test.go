@@ -0,0 +1,130 @@
+/*
+Copyright 2025 Ross Golder.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sshkey
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/rossigee/provider-hostinger/internal/clients"
+	"github.com/rossigee/provider-hostinger/internal/clients/auth"
+)
+
+func newTestClient(server *httptest.Server) *SSHKeyClient {
+	cfg := clients.DefaultHTTPClientConfig()
+	cfg.MaxRetries = 0
+	return NewSSHKeyClient(clients.NewHostingerClient(auth.NewV1KeyAuth("key", "customer", server.URL), cfg))
+}
+
+func TestGet(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/vps/public-keys" {
+			t.Errorf("unexpected path %s", r.URL.Path)
+		}
+		switch r.URL.Query().Get("page") {
+		case "1":
+			_, _ = w.Write([]byte(`{"data": [{"id": 1, "name": "a", "key": "ssh-ed25519 AAAA"}], "meta": {"current_page": 1, "last_page": 2}}`))
+		default:
+			_, _ = w.Write([]byte(`{"data": [{"id": 2, "name": "b", "key": "ssh-rsa AAAA"}], "meta": {"current_page": 2, "last_page": 2}}`))
+		}
+	}))
+	defer server.Close()
+
+	c := newTestClient(server)
+	k, err := c.Get(context.Background(), "2")
+	if err != nil {
+		t.Fatalf("Get() error = %v", err)
+	}
+	if k.ID != "2" || k.Name != "b" {
+		t.Errorf("Get() = %+v, want key 2 from the second page", k)
+	}
+
+	if _, err := c.Get(context.Background(), "3"); !clients.IsNotFound(err) {
+		t.Errorf("Get() error = %v, want not found", err)
+	}
+}
+
+func TestCreate(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/vps/public-keys" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		req := &createRequest{}
+		if err := json.NewDecoder(r.Body).Decode(req); err != nil {
+			t.Fatalf("decode request: %v", err)
+		}
+		if req.Name != "laptop" || req.Key != "ssh-ed25519 AAAA" {
+			t.Errorf("request = %+v, want name and key", req)
+		}
+		_, _ = w.Write([]byte(`{"id": 7, "name": "laptop", "key": "ssh-ed25519 AAAA"}`))
+	}))
+	defer server.Close()
+
+	k, err := newTestClient(server).Create(context.Background(), "laptop", "ssh-ed25519 AAAA")
+	if err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+	if k.ID != "7" {
+		t.Errorf("Create() ID = %q, want 7", k.ID)
+	}
+}
+
+func TestAttach(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/vps/public-keys/attach/123" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		req := &attachRequest{}
+		if err := json.NewDecoder(r.Body).Decode(req); err != nil {
+			t.Fatalf("decode request: %v", err)
+		}
+		if len(req.IDs) != 1 || req.IDs[0] != 7 {
+			t.Errorf("request ids = %v, want [7]", req.IDs)
+		}
+		_, _ = w.Write([]byte(`{"id": 99, "name": "attach_public_key", "state": "initiated"}`))
+	}))
+	defer server.Close()
+
+	action, err := newTestClient(server).Attach(context.Background(), "7", "123")
+	if err != nil {
+		t.Fatalf("Attach() error = %v", err)
+	}
+	if action.ID != 99 {
+		t.Errorf("Attach() action = %+v, want 99", action)
+	}
+}
+
+func TestAttachedKeys(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/vps/virtual-machines/123/public-keys" {
+			t.Errorf("unexpected path %s", r.URL.Path)
+		}
+		_, _ = w.Write([]byte(`{"data": [{"id": 7}, {"id": 8}], "meta": {"current_page": 1, "last_page": 1}}`))
+	}))
+	defer server.Close()
+
+	ids, err := newTestClient(server).AttachedKeys(context.Background(), "123")
+	if err != nil {
+		t.Fatalf("AttachedKeys() error = %v", err)
+	}
+	if len(ids) != 2 || ids[0] != "7" || ids[1] != "8" {
+		t.Errorf("AttachedKeys() = %v, want [7 8]", ids)
+	}
+}
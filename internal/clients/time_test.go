@@ -0,0 +1,59 @@
+/*
+Copyright 2025 Ross Golder.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clients
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseTime_Valid(t *testing.T) {
+	dateStr := "2024-01-08T10:30:45Z"
+	result := ParseTime(&dateStr)
+
+	if result == nil {
+		t.Fatal("ParseTime returned nil for valid date")
+	}
+
+	expected := time.Date(2024, 1, 8, 10, 30, 45, 0, time.UTC)
+	if !result.Time.Equal(expected) {
+		t.Errorf("ParseTime = %v, want %v", result.Time, expected)
+	}
+}
+
+func TestParseTime_Nil(t *testing.T) {
+	result := ParseTime(nil)
+	if result != nil {
+		t.Errorf("ParseTime(nil) = %v, want nil", result)
+	}
+}
+
+func TestParseTime_Empty(t *testing.T) {
+	empty := ""
+	result := ParseTime(&empty)
+	if result != nil {
+		t.Errorf("ParseTime(empty) = %v, want nil", result)
+	}
+}
+
+func TestParseTime_Invalid(t *testing.T) {
+	invalid := "not-a-date"
+	result := ParseTime(&invalid)
+	if result != nil {
+		t.Errorf("ParseTime(invalid) = %v, want nil", result)
+	}
+}
@@ -0,0 +1,291 @@
+/*
+Copyright 2025 Ross Golder.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backup
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/util/workqueue"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/event"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+
+	v1beta1 "github.com/rossigee/provider-hostinger/apis/backup/v1beta1"
+	providerv1beta1 "github.com/rossigee/provider-hostinger/apis/v1beta1"
+	"github.com/rossigee/provider-hostinger/internal/clients"
+	backupclient "github.com/rossigee/provider-hostinger/internal/clients/backup"
+)
+
+const (
+	errNotBackup = "managed resource is not a Backup custom resource"
+	errGetPC     = "cannot get ProviderConfig"
+	errNewClient = "cannot create new Hostinger client"
+
+	errListBackups   = "cannot list instance backups"
+	errListPolicies  = "cannot list backup policies"
+	errGetAction     = "cannot get backup action"
+	errExternalName  = "cannot parse external name"
+	errCreateBackup  = "failed to create backup"
+	errDeleteBackup  = "failed to delete backup"
+	statusCompleted  = "completed"
+	statusInProgress = "in progress"
+
+	// actionPrefix marks an external name holding the ID of the creation
+	// action rather than of the backup
+	actionPrefix = "action/"
+)
+
+// Setup adds a controller that reconciles Backup managed resources.
+func Setup(mgr ctrl.Manager, l logging.Logger, wl workqueue.TypedRateLimiter[any]) error {
+	name := managed.ControllerName(v1beta1.BackupGroupKind)
+
+	o := controller.Options{
+		MaxConcurrentReconciles: 5,
+	}
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(v1beta1.BackupGroupVersionKind),
+		managed.WithExternalConnecter(&connector{
+			kube:        mgr.GetClient(),
+			newClientFn: clients.NewClientFactory,
+		}),
+		managed.WithLogger(l.WithValues("controller", name)),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		managed.WithPollInterval(10*time.Minute),
+		managed.WithInitializers(),
+		managed.WithManagementPolicies(),
+	)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o).
+		For(&v1beta1.Backup{}).
+		Complete(r)
+}
+
+// A connector is expected to produce typed ExternalClient for the managed
+// resource it is supposed to manage.
+type connector struct {
+	kube        client.Client
+	newClientFn func(client.Client, clients.HTTPClientConfig) *clients.ClientFactory
+}
+
+// Connect produces an ExternalClient using the credentials of the
+// ProviderConfig referenced by the Backup.
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1beta1.Backup)
+	if !ok {
+		return nil, errors.New(errNotBackup)
+	}
+
+	pc := &providerv1beta1.ProviderConfig{}
+	if err := c.kube.Get(ctx, client.ObjectKey{Namespace: cr.GetNamespace(), Name: cr.Spec.ProviderConfigReference.Name}, pc); err != nil {
+		return nil, errors.Wrap(err, errGetPC)
+	}
+
+	clientFactory := c.newClientFn(c.kube, clients.DefaultHTTPClientConfig())
+
+	hc, err := clientFactory.CreateHostingerClient(ctx, pc)
+	if err != nil {
+		return nil, errors.Wrap(err, errNewClient)
+	}
+
+	return &external{kube: c.kube, client: backupclient.NewBackupClient(hc)}, nil
+}
+
+// An ExternalClient observes, then either creates, updates, or deletes an
+// external resource to ensure it reflects the managed resource's desired state.
+type external struct {
+	kube   client.Client
+	client backupclient.Client
+}
+
+func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1beta1.Backup)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotBackup)
+	}
+
+	// The external name is the ID of the backup, or action/<action ID>
+	// while the backup is being created
+	externalName := meta.GetExternalName(cr)
+	if externalName == "" {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+	createActionID, err := parseExternalName(externalName)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errExternalName)
+	}
+	if createActionID != nil {
+		return e.observeCreation(ctx, cr, *createActionID)
+	}
+
+	backups, err := e.client.List(ctx, cr.Spec.ForProvider.InstanceID)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errListBackups)
+	}
+	var found *backupclient.Backup
+	for _, b := range backups {
+		if b.ID == externalName {
+			found = b
+			break
+		}
+	}
+	if found == nil {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	schedule, err := e.currentSchedule(ctx, cr)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+
+	// Hostinger only lists backups that have finished
+	cr.Status.AtProvider.ID = found.ID
+	cr.Status.AtProvider.Status = statusCompleted
+	cr.Status.AtProvider.Location = found.Location
+	cr.Status.AtProvider.CreatedDate = nil
+	if created := found.Created(); !created.IsZero() {
+		cr.Status.AtProvider.CreatedDate = &metav1.Time{Time: created}
+	}
+	cr.Status.AtProvider.CurrentSchedule = schedule
+	cr.SetConditions(xpv1.Available())
+
+	return managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true}, nil
+}
+
+// currentSchedule returns the schedule of the BackupPolicy in the same
+// namespace that applies to the instance of the backup, if any.
+func (e *external) currentSchedule(ctx context.Context, cr *v1beta1.Backup) (*v1beta1.BackupScheduleType, error) {
+	policies := &v1beta1.BackupPolicyList{}
+	if err := e.kube.List(ctx, policies, client.InNamespace(cr.GetNamespace())); err != nil {
+		return nil, errors.Wrap(err, errListPolicies)
+	}
+	for i := range policies.Items {
+		id := policies.Items[i].Spec.ForProvider.InstanceID
+		if id != nil && *id == cr.Spec.ForProvider.InstanceID {
+			schedule := policies.Items[i].Spec.ForProvider.Schedule
+			return &schedule, nil
+		}
+	}
+	return nil, nil
+}
+
+// Create starts a backup of the instance. The backup ID is only known once
+// the action has finished, so the external name holds the action ID until
+// then.
+func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1beta1.Backup)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotBackup)
+	}
+
+	action, err := e.client.Create(ctx, cr.Spec.ForProvider.InstanceID)
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreateBackup)
+	}
+
+	meta.SetExternalName(cr, actionPrefix+strconv.FormatInt(action.ID, 10))
+	return managed.ExternalCreation{}, nil
+}
+
+// Update is a no-op; backups cannot be changed.
+func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	return managed.ExternalUpdate{}, nil
+}
+
+// Delete deletes the backup. A backup that is still being created is
+// deleted once it appears.
+func (e *external) Delete(ctx context.Context, mg resource.Managed) (managed.ExternalDelete, error) {
+	cr, ok := mg.(*v1beta1.Backup)
+	if !ok {
+		return managed.ExternalDelete{}, errors.New(errNotBackup)
+	}
+
+	externalName := meta.GetExternalName(cr)
+	if externalName == "" || strings.HasPrefix(externalName, actionPrefix) {
+		return managed.ExternalDelete{}, nil
+	}
+
+	err := e.client.Delete(ctx, cr.Spec.ForProvider.InstanceID, externalName)
+	if clients.IsNotFound(err) {
+		return managed.ExternalDelete{}, nil
+	}
+	return managed.ExternalDelete{}, errors.Wrap(err, errDeleteBackup)
+}
+
+// observeCreation waits for the creation action to finish, then points the
+// external name at the backup it made.
+func (e *external) observeCreation(ctx context.Context, cr *v1beta1.Backup, actionID int64) (managed.ExternalObservation, error) {
+	instanceID := cr.Spec.ForProvider.InstanceID
+	action, err := e.client.GetAction(ctx, instanceID, actionID)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errGetAction)
+	}
+
+	// A failed creation is retried by Create, which sets a new external name
+	if action.State == clients.ActionStateError {
+		meta.SetExternalName(cr, "")
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	if action.IsDone() {
+		backups, err := e.client.List(ctx, instanceID)
+		if err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errListBackups)
+		}
+		if made := backupclient.CreatedBy(backups, action, nil); made != nil {
+			meta.SetExternalName(cr, made.ID)
+			return managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true, ResourceLateInitialized: true}, nil
+		}
+	}
+
+	cr.Status.AtProvider.Status = statusInProgress
+	cr.SetConditions(xpv1.Creating())
+	return managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true}, nil
+}
+
+// parseExternalName returns the ID of the creation action if the external
+// name is of the form action/<action ID>.
+func parseExternalName(name string) (*int64, error) {
+	action, found := strings.CutPrefix(name, actionPrefix)
+	if !found {
+		return nil, nil
+	}
+	actionID, err := strconv.ParseInt(action, 10, 64)
+	if err != nil {
+		return nil, errors.Errorf("external name %q is not %s<action ID>", name, actionPrefix)
+	}
+	return &actionID, nil
+}
+
+// Disconnect closes the connection to the external service.
+func (e *external) Disconnect(ctx context.Context) error {
+	return nil
+}
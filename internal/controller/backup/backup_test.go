@@ -0,0 +1,206 @@
+/*
+Copyright 2025 Ross Golder.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backup
+
+import (
+	"context"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+
+	v1beta1 "github.com/rossigee/provider-hostinger/apis/backup/v1beta1"
+	"github.com/rossigee/provider-hostinger/internal/clients"
+	backupclient "github.com/rossigee/provider-hostinger/internal/clients/backup"
+)
+
+// MockBackupClient is a mock implementation of backupclient.Client
+type MockBackupClient struct {
+	backups    []*backupclient.Backup
+	action     *clients.Action
+	deletedIDs []string
+}
+
+func (m *MockBackupClient) List(ctx context.Context, instanceID string) ([]*backupclient.Backup, error) {
+	return m.backups, nil
+}
+
+func (m *MockBackupClient) Create(ctx context.Context, instanceID string) (*clients.Action, error) {
+	return &clients.Action{ID: 8124, State: clients.ActionStateInitiated}, nil
+}
+
+func (m *MockBackupClient) Delete(ctx context.Context, instanceID, backupID string) error {
+	m.deletedIDs = append(m.deletedIDs, backupID)
+	return nil
+}
+
+func (m *MockBackupClient) Limit(ctx context.Context, instanceID string) (int32, error) {
+	return 0, nil
+}
+
+func (m *MockBackupClient) Restore(ctx context.Context, instanceID, backupID string) (*clients.Action, error) {
+	return nil, nil
+}
+
+func (m *MockBackupClient) GetAction(ctx context.Context, instanceID string, actionID int64) (*clients.Action, error) {
+	return m.action, nil
+}
+
+func newTestExternal(t *testing.T, mock *MockBackupClient, policies ...*v1beta1.BackupPolicy) *external {
+	t.Helper()
+	s := runtime.NewScheme()
+	if err := v1beta1.SchemeBuilder.AddToScheme(s); err != nil {
+		t.Fatalf("AddToScheme() error = %v", err)
+	}
+	b := fake.NewClientBuilder().WithScheme(s)
+	for _, p := range policies {
+		b = b.WithObjects(p)
+	}
+	return &external{kube: b.Build(), client: mock}
+}
+
+func newTestBackup(externalName string) *v1beta1.Backup {
+	cr := &v1beta1.Backup{}
+	cr.SetNamespace("default")
+	cr.Spec.ForProvider.InstanceID = "123"
+	meta.SetExternalName(cr, externalName)
+	return cr
+}
+
+func TestExternalObserve_Import(t *testing.T) {
+	createdAt := "2025-02-27T11:54:22Z"
+	mock := &MockBackupClient{backups: []*backupclient.Backup{
+		{ID: "10", Location: "nl-srv-1", CreatedAt: &createdAt},
+		{ID: "20", Location: "nl-srv-1"},
+	}}
+	instanceID := "123"
+	policy := &v1beta1.BackupPolicy{}
+	policy.SetName("weekly")
+	policy.SetNamespace("default")
+	policy.Spec.ForProvider.InstanceID = &instanceID
+	policy.Spec.ForProvider.Schedule = v1beta1.BackupScheduleWeekly
+
+	cr := newTestBackup("10")
+	obs, err := newTestExternal(t, mock, policy).Observe(context.Background(), cr)
+	if err != nil {
+		t.Fatalf("Observe() error = %v", err)
+	}
+	if !obs.ResourceExists || !obs.ResourceUpToDate {
+		t.Errorf("Observe() = %+v, want existing and up to date", obs)
+	}
+
+	atProvider := cr.Status.AtProvider
+	if atProvider.ID != "10" || atProvider.Location != "nl-srv-1" || atProvider.Status != "completed" {
+		t.Errorf("AtProvider = %+v, want backup 10 observed", atProvider)
+	}
+	if atProvider.CreatedDate == nil || atProvider.CreatedDate.UTC().Format("2006-01-02T15:04:05Z") != createdAt {
+		t.Errorf("CreatedDate = %v, want %s", atProvider.CreatedDate, createdAt)
+	}
+	if atProvider.CurrentSchedule == nil || *atProvider.CurrentSchedule != v1beta1.BackupScheduleWeekly {
+		t.Errorf("CurrentSchedule = %v, want weekly from the BackupPolicy", atProvider.CurrentSchedule)
+	}
+	if cr.GetCondition(xpv1.TypeReady).Reason != xpv1.ReasonAvailable {
+		t.Error("backup should be Available")
+	}
+}
+
+func TestExternalObserve_NotFound(t *testing.T) {
+	mock := &MockBackupClient{backups: []*backupclient.Backup{{ID: "20"}}}
+
+	obs, err := newTestExternal(t, mock).Observe(context.Background(), newTestBackup("10"))
+	if err != nil {
+		t.Fatalf("Observe() error = %v", err)
+	}
+	if obs.ResourceExists {
+		t.Error("Observe() should report a missing backup as not existing")
+	}
+}
+
+func TestExternalObserve_Creating(t *testing.T) {
+	mock := &MockBackupClient{action: &clients.Action{ID: 8124, State: clients.ActionStateInitiated}}
+	cr := newTestBackup("action/8124")
+
+	obs, err := newTestExternal(t, mock).Observe(context.Background(), cr)
+	if err != nil {
+		t.Fatalf("Observe() error = %v", err)
+	}
+	if !obs.ResourceExists || !obs.ResourceUpToDate {
+		t.Errorf("Observe() = %+v, want existing while the backup is created", obs)
+	}
+	if cr.GetCondition(xpv1.TypeReady).Reason != xpv1.ReasonCreating {
+		t.Error("backup should be Creating")
+	}
+}
+
+func TestExternalObserve_Created(t *testing.T) {
+	started := "2025-02-27T11:50:00Z"
+	createdAt := "2025-02-27T11:54:22Z"
+	mock := &MockBackupClient{
+		backups: []*backupclient.Backup{{ID: "20", CreatedAt: &createdAt}},
+		action:  &clients.Action{ID: 8124, State: clients.ActionStateSuccess, CreatedAt: started},
+	}
+	cr := newTestBackup("action/8124")
+
+	obs, err := newTestExternal(t, mock).Observe(context.Background(), cr)
+	if err != nil {
+		t.Fatalf("Observe() error = %v", err)
+	}
+	if !obs.ResourceExists || !obs.ResourceLateInitialized {
+		t.Errorf("Observe() = %+v, want the external name updated", obs)
+	}
+	if meta.GetExternalName(cr) != "20" {
+		t.Errorf("external name = %q, want the ID of the new backup", meta.GetExternalName(cr))
+	}
+}
+
+func TestExternalObserve_CreateFailed(t *testing.T) {
+	mock := &MockBackupClient{action: &clients.Action{ID: 8124, State: clients.ActionStateError}}
+	cr := newTestBackup("action/8124")
+
+	obs, err := newTestExternal(t, mock).Observe(context.Background(), cr)
+	if err != nil {
+		t.Fatalf("Observe() error = %v", err)
+	}
+	if obs.ResourceExists || meta.GetExternalName(cr) != "" {
+		t.Errorf("Observe() = %+v, external name = %q, want creation retried", obs, meta.GetExternalName(cr))
+	}
+}
+
+func TestExternalCreate(t *testing.T) {
+	cr := newTestBackup("")
+
+	if _, err := newTestExternal(t, &MockBackupClient{}).Create(context.Background(), cr); err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+	if meta.GetExternalName(cr) != "action/8124" {
+		t.Errorf("external name = %q, want the creation action", meta.GetExternalName(cr))
+	}
+}
+
+func TestExternalDelete(t *testing.T) {
+	mock := &MockBackupClient{}
+
+	if _, err := newTestExternal(t, mock).Delete(context.Background(), newTestBackup("10")); err != nil {
+		t.Fatalf("Delete() error = %v", err)
+	}
+	if len(mock.deletedIDs) != 1 || mock.deletedIDs[0] != "10" {
+		t.Errorf("deleted = %v, want backup 10", mock.deletedIDs)
+	}
+}
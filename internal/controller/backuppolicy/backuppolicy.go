@@ -0,0 +1,345 @@
+/*
+Copyright 2025 Ross Golder.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backuppolicy
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/util/workqueue"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/event"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+
+	v1beta1 "github.com/rossigee/provider-hostinger/apis/backup/v1beta1"
+	providerv1beta1 "github.com/rossigee/provider-hostinger/apis/v1beta1"
+	"github.com/rossigee/provider-hostinger/internal/clients"
+	backupclient "github.com/rossigee/provider-hostinger/internal/clients/backup"
+)
+
+const (
+	errNotBackupPolicy = "managed resource is not a BackupPolicy custom resource"
+	errGetPC           = "cannot get ProviderConfig"
+	errNewClient       = "cannot create new Hostinger client"
+
+	errNoInstanceID = "instanceId is not set or could not be resolved"
+	errListBackups  = "cannot list instance backups"
+	errGetLimit     = "cannot get the backup limit of the instance plan"
+	errGetAction    = "cannot get backup action"
+	errDeleteBackup = "failed to prune backup %s"
+	errCreateBackup = "failed to create backup"
+)
+
+// Setup adds a controller that reconciles BackupPolicy managed resources.
+func Setup(mgr ctrl.Manager, l logging.Logger, wl workqueue.TypedRateLimiter[any]) error {
+	name := managed.ControllerName(v1beta1.BackupPolicyGroupKind)
+
+	o := controller.Options{
+		MaxConcurrentReconciles: 5,
+	}
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(v1beta1.BackupPolicyGroupVersionKind),
+		managed.WithExternalConnecter(&connector{
+			kube:        mgr.GetClient(),
+			newClientFn: clients.NewClientFactory,
+		}),
+		managed.WithLogger(l.WithValues("controller", name)),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		managed.WithPollInterval(5*time.Minute),
+		managed.WithInitializers(),
+	)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o).
+		For(&v1beta1.BackupPolicy{}).
+		Complete(r)
+}
+
+// A connector is expected to produce typed ExternalClient for the managed
+// resource it is supposed to manage.
+type connector struct {
+	kube        client.Client
+	newClientFn func(client.Client, clients.HTTPClientConfig) *clients.ClientFactory
+}
+
+// Connect produces an ExternalClient using the credentials of the
+// ProviderConfig referenced by the BackupPolicy.
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1beta1.BackupPolicy)
+	if !ok {
+		return nil, errors.New(errNotBackupPolicy)
+	}
+
+	pc := &providerv1beta1.ProviderConfig{}
+	if err := c.kube.Get(ctx, client.ObjectKey{Namespace: cr.GetNamespace(), Name: cr.Spec.ProviderConfigReference.Name}, pc); err != nil {
+		return nil, errors.Wrap(err, errGetPC)
+	}
+
+	clientFactory := c.newClientFn(c.kube, clients.DefaultHTTPClientConfig())
+
+	hc, err := clientFactory.CreateHostingerClient(ctx, pc)
+	if err != nil {
+		return nil, errors.Wrap(err, errNewClient)
+	}
+
+	return &external{client: backupclient.NewBackupClient(hc), now: time.Now}, nil
+}
+
+// An ExternalClient observes, then either creates, updates, or deletes an
+// external resource to ensure it reflects the managed resource's desired state.
+type external struct {
+	client backupclient.Client
+	now    func() time.Time
+}
+
+// plan is what a policy needs to do to the backups of its instance.
+type plan struct {
+	backups []*backupclient.Backup
+	limit   int32
+	last    time.Time
+	next    *time.Time
+	due     bool
+	prune   []*backupclient.Backup
+}
+
+// plan compares the backups of the instance against the policy. When created
+// is a backup action that just succeeded, the backup it made is recorded as
+// one of the policy's own. Only backups the policy created are pruned.
+func (e *external) plan(ctx context.Context, cr *v1beta1.BackupPolicy, created *clients.Action) (*plan, error) {
+	params := cr.Spec.ForProvider
+	backups, err := e.client.List(ctx, *params.InstanceID)
+	if err != nil {
+		return nil, errors.Wrap(err, errListBackups)
+	}
+	limit, err := e.client.Limit(ctx, *params.InstanceID)
+	if err != nil {
+		return nil, errors.Wrap(err, errGetLimit)
+	}
+
+	obs := &cr.Status.AtProvider
+	if created != nil {
+		recordCreated(obs, backups, created)
+	}
+	owned := ownedBackups(obs, backups)
+
+	now := e.now()
+	p := &plan{backups: backups, limit: limit}
+	if newest := backupclient.Newest(backups); newest != nil {
+		p.last = newest.Created()
+	}
+	if next, ok := backupclient.NextRun(params.Schedule, p.last, now); ok {
+		p.next = &next
+		p.due = !now.Before(next)
+	}
+
+	retention := backupclient.Retention{}
+	if limit > 0 {
+		// Backups the policy did not create still count towards the limit
+		retention.Limit = limit - int32(len(backups)-len(owned))
+		if retention.Limit < 1 {
+			retention.Limit = 1
+		}
+	}
+	if params.KeepLast != nil {
+		retention.KeepLast = *params.KeepLast
+	}
+	if params.KeepDays != nil {
+		retention.KeepDays = *params.KeepDays
+	}
+	p.prune = backupclient.Prune(owned, retention, p.due, now)
+	return p, nil
+}
+
+// recordCreated adds the backup made by a successful backup action to the
+// backups the policy created.
+func recordCreated(obs *v1beta1.BackupPolicyObservation, backups []*backupclient.Backup, action *clients.Action) {
+	recorded := map[string]bool{}
+	for _, id := range obs.CreatedBackupIDs {
+		recorded[id] = true
+	}
+
+	if made := backupclient.CreatedBy(backups, action, recorded); made != nil {
+		obs.CreatedBackupIDs = append(obs.CreatedBackupIDs, made.ID)
+		obs.LastSuccessfulRun = &metav1.Time{Time: made.Created()}
+		return
+	}
+	if t := clients.ParseTime(&action.UpdatedAt); t != nil {
+		obs.LastSuccessfulRun = t
+	}
+}
+
+// ownedBackups returns the backups the policy created, and forgets those that
+// no longer exist.
+func ownedBackups(obs *v1beta1.BackupPolicyObservation, backups []*backupclient.Backup) []*backupclient.Backup {
+	byID := map[string]*backupclient.Backup{}
+	for _, b := range backups {
+		byID[b.ID] = b
+	}
+
+	owned := []*backupclient.Backup{}
+	var ids []string
+	for _, id := range obs.CreatedBackupIDs {
+		if b, ok := byID[id]; ok {
+			owned = append(owned, b)
+			ids = append(ids, id)
+		}
+	}
+	obs.CreatedBackupIDs = ids
+	return owned
+}
+
+func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1beta1.BackupPolicy)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotBackupPolicy)
+	}
+
+	// The external name is the ID of the instance the policy applies to
+	if meta.GetExternalName(cr) == "" {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	// Deleting the policy keeps its backups, so there is nothing left to
+	// delete once it is being deleted
+	if meta.WasDeleted(cr) {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	params := cr.Spec.ForProvider
+	if params.InstanceID == nil || *params.InstanceID == "" {
+		return managed.ExternalObservation{}, errors.New(errNoInstanceID)
+	}
+
+	// Wait for a running backup to finish rather than starting another
+	obs := &cr.Status.AtProvider
+	var created *clients.Action
+	if obs.CreateActionID != nil {
+		action, err := e.client.GetAction(ctx, *params.InstanceID, *obs.CreateActionID)
+		if err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errGetAction)
+		}
+		if !action.IsDone() {
+			cr.SetConditions(xpv1.Available().WithMessage("backup in progress"))
+			return managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true}, nil
+		}
+		obs.CreateActionID = nil
+		obs.LastRunState = action.State
+		if action.State == clients.ActionStateSuccess {
+			created = action
+		}
+	}
+
+	p, err := e.plan(ctx, cr, created)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+
+	schedule := params.Schedule
+	obs.CurrentSchedule = &schedule
+	obs.BackupCount = int32(len(p.backups))
+	obs.BackupLimit = p.limit
+	obs.NextScheduledRun = nil
+	if p.next != nil {
+		obs.NextScheduledRun = &metav1.Time{Time: *p.next}
+	}
+
+	if obs.LastRunState == clients.ActionStateError {
+		cr.SetConditions(xpv1.Unavailable().WithMessage("the last backup action reported an error"))
+	} else {
+		cr.SetConditions(xpv1.Available())
+	}
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: !p.due && len(p.prune) == 0,
+	}, nil
+}
+
+// Create adopts the instance; backups are created and pruned by Update.
+func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1beta1.BackupPolicy)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotBackupPolicy)
+	}
+
+	params := cr.Spec.ForProvider
+	if params.InstanceID == nil || *params.InstanceID == "" {
+		return managed.ExternalCreation{}, errors.New(errNoInstanceID)
+	}
+
+	meta.SetExternalName(cr, *params.InstanceID)
+	return managed.ExternalCreation{}, nil
+}
+
+// Update prunes backups the policy created that fall outside the retention,
+// then creates a backup if one is due. Pruning first frees a slot when the
+// plan limit has been reached.
+func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1beta1.BackupPolicy)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotBackupPolicy)
+	}
+
+	params := cr.Spec.ForProvider
+	if params.InstanceID == nil || *params.InstanceID == "" {
+		return managed.ExternalUpdate{}, errors.New(errNoInstanceID)
+	}
+
+	p, err := e.plan(ctx, cr, nil)
+	if err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+
+	for _, b := range p.prune {
+		if err := e.client.Delete(ctx, *params.InstanceID, b.ID); err != nil && !clients.IsNotFound(err) {
+			return managed.ExternalUpdate{}, errors.Wrapf(err, errDeleteBackup, b.ID)
+		}
+	}
+
+	if !p.due {
+		return managed.ExternalUpdate{}, nil
+	}
+
+	action, err := e.client.Create(ctx, *params.InstanceID)
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errCreateBackup)
+	}
+	cr.Status.AtProvider.CreateActionID = &action.ID
+
+	return managed.ExternalUpdate{}, nil
+}
+
+// Delete is a no-op; backups created by the policy are kept.
+func (e *external) Delete(ctx context.Context, mg resource.Managed) (managed.ExternalDelete, error) {
+	return managed.ExternalDelete{}, nil
+}
+
+// Disconnect closes the connection to the external service.
+func (e *external) Disconnect(ctx context.Context) error {
+	return nil
+}
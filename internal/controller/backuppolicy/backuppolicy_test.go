@@ -0,0 +1,277 @@
+/*
+Copyright 2025 Ross Golder.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backuppolicy
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+
+	v1beta1 "github.com/rossigee/provider-hostinger/apis/backup/v1beta1"
+	"github.com/rossigee/provider-hostinger/internal/clients"
+	backupclient "github.com/rossigee/provider-hostinger/internal/clients/backup"
+)
+
+var testNow = time.Date(2025, 3, 10, 12, 0, 0, 0, time.UTC)
+
+// MockBackupClient is a mock implementation of backupclient.Client
+type MockBackupClient struct {
+	backups      []*backupclient.Backup
+	limit        int32
+	action       *clients.Action
+	createCalled bool
+	deletedIDs   []string
+}
+
+func (m *MockBackupClient) List(ctx context.Context, instanceID string) ([]*backupclient.Backup, error) {
+	return m.backups, nil
+}
+
+func (m *MockBackupClient) Create(ctx context.Context, instanceID string) (*clients.Action, error) {
+	m.createCalled = true
+	return &clients.Action{ID: 8124, State: clients.ActionStateInitiated}, nil
+}
+
+func (m *MockBackupClient) Delete(ctx context.Context, instanceID, backupID string) error {
+	m.deletedIDs = append(m.deletedIDs, backupID)
+	return nil
+}
+
+func (m *MockBackupClient) Limit(ctx context.Context, instanceID string) (int32, error) {
+	return m.limit, nil
+}
+
+func (m *MockBackupClient) Restore(ctx context.Context, instanceID, backupID string) (*clients.Action, error) {
+	return nil, nil
+}
+
+func (m *MockBackupClient) GetAction(ctx context.Context, instanceID string, actionID int64) (*clients.Action, error) {
+	return m.action, nil
+}
+
+func backupAt(id string, daysAgo int) *backupclient.Backup {
+	created := testNow.AddDate(0, 0, -daysAgo).Format(time.RFC3339)
+	return &backupclient.Backup{ID: id, CreatedAt: &created}
+}
+
+func newTestPolicy(schedule v1beta1.BackupScheduleType) *v1beta1.BackupPolicy {
+	instanceID := "123"
+	cr := &v1beta1.BackupPolicy{}
+	cr.Spec.ForProvider.InstanceID = &instanceID
+	cr.Spec.ForProvider.Schedule = schedule
+	meta.SetExternalName(cr, instanceID)
+	return cr
+}
+
+func newTestExternal(mock *MockBackupClient) *external {
+	return &external{client: mock, now: func() time.Time { return testNow }}
+}
+
+func TestExternalObserve_UpToDate(t *testing.T) {
+	mock := &MockBackupClient{backups: []*backupclient.Backup{backupAt("10", 3), backupAt("11", 1)}, limit: 4}
+	cr := newTestPolicy(v1beta1.BackupScheduleWeekly)
+
+	obs, err := newTestExternal(mock).Observe(context.Background(), cr)
+	if err != nil {
+		t.Fatalf("Observe() error = %v", err)
+	}
+	if !obs.ResourceExists || !obs.ResourceUpToDate {
+		t.Errorf("Observe() = %+v, want existing and up to date", obs)
+	}
+
+	atProvider := cr.Status.AtProvider
+	if atProvider.BackupCount != 2 || atProvider.BackupLimit != 4 {
+		t.Errorf("BackupCount/BackupLimit = %d/%d, want 2/4", atProvider.BackupCount, atProvider.BackupLimit)
+	}
+	if atProvider.NextScheduledRun == nil || !atProvider.NextScheduledRun.Time.Equal(testNow.AddDate(0, 0, 6)) {
+		t.Errorf("NextScheduledRun = %v, want a week after the newest backup", atProvider.NextScheduledRun)
+	}
+	if atProvider.CurrentSchedule == nil || *atProvider.CurrentSchedule != v1beta1.BackupScheduleWeekly {
+		t.Errorf("CurrentSchedule = %v, want weekly", atProvider.CurrentSchedule)
+	}
+	if cr.GetCondition(xpv1.TypeReady).Reason != xpv1.ReasonAvailable {
+		t.Error("policy should be Available")
+	}
+}
+
+func TestExternalObserve_BackupDue(t *testing.T) {
+	mock := &MockBackupClient{backups: []*backupclient.Backup{backupAt("10", 2)}}
+	cr := newTestPolicy(v1beta1.BackupScheduleDaily)
+
+	obs, err := newTestExternal(mock).Observe(context.Background(), cr)
+	if err != nil {
+		t.Fatalf("Observe() error = %v", err)
+	}
+	if obs.ResourceUpToDate {
+		t.Error("Observe() should not be up to date when a backup is due")
+	}
+}
+
+func TestExternalObserve_BackupInProgress(t *testing.T) {
+	mock := &MockBackupClient{action: &clients.Action{ID: 8124, State: clients.ActionStateInitiated}}
+	cr := newTestPolicy(v1beta1.BackupScheduleDaily)
+	actionID := int64(8124)
+	cr.Status.AtProvider.CreateActionID = &actionID
+
+	obs, err := newTestExternal(mock).Observe(context.Background(), cr)
+	if err != nil {
+		t.Fatalf("Observe() error = %v", err)
+	}
+	if !obs.ResourceUpToDate {
+		t.Error("Observe() should be up to date while a backup is in progress")
+	}
+}
+
+func TestExternalObserve_BackupFailed(t *testing.T) {
+	mock := &MockBackupClient{
+		backups: []*backupclient.Backup{backupAt("10", 0)},
+		action:  &clients.Action{ID: 8124, State: clients.ActionStateError},
+	}
+	cr := newTestPolicy(v1beta1.BackupScheduleDaily)
+	actionID := int64(8124)
+	cr.Status.AtProvider.CreateActionID = &actionID
+
+	if _, err := newTestExternal(mock).Observe(context.Background(), cr); err != nil {
+		t.Fatalf("Observe() error = %v", err)
+	}
+	if cr.Status.AtProvider.CreateActionID != nil || cr.Status.AtProvider.LastRunState != clients.ActionStateError {
+		t.Errorf("AtProvider = %+v, want finished action recorded", cr.Status.AtProvider)
+	}
+	if cr.Status.AtProvider.LastSuccessfulRun != nil || len(cr.Status.AtProvider.CreatedBackupIDs) != 0 {
+		t.Errorf("AtProvider = %+v, want a failed backup not recorded as a successful run", cr.Status.AtProvider)
+	}
+	if cr.GetCondition(xpv1.TypeReady).Reason != xpv1.ReasonUnavailable {
+		t.Error("policy should be Unavailable after a failed backup")
+	}
+}
+
+func TestExternalObserve_BackupSucceeded(t *testing.T) {
+	started := testNow.Add(-time.Hour).Format(time.RFC3339)
+	mock := &MockBackupClient{
+		backups: []*backupclient.Backup{backupAt("10", 3), backupAt("11", 0)},
+		action:  &clients.Action{ID: 8124, State: clients.ActionStateSuccess, CreatedAt: started},
+	}
+	cr := newTestPolicy(v1beta1.BackupScheduleDaily)
+	actionID := int64(8124)
+	cr.Status.AtProvider.CreateActionID = &actionID
+
+	if _, err := newTestExternal(mock).Observe(context.Background(), cr); err != nil {
+		t.Fatalf("Observe() error = %v", err)
+	}
+	atProvider := cr.Status.AtProvider
+	if len(atProvider.CreatedBackupIDs) != 1 || atProvider.CreatedBackupIDs[0] != "11" {
+		t.Errorf("CreatedBackupIDs = %v, want the backup made by the action", atProvider.CreatedBackupIDs)
+	}
+	if atProvider.LastSuccessfulRun == nil || !atProvider.LastSuccessfulRun.Time.Equal(testNow) {
+		t.Errorf("LastSuccessfulRun = %v, want the backup made by the action", atProvider.LastSuccessfulRun)
+	}
+}
+
+func TestExternalObserve_Deleted(t *testing.T) {
+	mock := &MockBackupClient{backups: []*backupclient.Backup{backupAt("10", 2)}}
+	cr := newTestPolicy(v1beta1.BackupScheduleDaily)
+	now := metav1.Now()
+	cr.SetDeletionTimestamp(&now)
+
+	obs, err := newTestExternal(mock).Observe(context.Background(), cr)
+	if err != nil {
+		t.Fatalf("Observe() error = %v", err)
+	}
+	if obs.ResourceExists {
+		t.Error("Observe() should report a deleted policy as not existing")
+	}
+}
+
+func TestExternalCreate(t *testing.T) {
+	cr := newTestPolicy(v1beta1.BackupScheduleDaily)
+	meta.SetExternalName(cr, "")
+
+	if _, err := newTestExternal(&MockBackupClient{}).Create(context.Background(), cr); err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+	if meta.GetExternalName(cr) != "123" {
+		t.Errorf("external name = %q, want instance ID", meta.GetExternalName(cr))
+	}
+}
+
+func TestExternalUpdate_PrunesAndCreates(t *testing.T) {
+	mock := &MockBackupClient{
+		backups: []*backupclient.Backup{backupAt("10", 9), backupAt("11", 5), backupAt("12", 2)},
+		limit:   3,
+	}
+	cr := newTestPolicy(v1beta1.BackupScheduleDaily)
+	cr.Status.AtProvider.CreatedBackupIDs = []string{"10", "11", "12"}
+	keepLast := int32(5)
+	cr.Spec.ForProvider.KeepLast = &keepLast
+
+	if _, err := newTestExternal(mock).Update(context.Background(), cr); err != nil {
+		t.Fatalf("Update() error = %v", err)
+	}
+	if len(mock.deletedIDs) != 1 || mock.deletedIDs[0] != "10" {
+		t.Errorf("deleted = %v, want oldest backup pruned to stay within the plan limit", mock.deletedIDs)
+	}
+	if !mock.createCalled {
+		t.Error("Create should be called when a backup is due")
+	}
+	if cr.Status.AtProvider.CreateActionID == nil || *cr.Status.AtProvider.CreateActionID != 8124 {
+		t.Errorf("CreateActionID = %v, want 8124", cr.Status.AtProvider.CreateActionID)
+	}
+}
+
+func TestExternalUpdate_ManualOnlyPrunes(t *testing.T) {
+	mock := &MockBackupClient{backups: []*backupclient.Backup{backupAt("10", 40), backupAt("11", 1)}}
+	cr := newTestPolicy(v1beta1.BackupScheduleManual)
+	cr.Status.AtProvider.CreatedBackupIDs = []string{"10", "11"}
+	keepDays := int32(30)
+	cr.Spec.ForProvider.KeepDays = &keepDays
+
+	if _, err := newTestExternal(mock).Update(context.Background(), cr); err != nil {
+		t.Fatalf("Update() error = %v", err)
+	}
+	if len(mock.deletedIDs) != 1 || mock.deletedIDs[0] != "10" {
+		t.Errorf("deleted = %v, want backups older than keepDays pruned", mock.deletedIDs)
+	}
+	if mock.createCalled {
+		t.Error("Create should not be called for a manual schedule")
+	}
+}
+
+func TestExternalUpdate_KeepsOtherBackups(t *testing.T) {
+	mock := &MockBackupClient{
+		backups: []*backupclient.Backup{backupAt("9", 60), backupAt("10", 40), backupAt("11", 1)},
+		limit:   3,
+	}
+	cr := newTestPolicy(v1beta1.BackupScheduleManual)
+	cr.Status.AtProvider.CreatedBackupIDs = []string{"10", "11", "7"}
+	keepDays := int32(30)
+	cr.Spec.ForProvider.KeepDays = &keepDays
+
+	if _, err := newTestExternal(mock).Update(context.Background(), cr); err != nil {
+		t.Fatalf("Update() error = %v", err)
+	}
+	if len(mock.deletedIDs) != 1 || mock.deletedIDs[0] != "10" {
+		t.Errorf("deleted = %v, want only backups the policy created pruned", mock.deletedIDs)
+	}
+	if ids := cr.Status.AtProvider.CreatedBackupIDs; len(ids) != 2 {
+		t.Errorf("CreatedBackupIDs = %v, want backups that no longer exist forgotten", ids)
+	}
+}
@@ -0,0 +1,227 @@
+/*
+Copyright 2025 Ross Golder.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backuprestore
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/pkg/errors"
+	"k8s.io/client-go/util/workqueue"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/event"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+
+	v1beta1 "github.com/rossigee/provider-hostinger/apis/backup/v1beta1"
+	providerv1beta1 "github.com/rossigee/provider-hostinger/apis/v1beta1"
+	"github.com/rossigee/provider-hostinger/internal/clients"
+	backupclient "github.com/rossigee/provider-hostinger/internal/clients/backup"
+)
+
+const (
+	errNotBackupRestore = "managed resource is not a BackupRestore custom resource"
+	errGetPC            = "cannot get ProviderConfig"
+	errNewClient        = "cannot create new Hostinger client"
+
+	errNotConfirmed    = "confirmDataLoss must be true to restore a backup"
+	errNoInstanceID    = "instanceId is not set or could not be resolved"
+	errListBackups     = "cannot list instance backups"
+	errBackupNotFound  = "backup %s not found on instance %s"
+	errRestore         = "failed to restore backup"
+	errInvalidActionID = "external name %q is not a restore action ID"
+	errGetAction       = "cannot get restore action"
+)
+
+// Setup adds a controller that reconciles BackupRestore managed resources.
+func Setup(mgr ctrl.Manager, l logging.Logger, wl workqueue.TypedRateLimiter[any]) error {
+	name := managed.ControllerName(v1beta1.BackupRestoreGroupKind)
+
+	o := controller.Options{
+		MaxConcurrentReconciles: 5,
+	}
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(v1beta1.BackupRestoreGroupVersionKind),
+		managed.WithExternalConnecter(&connector{
+			kube:        mgr.GetClient(),
+			newClientFn: clients.NewClientFactory,
+		}),
+		managed.WithLogger(l.WithValues("controller", name)),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		managed.WithPollInterval(time.Minute),
+		managed.WithInitializers(),
+	)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o).
+		For(&v1beta1.BackupRestore{}).
+		Complete(r)
+}
+
+// A connector is expected to produce typed ExternalClient for the managed
+// resource it is supposed to manage.
+type connector struct {
+	kube        client.Client
+	newClientFn func(client.Client, clients.HTTPClientConfig) *clients.ClientFactory
+}
+
+// Connect produces an ExternalClient using the credentials of the
+// ProviderConfig referenced by the BackupRestore.
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1beta1.BackupRestore)
+	if !ok {
+		return nil, errors.New(errNotBackupRestore)
+	}
+
+	pc := &providerv1beta1.ProviderConfig{}
+	if err := c.kube.Get(ctx, client.ObjectKey{Namespace: cr.GetNamespace(), Name: cr.Spec.ProviderConfigReference.Name}, pc); err != nil {
+		return nil, errors.Wrap(err, errGetPC)
+	}
+
+	clientFactory := c.newClientFn(c.kube, clients.DefaultHTTPClientConfig())
+
+	hc, err := clientFactory.CreateHostingerClient(ctx, pc)
+	if err != nil {
+		return nil, errors.Wrap(err, errNewClient)
+	}
+
+	return &external{client: backupclient.NewBackupClient(hc)}, nil
+}
+
+// An ExternalClient observes, then either creates, updates, or deletes an
+// external resource to ensure it reflects the managed resource's desired state.
+type external struct {
+	client backupclient.Client
+}
+
+func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1beta1.BackupRestore)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotBackupRestore)
+	}
+
+	// The external name is the ID of the restore action. A restore cannot be
+	// undone, so there is nothing left to delete once it is deleted.
+	externalName := meta.GetExternalName(cr)
+	if externalName == "" || meta.WasDeleted(cr) {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	// A finished restore never needs to be looked at again
+	if state := cr.Status.AtProvider.State; state == clients.ActionStateSuccess || state == clients.ActionStateError {
+		return managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true}, nil
+	}
+
+	actionID, err := strconv.ParseInt(externalName, 10, 64)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Errorf(errInvalidActionID, externalName)
+	}
+	if cr.Spec.ForProvider.InstanceID == nil {
+		return managed.ExternalObservation{}, errors.New(errNoInstanceID)
+	}
+
+	action, err := e.client.GetAction(ctx, *cr.Spec.ForProvider.InstanceID, actionID)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errGetAction)
+	}
+
+	cr.Status.AtProvider.ActionID = &action.ID
+	cr.Status.AtProvider.State = action.State
+	cr.Status.AtProvider.StartedAt = clients.ParseTime(&action.CreatedAt)
+
+	switch {
+	case action.State == clients.ActionStateSuccess:
+		cr.Status.AtProvider.CompletedAt = clients.ParseTime(&action.UpdatedAt)
+		cr.SetConditions(xpv1.Available())
+	case action.State == clients.ActionStateError:
+		cr.Status.AtProvider.CompletedAt = clients.ParseTime(&action.UpdatedAt)
+		cr.SetConditions(xpv1.Unavailable().WithMessage("the restore action reported an error"))
+	default:
+		cr.SetConditions(xpv1.Unavailable().WithMessage("restore in progress"))
+	}
+
+	return managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true}, nil
+}
+
+func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1beta1.BackupRestore)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotBackupRestore)
+	}
+
+	params := cr.Spec.ForProvider
+	if !params.ConfirmDataLoss {
+		return managed.ExternalCreation{}, errors.New(errNotConfirmed)
+	}
+	if params.InstanceID == nil || *params.InstanceID == "" {
+		return managed.ExternalCreation{}, errors.New(errNoInstanceID)
+	}
+
+	// Make sure the backup belongs to the instance before wiping its disk
+	backups, err := e.client.List(ctx, *params.InstanceID)
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errListBackups)
+	}
+	if !hasBackup(backups, params.BackupID) {
+		return managed.ExternalCreation{}, errors.Errorf(errBackupNotFound, params.BackupID, *params.InstanceID)
+	}
+
+	action, err := e.client.Restore(ctx, *params.InstanceID, params.BackupID)
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errRestore)
+	}
+
+	// Status set here is not persisted; Observe fills it in from the action
+	// named by the external name
+	meta.SetExternalName(cr, strconv.FormatInt(action.ID, 10))
+
+	return managed.ExternalCreation{}, nil
+}
+
+// Update is a no-op; a restore cannot be changed once it has been started.
+func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	return managed.ExternalUpdate{}, nil
+}
+
+// Delete is a no-op; a restore cannot be undone.
+func (e *external) Delete(ctx context.Context, mg resource.Managed) (managed.ExternalDelete, error) {
+	return managed.ExternalDelete{}, nil
+}
+
+// Disconnect closes the connection to the external service.
+func (e *external) Disconnect(ctx context.Context) error {
+	return nil
+}
+
+// hasBackup reports whether a backup with the given ID is in the list.
+func hasBackup(backups []*backupclient.Backup, backupID string) bool {
+	for _, b := range backups {
+		if b.ID == backupID {
+			return true
+		}
+	}
+	return false
+}
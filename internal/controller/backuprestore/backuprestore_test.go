@@ -0,0 +1,176 @@
+/*
+Copyright 2025 Ross Golder.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backuprestore
+
+import (
+	"context"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+
+	v1beta1 "github.com/rossigee/provider-hostinger/apis/backup/v1beta1"
+	"github.com/rossigee/provider-hostinger/internal/clients"
+	backupclient "github.com/rossigee/provider-hostinger/internal/clients/backup"
+)
+
+// MockBackupClient is a mock implementation of backupclient.Client
+type MockBackupClient struct {
+	backups       []*backupclient.Backup
+	action        *clients.Action
+	restoreCalled bool
+}
+
+func (m *MockBackupClient) List(ctx context.Context, instanceID string) ([]*backupclient.Backup, error) {
+	return m.backups, nil
+}
+
+func (m *MockBackupClient) Create(ctx context.Context, instanceID string) (*clients.Action, error) {
+	return nil, nil
+}
+
+func (m *MockBackupClient) Delete(ctx context.Context, instanceID, backupID string) error {
+	return nil
+}
+
+func (m *MockBackupClient) Limit(ctx context.Context, instanceID string) (int32, error) {
+	return 0, nil
+}
+
+func (m *MockBackupClient) Restore(ctx context.Context, instanceID, backupID string) (*clients.Action, error) {
+	m.restoreCalled = true
+	return &clients.Action{ID: 8123, State: clients.ActionStateInitiated, CreatedAt: "2025-02-27T11:54:22Z"}, nil
+}
+
+func (m *MockBackupClient) GetAction(ctx context.Context, instanceID string, actionID int64) (*clients.Action, error) {
+	return m.action, nil
+}
+
+func newTestRestore(confirm bool) *v1beta1.BackupRestore {
+	instanceID := "123"
+	cr := &v1beta1.BackupRestore{}
+	cr.Spec.ForProvider.InstanceID = &instanceID
+	cr.Spec.ForProvider.BackupID = "10"
+	cr.Spec.ForProvider.ConfirmDataLoss = confirm
+	return cr
+}
+
+func TestExternalCreate_RequiresConfirmation(t *testing.T) {
+	mock := &MockBackupClient{backups: []*backupclient.Backup{{ID: "10"}}}
+	ext := &external{client: mock}
+
+	if _, err := ext.Create(context.Background(), newTestRestore(false)); err == nil {
+		t.Fatal("Create() error = nil, want error without confirmDataLoss")
+	}
+	if mock.restoreCalled {
+		t.Error("Restore should not be called without confirmation")
+	}
+}
+
+func TestExternalCreate_UnknownBackup(t *testing.T) {
+	mock := &MockBackupClient{backups: []*backupclient.Backup{{ID: "20"}}}
+	ext := &external{client: mock}
+
+	if _, err := ext.Create(context.Background(), newTestRestore(true)); err == nil {
+		t.Fatal("Create() error = nil, want error for a backup of another instance")
+	}
+	if mock.restoreCalled {
+		t.Error("Restore should not be called for an unknown backup")
+	}
+}
+
+func TestExternalCreate(t *testing.T) {
+	mock := &MockBackupClient{backups: []*backupclient.Backup{{ID: "10"}}}
+	ext := &external{client: mock}
+	cr := newTestRestore(true)
+
+	if _, err := ext.Create(context.Background(), cr); err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+	if meta.GetExternalName(cr) != "8123" {
+		t.Errorf("external name = %q, want the action ID", meta.GetExternalName(cr))
+	}
+}
+
+func TestExternalObserve_Progress(t *testing.T) {
+	mock := &MockBackupClient{action: &clients.Action{ID: 8123, State: clients.ActionStateSent, CreatedAt: "2025-02-27T11:54:22Z"}}
+	ext := &external{client: mock}
+	cr := newTestRestore(true)
+	meta.SetExternalName(cr, "8123")
+
+	if _, err := ext.Observe(context.Background(), cr); err != nil {
+		t.Fatalf("Observe() error = %v", err)
+	}
+	if cr.Status.AtProvider.ActionID == nil || cr.Status.AtProvider.State != clients.ActionStateSent || cr.Status.AtProvider.StartedAt == nil {
+		t.Errorf("status = %+v, want the running action", cr.Status.AtProvider)
+	}
+	if cr.Status.AtProvider.CompletedAt != nil {
+		t.Error("CompletedAt should not be set while the restore is running")
+	}
+	if got := cr.GetCondition(xpv1.TypeReady).Reason; got != xpv1.ReasonUnavailable {
+		t.Errorf("Ready reason = %v, want %v", got, xpv1.ReasonUnavailable)
+	}
+
+	mock.action = &clients.Action{ID: 8123, State: clients.ActionStateSuccess, UpdatedAt: "2025-02-27T12:10:00Z"}
+	if _, err := ext.Observe(context.Background(), cr); err != nil {
+		t.Fatalf("Observe() error = %v", err)
+	}
+	if cr.Status.AtProvider.CompletedAt == nil || cr.Status.AtProvider.State != clients.ActionStateSuccess {
+		t.Errorf("status = %+v, want completed restore", cr.Status.AtProvider)
+	}
+	if got := cr.GetCondition(xpv1.TypeReady).Reason; got != xpv1.ReasonAvailable {
+		t.Errorf("Ready reason = %v, want %v", got, xpv1.ReasonAvailable)
+	}
+}
+
+func TestExternalObserve_NoActionTime(t *testing.T) {
+	mock := &MockBackupClient{action: &clients.Action{ID: 8123, State: clients.ActionStateError}}
+	ext := &external{client: mock}
+	cr := newTestRestore(true)
+	meta.SetExternalName(cr, "8123")
+
+	if _, err := ext.Observe(context.Background(), cr); err != nil {
+		t.Fatalf("Observe() error = %v", err)
+	}
+	if cr.Status.AtProvider.StartedAt != nil || cr.Status.AtProvider.CompletedAt != nil {
+		t.Errorf("status = %+v, want no times when the API reports none", cr.Status.AtProvider)
+	}
+
+	// The finished restore is not looked up again
+	mock.action = nil
+	if _, err := ext.Observe(context.Background(), cr); err != nil {
+		t.Fatalf("Observe() error = %v", err)
+	}
+}
+
+func TestExternalObserve_Deleted(t *testing.T) {
+	ext := &external{client: &MockBackupClient{}}
+	cr := newTestRestore(true)
+	meta.SetExternalName(cr, "8123")
+	now := metav1.Now()
+	cr.SetDeletionTimestamp(&now)
+
+	obs, err := ext.Observe(context.Background(), cr)
+	if err != nil {
+		t.Fatalf("Observe() error = %v", err)
+	}
+	if obs.ResourceExists {
+		t.Error("Observe() should let a deleted restore go, as there is nothing to delete")
+	}
+}
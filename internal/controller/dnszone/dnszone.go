@@ -0,0 +1,338 @@
+/*
+Copyright 2025 Ross Golder.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dnszone
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/workqueue"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/event"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+
+	v1beta1 "github.com/rossigee/provider-hostinger/apis/dns/v1beta1"
+	providerv1beta1 "github.com/rossigee/provider-hostinger/apis/v1beta1"
+	"github.com/rossigee/provider-hostinger/internal/clients"
+	dnsclient "github.com/rossigee/provider-hostinger/internal/clients/dns"
+)
+
+const (
+	errNotDNSZone = "managed resource is not a DNSZone custom resource"
+	errGetPC      = "cannot get ProviderConfig"
+	errNewClient  = "cannot create new Hostinger client"
+
+	errGetZone       = "failed to get DNS zone"
+	errResetZone     = "failed to reset DNS zone"
+	errListRecords   = "cannot list Records"
+	errDeleteRecords = "failed to delete unmanaged DNS records"
+	errUpdateZone    = "failed to remove unmanaged DNS records"
+)
+
+// Setup adds a controller that reconciles DNSZone managed resources.
+func Setup(mgr ctrl.Manager, l logging.Logger, wl workqueue.TypedRateLimiter[any]) error {
+	name := managed.ControllerName(v1beta1.DNSZoneGroupKind)
+
+	o := controller.Options{
+		MaxConcurrentReconciles: 5,
+	}
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(v1beta1.DNSZoneGroupVersionKind),
+		managed.WithExternalConnecter(&connector{
+			kube:        mgr.GetClient(),
+			newClientFn: clients.NewClientFactory,
+		}),
+		managed.WithLogger(l.WithValues("controller", name)),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		managed.WithPollInterval(5*time.Minute),
+		managed.WithInitializers(),
+	)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o).
+		For(&v1beta1.DNSZone{}).
+		Watches(&v1beta1.Record{}, handler.EnqueueRequestsFromMapFunc(recordZones(mgr.GetClient()))).
+		Complete(r)
+}
+
+// recordZones maps a Record to the DNSZones of its zone in any namespace, so
+// that the record counts follow the Records without waiting for the next
+// poll.
+func recordZones(kube client.Client) handler.MapFunc {
+	return func(ctx context.Context, obj client.Object) []reconcile.Request {
+		record, ok := obj.(*v1beta1.Record)
+		if !ok {
+			return nil
+		}
+		zones := &v1beta1.DNSZoneList{}
+		if err := kube.List(ctx, zones); err != nil {
+			return nil
+		}
+		requests := []reconcile.Request{}
+		for _, z := range zones.Items {
+			if sameZone(z.Spec.ForProvider.Zone, record.Spec.ForProvider.Zone) {
+				requests = append(requests, reconcile.Request{NamespacedName: types.NamespacedName{Namespace: z.GetNamespace(), Name: z.GetName()}})
+			}
+		}
+		return requests
+	}
+}
+
+// A connector is expected to produce typed ExternalClient for the managed
+// resource it is supposed to manage.
+type connector struct {
+	kube        client.Client
+	newClientFn func(client.Client, clients.HTTPClientConfig) *clients.ClientFactory
+}
+
+// Connect produces an ExternalClient using the credentials of the
+// ProviderConfig referenced by the DNSZone.
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1beta1.DNSZone)
+	if !ok {
+		return nil, errors.New(errNotDNSZone)
+	}
+
+	pc := &providerv1beta1.ProviderConfig{}
+	if err := c.kube.Get(ctx, client.ObjectKey{Namespace: cr.GetNamespace(), Name: cr.Spec.ProviderConfigReference.Name}, pc); err != nil {
+		return nil, errors.Wrap(err, errGetPC)
+	}
+
+	clientFactory := c.newClientFn(c.kube, clients.DefaultHTTPClientConfig())
+
+	hc, err := clientFactory.CreateHostingerClient(ctx, pc)
+	if err != nil {
+		return nil, errors.Wrap(err, errNewClient)
+	}
+
+	return &external{kube: c.kube, client: dnsclient.NewDNSClient(hc)}, nil
+}
+
+// An ExternalClient observes, then either creates, updates, or deletes an
+// external resource to ensure it reflects the managed resource's desired state.
+type external struct {
+	kube   client.Client
+	client dnsclient.Client
+}
+
+// Observe counts the managed, excluded and unmanaged records of the zone.
+// An authoritative zone is up to date once it has no unmanaged records.
+func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1beta1.DNSZone)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotDNSZone)
+	}
+
+	// The external name is the zone
+	externalName := meta.GetExternalName(cr)
+	if externalName == "" {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	// Delete leaves the zone in place, so there is nothing left to delete
+	// once the resource is being deleted
+	if meta.WasDeleted(cr) {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	c, err := e.classify(ctx, cr, externalName)
+	if err != nil {
+		if clients.IsNotFound(errors.Cause(err)) {
+			return managed.ExternalObservation{ResourceExists: false}, nil
+		}
+		return managed.ExternalObservation{}, err
+	}
+
+	unmanaged := []string{}
+	for _, set := range c.Unmanaged {
+		unmanaged = append(unmanaged, dnsclient.SetKey(externalName, set.Name, set.Type))
+	}
+	cr.Status.AtProvider.ManagedRecords = int32(c.Managed)
+	cr.Status.AtProvider.ExcludedRecords = int32(c.Excluded)
+	cr.Status.AtProvider.UnmanagedRecords = int32(c.UnmanagedCount())
+	cr.Status.AtProvider.UnmanagedRecordSets = unmanaged
+
+	condition := xpv1.Available()
+	if len(unmanaged) > 0 && !authoritative(cr) {
+		condition = condition.WithMessage(fmt.Sprintf("%d unmanaged records in %s", c.UnmanagedCount(), strings.Join(unmanaged, ", ")))
+	}
+	cr.SetConditions(condition)
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: !authoritative(cr) || len(unmanaged) == 0,
+	}, nil
+}
+
+// Create resets the zone to the Hostinger defaults if requested. The zone
+// itself belongs to the domain and is not created.
+func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1beta1.DNSZone)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotDNSZone)
+	}
+
+	zone := cr.Spec.ForProvider.Zone
+	if reset := cr.Spec.ForProvider.ResetOnCreate; reset != nil {
+		resetEmail := reset.ResetEmailRecords == nil || *reset.ResetEmailRecords
+		keep := make([]string, 0, len(reset.KeepRecordTypes))
+		for _, t := range reset.KeepRecordTypes {
+			keep = append(keep, string(t))
+		}
+		if err := e.client.ResetZone(ctx, zone, resetEmail, keep); err != nil {
+			return managed.ExternalCreation{}, errors.Wrap(err, errResetZone)
+		}
+	} else if _, err := e.client.GetZone(ctx, zone); err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errGetZone)
+	}
+
+	meta.SetExternalName(cr, zone)
+	return managed.ExternalCreation{}, nil
+}
+
+// Update removes the unmanaged records of an authoritative zone. Record sets
+// with no managed or disabled values left are deleted, others are rewritten
+// with only their managed and disabled values.
+func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1beta1.DNSZone)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotDNSZone)
+	}
+
+	if !authoritative(cr) {
+		return managed.ExternalUpdate{}, nil
+	}
+
+	zone := meta.GetExternalName(cr)
+	c, err := e.classify(ctx, cr, zone)
+	if err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+
+	filters := []dnsclient.Filter{}
+	rewrite := []dnsclient.RecordSet{}
+	for _, set := range c.Kept {
+		if len(set.Values) == 0 && len(set.Disabled) == 0 {
+			filters = append(filters, dnsclient.Filter{Name: set.Name, Type: set.Type})
+		} else {
+			rewrite = append(rewrite, set)
+		}
+	}
+	if len(filters) > 0 {
+		if err := e.client.DeleteRecords(ctx, zone, filters); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errDeleteRecords)
+		}
+	}
+	if len(rewrite) > 0 {
+		if err := e.client.UpdateZone(ctx, zone, true, rewrite); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateZone)
+		}
+	}
+
+	return managed.ExternalUpdate{}, nil
+}
+
+// Delete does nothing: the zone belongs to the domain and its records are
+// left in place.
+func (e *external) Delete(ctx context.Context, mg resource.Managed) (managed.ExternalDelete, error) {
+	if _, ok := mg.(*v1beta1.DNSZone); !ok {
+		return managed.ExternalDelete{}, errors.New(errNotDNSZone)
+	}
+	return managed.ExternalDelete{}, nil
+}
+
+// classify compares the records of the zone with those declared by the
+// Records for the zone
+func (e *external) classify(ctx context.Context, cr *v1beta1.DNSZone, zone string) (dnsclient.Classification, error) {
+	sets, err := e.client.GetZone(ctx, zone)
+	if err != nil {
+		return dnsclient.Classification{}, errors.Wrap(err, errGetZone)
+	}
+	declared, err := e.declared(ctx, zone)
+	if err != nil {
+		return dnsclient.Classification{}, err
+	}
+	exclude := make([]dnsclient.Filter, 0, len(cr.Spec.ForProvider.Exclude))
+	for _, f := range cr.Spec.ForProvider.Exclude {
+		filter := dnsclient.Filter{Name: f.Name}
+		if f.Type != nil {
+			filter.Type = *f.Type
+		}
+		exclude = append(exclude, filter)
+	}
+	return dnsclient.Classify(zone, sets, declared, exclude), nil
+}
+
+// declared returns the values declared by the Records for the zone in any
+// namespace, as the zone is shared by all of them. Both
+// the values in spec and those a Record still owns count, so values are not
+// removed while a Record is moving off them. A Record whose values are not
+// known yet, such as one waiting for an instance address, declares its whole
+// record set.
+func (e *external) declared(ctx context.Context, zone string) (dnsclient.Declared, error) {
+	records := &v1beta1.RecordList{}
+	if err := e.kube.List(ctx, records); err != nil {
+		return nil, errors.Wrap(err, errListRecords)
+	}
+	declared := dnsclient.Declared{}
+	for _, r := range records.Items {
+		p := r.Spec.ForProvider
+		if !sameZone(p.Zone, zone) {
+			continue
+		}
+		values, err := dnsclient.NormalizeValues(string(p.Type), p.Values)
+		if err != nil {
+			values = nil
+		}
+		values = append(values, r.Status.AtProvider.ManagedValues...)
+		if len(values) == 0 {
+			values = nil
+		}
+		declared.Add(dnsclient.SetKey(zone, p.Name, string(p.Type)), values)
+	}
+	return declared, nil
+}
+
+// authoritative reports whether the DNSZone removes unmanaged records
+func authoritative(cr *v1beta1.DNSZone) bool {
+	return cr.Spec.ForProvider.Authoritative != nil && *cr.Spec.ForProvider.Authoritative
+}
+
+// sameZone reports whether two zone names are the same domain
+func sameZone(a, b string) bool {
+	return strings.EqualFold(strings.TrimSuffix(a, "."), strings.TrimSuffix(b, "."))
+}
+
+// Disconnect closes the connection to the external service.
+func (e *external) Disconnect(ctx context.Context) error {
+	return nil
+}
@@ -0,0 +1,261 @@
+/*
+Copyright 2025 Ross Golder.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dnszone
+
+import (
+	"context"
+	"net/http"
+	"reflect"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+
+	v1beta1 "github.com/rossigee/provider-hostinger/apis/dns/v1beta1"
+	"github.com/rossigee/provider-hostinger/internal/clients"
+	dnsclient "github.com/rossigee/provider-hostinger/internal/clients/dns"
+)
+
+// MockDNSClient is a mock implementation of dnsclient.Client
+type MockDNSClient struct {
+	zone    []dnsclient.RecordSet
+	updates [][]dnsclient.RecordSet
+	deletes [][]dnsclient.Filter
+	resets  [][]string
+}
+
+func (m *MockDNSClient) GetZone(ctx context.Context, domain string) ([]dnsclient.RecordSet, error) {
+	if m.zone == nil {
+		return nil, clients.ClassifyError(http.StatusNotFound, "not found")
+	}
+	return m.zone, nil
+}
+
+func (m *MockDNSClient) UpdateZone(ctx context.Context, domain string, overwrite bool, sets []dnsclient.RecordSet) error {
+	m.updates = append(m.updates, sets)
+	return nil
+}
+
+func (m *MockDNSClient) DeleteRecords(ctx context.Context, domain string, filters []dnsclient.Filter) error {
+	m.deletes = append(m.deletes, filters)
+	return nil
+}
+
+func (m *MockDNSClient) ResetZone(ctx context.Context, domain string, resetEmailRecords bool, keepTypes []string) error {
+	m.resets = append(m.resets, keepTypes)
+	return nil
+}
+
+func (m *MockDNSClient) ListSnapshots(ctx context.Context, domain string) ([]*dnsclient.Snapshot, error) {
+	return nil, nil
+}
+
+func (m *MockDNSClient) RestoreSnapshot(ctx context.Context, domain, snapshotID string) error {
+	return nil
+}
+
+func newTestDNSZone(authoritative bool) *v1beta1.DNSZone {
+	cr := &v1beta1.DNSZone{}
+	cr.SetNamespace("default")
+	cr.SetName("example-com")
+	cr.Spec.ForProvider.Zone = "example.com"
+	cr.Spec.ForProvider.Authoritative = &authoritative
+	meta.SetExternalName(cr, "example.com")
+	return cr
+}
+
+func newTestRecord(name, zone, recordName string, recordType v1beta1.RecordType, values ...string) *v1beta1.Record {
+	r := &v1beta1.Record{}
+	r.SetNamespace("default")
+	r.SetName(name)
+	r.Spec.ForProvider.Zone = zone
+	r.Spec.ForProvider.Name = recordName
+	r.Spec.ForProvider.Type = recordType
+	r.Spec.ForProvider.Values = values
+	return r
+}
+
+func newTestExternal(mock *MockDNSClient, objs ...client.Object) *external {
+	s := runtime.NewScheme()
+	_ = clientgoscheme.AddToScheme(s)
+	_ = v1beta1.SchemeBuilder.AddToScheme(s)
+	return &external{kube: fake.NewClientBuilder().WithScheme(s).WithObjects(objs...).Build(), client: mock}
+}
+
+func newTestZone() []dnsclient.RecordSet {
+	return []dnsclient.RecordSet{
+		{Name: "@", Type: "NS", TTL: 86400, Values: []string{"ns1.dns-parking.com", "ns2.dns-parking.com"}},
+		{Name: "@", Type: "A", TTL: 300, Values: []string{"192.0.2.1", "198.51.100.7"}},
+		{Name: "ftp", Type: "A", TTL: 300, Values: []string{"198.51.100.8"}},
+		{Name: "_dmarc", Type: "TXT", TTL: 300, Values: []string{"v=DMARC1; p=none"}},
+	}
+}
+
+func newTestRecords() []client.Object {
+	return []client.Object{
+		newTestRecord("apex", "example.com", "@", v1beta1.RecordTypeA, "192.0.2.1"),
+		newTestRecord("other", "example.org", "ftp", v1beta1.RecordTypeA, "198.51.100.8"),
+	}
+}
+
+func TestExternalObserve(t *testing.T) {
+	e := newTestExternal(&MockDNSClient{zone: newTestZone()}, newTestRecords()...)
+	cr := newTestDNSZone(false)
+	cr.Spec.ForProvider.Exclude = []v1beta1.DNSZoneRecordFilter{{Name: "_dmarc"}}
+
+	obs, err := e.Observe(context.Background(), cr)
+	if err != nil {
+		t.Fatalf("Observe() error = %v", err)
+	}
+	if !obs.ResourceExists || !obs.ResourceUpToDate {
+		t.Errorf("Observe() = %+v, want existing and up to date when not authoritative", obs)
+	}
+	got := cr.Status.AtProvider
+	if got.ManagedRecords != 1 || got.ExcludedRecords != 3 || got.UnmanagedRecords != 2 {
+		t.Errorf("status = %+v, want 1 managed, 3 excluded and 2 unmanaged records", got)
+	}
+	if want := []string{"@/A", "ftp/A"}; !reflect.DeepEqual(got.UnmanagedRecordSets, want) {
+		t.Errorf("UnmanagedRecordSets = %v, want %v", got.UnmanagedRecordSets, want)
+	}
+
+	cr = newTestDNSZone(true)
+	obs, err = e.Observe(context.Background(), cr)
+	if err != nil {
+		t.Fatalf("Observe() error = %v", err)
+	}
+	if obs.ResourceUpToDate {
+		t.Error("Observe() ResourceUpToDate = true, want false for an authoritative zone with unmanaged records")
+	}
+}
+
+func TestExternalObserveDeleted(t *testing.T) {
+	e := newTestExternal(&MockDNSClient{zone: newTestZone()}, newTestRecords()...)
+	cr := newTestDNSZone(true)
+	now := metav1.Now()
+	cr.SetDeletionTimestamp(&now)
+
+	obs, err := e.Observe(context.Background(), cr)
+	if err != nil {
+		t.Fatalf("Observe() error = %v", err)
+	}
+	if obs.ResourceExists {
+		t.Error("Observe() ResourceExists = true, want false for a deleted DNSZone")
+	}
+}
+
+func TestExternalUpdateRemovesUnmanaged(t *testing.T) {
+	mock := &MockDNSClient{zone: newTestZone()}
+	e := newTestExternal(mock, newTestRecords()...)
+	cr := newTestDNSZone(true)
+	typ := "TXT"
+	cr.Spec.ForProvider.Exclude = []v1beta1.DNSZoneRecordFilter{{Name: "_dmarc", Type: &typ}}
+
+	if _, err := e.Update(context.Background(), cr); err != nil {
+		t.Fatalf("Update() error = %v", err)
+	}
+	if want := [][]dnsclient.Filter{{{Name: "ftp", Type: "A"}}}; !reflect.DeepEqual(mock.deletes, want) {
+		t.Errorf("deletes = %+v, want %+v", mock.deletes, want)
+	}
+	if want := [][]dnsclient.RecordSet{{{Name: "@", Type: "A", TTL: 300, Values: []string{"192.0.2.1"}}}}; !reflect.DeepEqual(mock.updates, want) {
+		t.Errorf("updates = %+v, want %+v", mock.updates, want)
+	}
+}
+
+func TestExternalUpdateKeepsDisabledRecords(t *testing.T) {
+	mock := &MockDNSClient{zone: []dnsclient.RecordSet{
+		{Name: "@", Type: "A", TTL: 300, Values: []string{"192.0.2.1", "198.51.100.7"}, Disabled: []string{"198.51.100.9"}},
+		{Name: "ftp", Type: "A", TTL: 300, Values: []string{"198.51.100.8"}, Disabled: []string{"198.51.100.10"}},
+	}}
+	e := newTestExternal(mock, newTestRecords()...)
+
+	if _, err := e.Update(context.Background(), newTestDNSZone(true)); err != nil {
+		t.Fatalf("Update() error = %v", err)
+	}
+	if len(mock.deletes) != 0 {
+		t.Errorf("deletes = %+v, want record sets with disabled records kept", mock.deletes)
+	}
+	want := [][]dnsclient.RecordSet{{
+		{Name: "@", Type: "A", TTL: 300, Values: []string{"192.0.2.1"}, Disabled: []string{"198.51.100.9"}},
+		{Name: "ftp", Type: "A", TTL: 300, Values: []string{}, Disabled: []string{"198.51.100.10"}},
+	}}
+	if !reflect.DeepEqual(mock.updates, want) {
+		t.Errorf("updates = %+v, want %+v", mock.updates, want)
+	}
+}
+
+func TestExternalUpdateKeepsOtherNamespaceRecords(t *testing.T) {
+	mock := &MockDNSClient{zone: newTestZone()}
+	other := newTestRecord("ftp", "example.com", "ftp", v1beta1.RecordTypeA, "198.51.100.8")
+	other.SetNamespace("team-b")
+	e := newTestExternal(mock, append(newTestRecords(), other)...)
+	cr := newTestDNSZone(true)
+	cr.Spec.ForProvider.Exclude = []v1beta1.DNSZoneRecordFilter{{Name: "_dmarc"}}
+
+	if _, err := e.Update(context.Background(), cr); err != nil {
+		t.Fatalf("Update() error = %v", err)
+	}
+	if len(mock.deletes) != 0 {
+		t.Errorf("deletes = %+v, want the record set of a Record in another namespace kept", mock.deletes)
+	}
+}
+
+func TestExternalUpdateNotAuthoritative(t *testing.T) {
+	mock := &MockDNSClient{zone: newTestZone()}
+	if _, err := newTestExternal(mock).Update(context.Background(), newTestDNSZone(false)); err != nil {
+		t.Fatalf("Update() error = %v", err)
+	}
+	if len(mock.deletes) != 0 || len(mock.updates) != 0 {
+		t.Errorf("deletes = %+v, updates = %+v, want no changes", mock.deletes, mock.updates)
+	}
+}
+
+func TestExternalUnknownRecordValues(t *testing.T) {
+	// A Record waiting for an instance address declares its whole set
+	r := newTestRecord("apex", "example.com", "@", v1beta1.RecordTypeA)
+	mock := &MockDNSClient{zone: newTestZone()}
+	e := newTestExternal(mock, r)
+	cr := newTestDNSZone(true)
+
+	if _, err := e.Observe(context.Background(), cr); err != nil {
+		t.Fatalf("Observe() error = %v", err)
+	}
+	if cr.Status.AtProvider.ManagedRecords != 2 {
+		t.Errorf("ManagedRecords = %d, want the whole @/A set", cr.Status.AtProvider.ManagedRecords)
+	}
+}
+
+func TestExternalCreateResets(t *testing.T) {
+	mock := &MockDNSClient{zone: newTestZone()}
+	cr := newTestDNSZone(true)
+	meta.SetExternalName(cr, "")
+	cr.Spec.ForProvider.ResetOnCreate = &v1beta1.DNSZoneReset{KeepRecordTypes: []v1beta1.RecordType{v1beta1.RecordTypeMX}}
+
+	if _, err := newTestExternal(mock).Create(context.Background(), cr); err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+	if want := [][]string{{"MX"}}; !reflect.DeepEqual(mock.resets, want) {
+		t.Errorf("resets = %v, want %v", mock.resets, want)
+	}
+	if meta.GetExternalName(cr) != "example.com" {
+		t.Errorf("external name = %q, want example.com", meta.GetExternalName(cr))
+	}
+}
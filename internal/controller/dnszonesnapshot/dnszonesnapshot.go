@@ -0,0 +1,248 @@
+/*
+Copyright 2025 Ross Golder.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dnszonesnapshot
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/util/workqueue"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/event"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+
+	v1beta1 "github.com/rossigee/provider-hostinger/apis/dns/v1beta1"
+	providerv1beta1 "github.com/rossigee/provider-hostinger/apis/v1beta1"
+	"github.com/rossigee/provider-hostinger/internal/clients"
+	dnsclient "github.com/rossigee/provider-hostinger/internal/clients/dns"
+)
+
+const (
+	errNotDNSZoneSnapshot = "managed resource is not a DNSZoneSnapshot custom resource"
+	errGetPC              = "cannot get ProviderConfig"
+	errNewClient          = "cannot create new Hostinger client"
+
+	errListSnapshots   = "failed to list DNS zone snapshots"
+	errUnknownSnapshot = "DNS zone has no snapshot with ID"
+	errRestoreSnapshot = "failed to restore DNS zone snapshot"
+)
+
+// Setup adds a controller that reconciles DNSZoneSnapshot managed resources.
+func Setup(mgr ctrl.Manager, l logging.Logger, wl workqueue.TypedRateLimiter[any]) error {
+	name := managed.ControllerName(v1beta1.DNSZoneSnapshotGroupKind)
+
+	o := controller.Options{
+		MaxConcurrentReconciles: 5,
+	}
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(v1beta1.DNSZoneSnapshotGroupVersionKind),
+		managed.WithExternalConnecter(&connector{
+			kube:        mgr.GetClient(),
+			newClientFn: clients.NewClientFactory,
+		}),
+		managed.WithLogger(l.WithValues("controller", name)),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		managed.WithPollInterval(5*time.Minute),
+		managed.WithInitializers(),
+	)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o).
+		For(&v1beta1.DNSZoneSnapshot{}).
+		Complete(r)
+}
+
+// A connector is expected to produce typed ExternalClient for the managed
+// resource it is supposed to manage.
+type connector struct {
+	kube        client.Client
+	newClientFn func(client.Client, clients.HTTPClientConfig) *clients.ClientFactory
+}
+
+// Connect produces an ExternalClient using the credentials of the
+// ProviderConfig referenced by the DNSZoneSnapshot.
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1beta1.DNSZoneSnapshot)
+	if !ok {
+		return nil, errors.New(errNotDNSZoneSnapshot)
+	}
+
+	pc := &providerv1beta1.ProviderConfig{}
+	if err := c.kube.Get(ctx, client.ObjectKey{Namespace: cr.GetNamespace(), Name: cr.Spec.ProviderConfigReference.Name}, pc); err != nil {
+		return nil, errors.Wrap(err, errGetPC)
+	}
+
+	clientFactory := c.newClientFn(c.kube, clients.DefaultHTTPClientConfig())
+
+	hc, err := clientFactory.CreateHostingerClient(ctx, pc)
+	if err != nil {
+		return nil, errors.Wrap(err, errNewClient)
+	}
+
+	return &external{client: dnsclient.NewDNSClient(hc)}, nil
+}
+
+// An ExternalClient observes, then either creates, updates, or deletes an
+// external resource to ensure it reflects the managed resource's desired state.
+type external struct {
+	client dnsclient.Client
+}
+
+// Observe lists the snapshots of the zone. The resource is up to date unless
+// a restore is requested by increasing restoreGeneration.
+func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1beta1.DNSZoneSnapshot)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotDNSZoneSnapshot)
+	}
+
+	// The external name is the zone
+	externalName := meta.GetExternalName(cr)
+	if externalName == "" {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	// Delete keeps the snapshots, so there is nothing left to delete once
+	// the resource is being deleted
+	if meta.WasDeleted(cr) {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	snapshots, err := e.client.ListSnapshots(ctx, externalName)
+	if err != nil {
+		if clients.IsNotFound(err) {
+			return managed.ExternalObservation{ResourceExists: false}, nil
+		}
+		return managed.ExternalObservation{}, errors.Wrap(err, errListSnapshots)
+	}
+
+	// Creating, adopting or re-applying the resource must not restore the
+	// zone, so the first restoreGeneration seen is taken as already restored
+	if cr.Status.AtProvider.RestoredGeneration == nil {
+		gen := restoreGeneration(cr)
+		cr.Status.AtProvider.RestoredGeneration = &gen
+	}
+
+	infos := make([]v1beta1.DNSZoneSnapshotInfo, 0, len(snapshots))
+	for _, s := range snapshots {
+		infos = append(infos, dnsclient.GetSnapshotInfo(s))
+	}
+	cr.Status.AtProvider.Snapshots = infos
+	cr.Status.AtProvider.LatestSnapshotID = ""
+	if len(snapshots) > 0 {
+		cr.Status.AtProvider.LatestSnapshotID = snapshots[0].ID
+	}
+	cr.SetConditions(xpv1.Available())
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: !restoreRequested(cr),
+	}, nil
+}
+
+// Create checks that the zone has snapshots to list. Hostinger takes the
+// snapshots itself, so there is nothing to create.
+func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1beta1.DNSZoneSnapshot)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotDNSZoneSnapshot)
+	}
+
+	if _, err := e.client.ListSnapshots(ctx, cr.Spec.ForProvider.Zone); err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errListSnapshots)
+	}
+
+	meta.SetExternalName(cr, cr.Spec.ForProvider.Zone)
+	return managed.ExternalCreation{}, nil
+}
+
+// Update restores the zone to the requested snapshot.
+func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1beta1.DNSZoneSnapshot)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotDNSZoneSnapshot)
+	}
+
+	if !restoreRequested(cr) {
+		return managed.ExternalUpdate{}, nil
+	}
+
+	snapshotID := *cr.Spec.ForProvider.RestoreSnapshotID
+	if !hasSnapshot(cr, snapshotID) {
+		return managed.ExternalUpdate{}, errors.Errorf("%s %s", errUnknownSnapshot, snapshotID)
+	}
+	if err := e.client.RestoreSnapshot(ctx, meta.GetExternalName(cr), snapshotID); err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errRestoreSnapshot)
+	}
+
+	now := metav1.Now()
+	gen := restoreGeneration(cr)
+	cr.Status.AtProvider.RestoredSnapshotID = snapshotID
+	cr.Status.AtProvider.RestoredAt = &now
+	cr.Status.AtProvider.RestoredGeneration = &gen
+
+	return managed.ExternalUpdate{}, nil
+}
+
+// Delete does nothing: the snapshots belong to the zone and are kept.
+func (e *external) Delete(ctx context.Context, mg resource.Managed) (managed.ExternalDelete, error) {
+	if _, ok := mg.(*v1beta1.DNSZoneSnapshot); !ok {
+		return managed.ExternalDelete{}, errors.New(errNotDNSZoneSnapshot)
+	}
+	return managed.ExternalDelete{}, nil
+}
+
+// restoreRequested reports whether restoreGeneration has been increased past
+// the generation of the last restore.
+func restoreRequested(cr *v1beta1.DNSZoneSnapshot) bool {
+	restored := cr.Status.AtProvider.RestoredGeneration
+	return cr.Spec.ForProvider.RestoreSnapshotID != nil && restored != nil && restoreGeneration(cr) > *restored
+}
+
+// restoreGeneration returns the requested restore generation, 0 if unset.
+func restoreGeneration(cr *v1beta1.DNSZoneSnapshot) int64 {
+	if gen := cr.Spec.ForProvider.RestoreGeneration; gen != nil {
+		return *gen
+	}
+	return 0
+}
+
+// hasSnapshot reports whether the observed snapshots include snapshotID.
+func hasSnapshot(cr *v1beta1.DNSZoneSnapshot, snapshotID string) bool {
+	for _, s := range cr.Status.AtProvider.Snapshots {
+		if s.ID == snapshotID {
+			return true
+		}
+	}
+	return false
+}
+
+// Disconnect closes the connection to the external service.
+func (e *external) Disconnect(ctx context.Context) error {
+	return nil
+}
@@ -0,0 +1,221 @@
+/*
+Copyright 2025 Ross Golder.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dnszonesnapshot
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+
+	v1beta1 "github.com/rossigee/provider-hostinger/apis/dns/v1beta1"
+	"github.com/rossigee/provider-hostinger/internal/clients"
+	dnsclient "github.com/rossigee/provider-hostinger/internal/clients/dns"
+)
+
+// MockDNSClient is a mock implementation of dnsclient.Client
+type MockDNSClient struct {
+	snapshots []*dnsclient.Snapshot
+	restored  []string
+}
+
+func (m *MockDNSClient) GetZone(ctx context.Context, domain string) ([]dnsclient.RecordSet, error) {
+	return nil, nil
+}
+
+func (m *MockDNSClient) UpdateZone(ctx context.Context, domain string, overwrite bool, sets []dnsclient.RecordSet) error {
+	return nil
+}
+
+func (m *MockDNSClient) DeleteRecords(ctx context.Context, domain string, filters []dnsclient.Filter) error {
+	return nil
+}
+
+func (m *MockDNSClient) ResetZone(ctx context.Context, domain string, resetEmailRecords bool, keepTypes []string) error {
+	return nil
+}
+
+func (m *MockDNSClient) ListSnapshots(ctx context.Context, domain string) ([]*dnsclient.Snapshot, error) {
+	if m.snapshots == nil {
+		return nil, clients.ClassifyError(http.StatusNotFound, "not found")
+	}
+	return m.snapshots, nil
+}
+
+func (m *MockDNSClient) RestoreSnapshot(ctx context.Context, domain, snapshotID string) error {
+	m.restored = append(m.restored, snapshotID)
+	return nil
+}
+
+func newTestDNSZoneSnapshot() *v1beta1.DNSZoneSnapshot {
+	cr := &v1beta1.DNSZoneSnapshot{}
+	cr.SetNamespace("default")
+	cr.SetName("example-com")
+	cr.Spec.ForProvider.Zone = "example.com"
+	meta.SetExternalName(cr, "example.com")
+	return cr
+}
+
+func newTestSnapshots() []*dnsclient.Snapshot {
+	newer := "2025-02-03T10:00:00Z"
+	older := "2025-02-01T10:00:00Z"
+	return []*dnsclient.Snapshot{
+		{ID: "2", Reason: "Zone updated", CreatedAt: &newer},
+		{ID: "1", Reason: "Zone updated", CreatedAt: &older},
+	}
+}
+
+func TestExternalObserve(t *testing.T) {
+	e := &external{client: &MockDNSClient{snapshots: newTestSnapshots()}}
+	cr := newTestDNSZoneSnapshot()
+
+	obs, err := e.Observe(context.Background(), cr)
+	if err != nil {
+		t.Fatalf("Observe() error = %v", err)
+	}
+	if !obs.ResourceExists || !obs.ResourceUpToDate {
+		t.Errorf("Observe() = %+v, want existing and up to date", obs)
+	}
+	if len(cr.Status.AtProvider.Snapshots) != 2 || cr.Status.AtProvider.LatestSnapshotID != "2" {
+		t.Errorf("status = %+v, want both snapshots with 2 as the latest", cr.Status.AtProvider)
+	}
+	if cr.Status.AtProvider.Snapshots[0].CreatedAt == nil {
+		t.Error("Snapshots[0].CreatedAt = nil, want the snapshot time")
+	}
+
+	id := "1"
+	cr.Spec.ForProvider.RestoreSnapshotID = &id
+	obs, err = e.Observe(context.Background(), cr)
+	if err != nil {
+		t.Fatalf("Observe() error = %v", err)
+	}
+	if !obs.ResourceUpToDate {
+		t.Error("Observe() ResourceUpToDate = false, want true until restoreGeneration is increased")
+	}
+
+	gen := int64(1)
+	cr.Spec.ForProvider.RestoreGeneration = &gen
+	obs, err = e.Observe(context.Background(), cr)
+	if err != nil {
+		t.Fatalf("Observe() error = %v", err)
+	}
+	if obs.ResourceUpToDate {
+		t.Error("Observe() ResourceUpToDate = true, want false while a restore is requested")
+	}
+}
+
+func TestExternalObserveReapplied(t *testing.T) {
+	e := &external{client: &MockDNSClient{snapshots: newTestSnapshots()}}
+	cr := newTestDNSZoneSnapshot()
+	id, gen := "1", int64(3)
+	cr.Spec.ForProvider.RestoreSnapshotID = &id
+	cr.Spec.ForProvider.RestoreGeneration = &gen
+
+	// A resource applied again with its restore fields set, such as after
+	// being deleted with an orphan policy, does not restore the zone again
+	obs, err := e.Observe(context.Background(), cr)
+	if err != nil {
+		t.Fatalf("Observe() error = %v", err)
+	}
+	if !obs.ResourceUpToDate {
+		t.Error("Observe() ResourceUpToDate = false, want true for the first restoreGeneration seen")
+	}
+	if got := cr.Status.AtProvider.RestoredGeneration; got == nil || *got != 3 {
+		t.Errorf("RestoredGeneration = %v, want the baseline 3", got)
+	}
+}
+
+func TestExternalObserveMissingZone(t *testing.T) {
+	e := &external{client: &MockDNSClient{}}
+
+	obs, err := e.Observe(context.Background(), newTestDNSZoneSnapshot())
+	if err != nil {
+		t.Fatalf("Observe() error = %v", err)
+	}
+	if obs.ResourceExists {
+		t.Error("Observe() ResourceExists = true, want false for a missing zone")
+	}
+}
+
+func TestExternalObserveDeleted(t *testing.T) {
+	e := &external{client: &MockDNSClient{snapshots: newTestSnapshots()}}
+	cr := newTestDNSZoneSnapshot()
+	now := metav1.Now()
+	cr.SetDeletionTimestamp(&now)
+
+	obs, err := e.Observe(context.Background(), cr)
+	if err != nil {
+		t.Fatalf("Observe() error = %v", err)
+	}
+	if obs.ResourceExists {
+		t.Error("Observe() ResourceExists = true, want false for a deleted DNSZoneSnapshot")
+	}
+}
+
+func TestExternalUpdateRestores(t *testing.T) {
+	mock := &MockDNSClient{snapshots: newTestSnapshots()}
+	e := &external{client: mock}
+	cr := newTestDNSZoneSnapshot()
+	id := "1"
+	cr.Spec.ForProvider.RestoreSnapshotID = &id
+	if _, err := e.Observe(context.Background(), cr); err != nil {
+		t.Fatalf("Observe() error = %v", err)
+	}
+	gen := int64(1)
+	cr.Spec.ForProvider.RestoreGeneration = &gen
+
+	if _, err := e.Update(context.Background(), cr); err != nil {
+		t.Fatalf("Update() error = %v", err)
+	}
+	if len(mock.restored) != 1 || mock.restored[0] != "1" {
+		t.Errorf("restored = %v, want snapshot 1", mock.restored)
+	}
+	if cr.Status.AtProvider.RestoredSnapshotID != "1" || cr.Status.AtProvider.RestoredAt == nil {
+		t.Errorf("status = %+v, want the restore recorded", cr.Status.AtProvider)
+	}
+
+	// The restore is not repeated once recorded
+	if _, err := e.Update(context.Background(), cr); err != nil {
+		t.Fatalf("Update() error = %v", err)
+	}
+	if len(mock.restored) != 1 {
+		t.Errorf("restored = %v, want a single restore", mock.restored)
+	}
+}
+
+func TestExternalUpdateUnknownSnapshot(t *testing.T) {
+	mock := &MockDNSClient{snapshots: newTestSnapshots()}
+	e := &external{client: mock}
+	cr := newTestDNSZoneSnapshot()
+	id := "99"
+	cr.Spec.ForProvider.RestoreSnapshotID = &id
+	if _, err := e.Observe(context.Background(), cr); err != nil {
+		t.Fatalf("Observe() error = %v", err)
+	}
+	gen := int64(1)
+	cr.Spec.ForProvider.RestoreGeneration = &gen
+
+	if _, err := e.Update(context.Background(), cr); err == nil {
+		t.Error("Update() error = nil, want an error for an unknown snapshot")
+	}
+	if len(mock.restored) != 0 {
+		t.Errorf("restored = %v, want none", mock.restored)
+	}
+}
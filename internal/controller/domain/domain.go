@@ -0,0 +1,310 @@
+/*
+Copyright 2025 Ross Golder.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package domain
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/pkg/errors"
+	"k8s.io/client-go/util/workqueue"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/event"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+
+	v1beta1 "github.com/rossigee/provider-hostinger/apis/domain/v1beta1"
+	providerv1beta1 "github.com/rossigee/provider-hostinger/apis/v1beta1"
+	"github.com/rossigee/provider-hostinger/internal/clients"
+	domainclient "github.com/rossigee/provider-hostinger/internal/clients/domain"
+)
+
+const (
+	errNotDomain = "managed resource is not a Domain custom resource"
+	errGetPC     = "cannot get ProviderConfig"
+	errNewClient = "cannot create new Hostinger client"
+
+	errGet          = "failed to get domain"
+	errAutoRenew    = "failed to get domain subscription"
+	errNotCreatable = "domains cannot be created through a Domain; the domain must already be in the Hostinger portfolio"
+	errNameservers  = "failed to set domain nameservers"
+	errLock         = "failed to set domain lock"
+	errPrivacy      = "failed to set domain privacy protection"
+	errNotLockable  = "the registry of this domain does not support the registrar lock"
+	errNotPrivate   = "the registry of this domain does not support privacy protection"
+
+	statusActive = "active"
+
+	// defaultExpiryWarningDays is used when expiryWarningDays is unset
+	defaultExpiryWarningDays = 30
+
+	reasonExpiring event.Reason = "DomainExpiring"
+)
+
+// expiryBuckets are the days left at which DomainExpiring warnings are
+// repeated within the warning window
+var expiryBuckets = []int32{1, 3, 7, 14}
+
+// Setup adds a controller that reconciles Domain managed resources.
+func Setup(mgr ctrl.Manager, l logging.Logger, wl workqueue.TypedRateLimiter[any]) error {
+	name := managed.ControllerName(v1beta1.DomainGroupKind)
+
+	o := controller.Options{
+		MaxConcurrentReconciles: 5,
+	}
+
+	recorder := event.NewAPIRecorder(mgr.GetEventRecorderFor(name))
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(v1beta1.DomainGroupVersionKind),
+		managed.WithExternalConnecter(&connector{
+			kube:        mgr.GetClient(),
+			newClientFn: clients.NewClientFactory,
+			recorder:    recorder,
+		}),
+		managed.WithLogger(l.WithValues("controller", name)),
+		managed.WithRecorder(recorder),
+		managed.WithPollInterval(10*time.Minute),
+		managed.WithInitializers(),
+		managed.WithManagementPolicies(),
+	)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o).
+		For(&v1beta1.Domain{}).
+		Complete(r)
+}
+
+// A connector is expected to produce typed ExternalClient for the managed
+// resource it is supposed to manage.
+type connector struct {
+	kube        client.Client
+	newClientFn func(client.Client, clients.HTTPClientConfig) *clients.ClientFactory
+	recorder    event.Recorder
+}
+
+// Connect produces an ExternalClient using the credentials of the
+// ProviderConfig referenced by the Domain.
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1beta1.Domain)
+	if !ok {
+		return nil, errors.New(errNotDomain)
+	}
+
+	pc := &providerv1beta1.ProviderConfig{}
+	if err := c.kube.Get(ctx, client.ObjectKey{Namespace: cr.GetNamespace(), Name: cr.Spec.ProviderConfigReference.Name}, pc); err != nil {
+		return nil, errors.Wrap(err, errGetPC)
+	}
+
+	clientFactory := c.newClientFn(c.kube, clients.DefaultHTTPClientConfig())
+
+	hc, err := clientFactory.CreateHostingerClient(ctx, pc)
+	if err != nil {
+		return nil, errors.Wrap(err, errNewClient)
+	}
+
+	return &external{client: domainclient.NewDomainClient(hc), recorder: c.recorder}, nil
+}
+
+// An ExternalClient observes, then either creates, updates, or deletes an
+// external resource to ensure it reflects the managed resource's desired state.
+type external struct {
+	client   domainclient.Client
+	recorder event.Recorder
+}
+
+// Observe reports the state of the domain, warns when it is about to
+// expire, and reports drift from the nameservers, lock and privacy protection
+// set in spec.
+func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1beta1.Domain)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotDomain)
+	}
+
+	// Delete leaves the domain registered, so there is nothing left to
+	// delete once the Domain is being deleted
+	if meta.WasDeleted(cr) {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	d, err := e.client.Get(ctx, cr.Spec.ForProvider.Domain)
+	if err != nil {
+		if clients.IsNotFound(err) {
+			return managed.ExternalObservation{ResourceExists: false}, nil
+		}
+		return managed.ExternalObservation{}, errors.Wrap(err, errGet)
+	}
+	autoRenew, err := e.client.AutoRenew(ctx, cr.Spec.ForProvider.Domain)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errAutoRenew)
+	}
+
+	warned := cr.Status.AtProvider.ExpiryWarningBucket
+	cr.Status.AtProvider = domainclient.GetObservation(d, autoRenew)
+
+	if d.Status == statusActive {
+		cr.SetConditions(xpv1.Available())
+	} else {
+		cr.SetConditions(xpv1.Unavailable().WithMessage("domain status is " + d.Status))
+	}
+	bucket, msg := expiryWarning(cr, time.Now())
+	cr.Status.AtProvider.ExpiryWarningBucket = bucket
+	if bucket != nil && (warned == nil || *warned != *bucket) {
+		e.recordEvent(cr, event.Warning(reasonExpiring, errors.New(msg)))
+	}
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: isUpToDate(cr.Spec.ForProvider, cr.Status.AtProvider),
+	}, nil
+}
+
+// Create always fails; a Domain observes a domain already in the portfolio.
+func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	return managed.ExternalCreation{}, errors.New(errNotCreatable)
+}
+
+// Update corrects drift from the nameservers, lock and privacy protection set
+// in spec; fields left unset are not managed.
+func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1beta1.Domain)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotDomain)
+	}
+
+	p := cr.Spec.ForProvider
+	obs := cr.Status.AtProvider
+
+	if len(p.Nameservers) > 0 && !nameserversMatch(p.Nameservers, obs.Nameservers) {
+		if err := e.client.SetNameservers(ctx, p.Domain, domainclient.NormalizeNameservers(p.Nameservers)); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errNameservers)
+		}
+	}
+	if p.Locked != nil && *p.Locked != obs.Locked {
+		if !obs.Lockable {
+			return managed.ExternalUpdate{}, errors.New(errNotLockable)
+		}
+		if err := e.client.SetLock(ctx, p.Domain, *p.Locked); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errLock)
+		}
+	}
+	if p.PrivacyProtection != nil && *p.PrivacyProtection != obs.PrivacyProtected {
+		if !obs.PrivacyProtectable {
+			return managed.ExternalUpdate{}, errors.New(errNotPrivate)
+		}
+		if err := e.client.SetPrivacyProtection(ctx, p.Domain, *p.PrivacyProtection); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errPrivacy)
+		}
+	}
+
+	return managed.ExternalUpdate{}, nil
+}
+
+// Delete is a no-op; deleting a Domain leaves the domain registered.
+func (e *external) Delete(ctx context.Context, mg resource.Managed) (managed.ExternalDelete, error) {
+	return managed.ExternalDelete{}, nil
+}
+
+// isUpToDate reports whether the domain matches the fields set in spec
+func isUpToDate(p v1beta1.DomainParameters, obs v1beta1.DomainObservation) bool {
+	if len(p.Nameservers) > 0 && !nameserversMatch(p.Nameservers, obs.Nameservers) {
+		return false
+	}
+	if p.Locked != nil && *p.Locked != obs.Locked {
+		return false
+	}
+	if p.PrivacyProtection != nil && *p.PrivacyProtection != obs.PrivacyProtected {
+		return false
+	}
+	return true
+}
+
+// nameserversMatch reports whether the desired nameservers are delegated to
+// in order
+func nameserversMatch(desired, observed []string) bool {
+	return slices.Equal(domainclient.NormalizeNameservers(desired), observed)
+}
+
+// expiryWarning returns the warning bucket and a warning if the domain
+// expires within the warning window, or nil and an empty string. Observe
+// only emits the warning when the bucket changes, so that it is not repeated
+// on every poll.
+func expiryWarning(cr *v1beta1.Domain, now time.Time) (*int32, string) {
+	expiresAt := cr.Status.AtProvider.ExpiresAt
+	if expiresAt == nil {
+		return nil, ""
+	}
+	window := int32(defaultExpiryWarningDays)
+	if cr.Spec.ForProvider.ExpiryWarningDays != nil {
+		window = *cr.Spec.ForProvider.ExpiryWarningDays
+	}
+	remaining := expiresAt.Sub(now)
+	if remaining > time.Duration(window)*24*time.Hour {
+		return nil, ""
+	}
+
+	renewal := "auto-renew is off"
+	if a := cr.Status.AtProvider.AutoRenew; a == nil {
+		renewal = "auto-renew is unknown"
+	} else if *a {
+		renewal = "auto-renew is on"
+	}
+	date := expiresAt.UTC().Format(time.DateOnly)
+	if remaining <= 0 {
+		bucket := int32(0)
+		return &bucket, fmt.Sprintf("domain %s expired on %s; %s", cr.Spec.ForProvider.Domain, date, renewal)
+	}
+	bucket := expiryBucket(remaining, window)
+	return &bucket, fmt.Sprintf("domain %s expires in %d days on %s; %s", cr.Spec.ForProvider.Domain, int(remaining.Hours()/24), date, renewal)
+}
+
+// expiryBucket rounds the days left up to the nearest of 1, 3, 7, 14 and
+// the warning window
+func expiryBucket(remaining time.Duration, window int32) int32 {
+	days := int32((remaining + 24*time.Hour - 1) / (24 * time.Hour))
+	for _, b := range expiryBuckets {
+		if b >= window {
+			break
+		}
+		if days <= b {
+			return b
+		}
+	}
+	return window
+}
+
+// recordEvent emits an event if a recorder is configured
+func (e *external) recordEvent(cr *v1beta1.Domain, ev event.Event) {
+	if e.recorder != nil {
+		e.recorder.Event(cr, ev)
+	}
+}
+
+// Disconnect closes the connection to the external service.
+func (e *external) Disconnect(ctx context.Context) error {
+	return nil
+}
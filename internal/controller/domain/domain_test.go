@@ -0,0 +1,328 @@
+/*
+Copyright 2025 Ross Golder.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package domain
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/event"
+
+	v1beta1 "github.com/rossigee/provider-hostinger/apis/domain/v1beta1"
+	"github.com/rossigee/provider-hostinger/internal/clients"
+	domainclient "github.com/rossigee/provider-hostinger/internal/clients/domain"
+)
+
+// MockDomainClient is a mock implementation of domainclient.Client
+type MockDomainClient struct {
+	domain    *domainclient.Domain
+	autoRenew *bool
+	calls     []string
+}
+
+func (m *MockDomainClient) Get(ctx context.Context, name string) (*domainclient.Domain, error) {
+	if m.domain == nil {
+		return nil, clients.ClassifyError(http.StatusNotFound, "not found")
+	}
+	return m.domain, nil
+}
+
+func (m *MockDomainClient) AutoRenew(ctx context.Context, name string) (*bool, error) {
+	return m.autoRenew, nil
+}
+
+func (m *MockDomainClient) SetNameservers(ctx context.Context, name string, nameservers []string) error {
+	m.calls = append(m.calls, fmt.Sprintf("nameservers %v", nameservers))
+	return nil
+}
+
+func (m *MockDomainClient) SetLock(ctx context.Context, name string, locked bool) error {
+	m.calls = append(m.calls, fmt.Sprintf("lock %t", locked))
+	return nil
+}
+
+func (m *MockDomainClient) SetPrivacyProtection(ctx context.Context, name string, enabled bool) error {
+	m.calls = append(m.calls, fmt.Sprintf("privacy %t", enabled))
+	return nil
+}
+
+// recordingRecorder records the events it is given
+type recordingRecorder struct {
+	events []event.Event
+}
+
+func (r *recordingRecorder) Event(obj runtime.Object, e event.Event) {
+	r.events = append(r.events, e)
+}
+
+func (r *recordingRecorder) WithAnnotations(keysAndValues ...string) event.Recorder {
+	return r
+}
+
+func newTestDomain() *v1beta1.Domain {
+	cr := &v1beta1.Domain{}
+	cr.SetNamespace("default")
+	cr.SetName("example-com")
+	cr.Spec.ForProvider.Domain = "example.com"
+	return cr
+}
+
+func newTestPortfolioDomain(status string, expiresIn time.Duration) *domainclient.Domain {
+	expiresAt := time.Now().Add(expiresIn).UTC().Format(time.RFC3339)
+	return &domainclient.Domain{
+		Domain:      "example.com",
+		Status:      status,
+		ExpiresAt:   &expiresAt,
+		Lockable:    true,
+		Locked:      true,
+		Nameservers: []string{"ns1.dns-parking.com", "ns2.dns-parking.com"},
+	}
+}
+
+func TestExternalObserve(t *testing.T) {
+	autoRenew := true
+	recorder := &recordingRecorder{}
+	e := &external{client: &MockDomainClient{domain: newTestPortfolioDomain("active", 200*24*time.Hour), autoRenew: &autoRenew}, recorder: recorder}
+	cr := newTestDomain()
+
+	obs, err := e.Observe(context.Background(), cr)
+	if err != nil {
+		t.Fatalf("Observe() error = %v", err)
+	}
+	if !obs.ResourceExists || !obs.ResourceUpToDate {
+		t.Errorf("Observe() = %+v, want existing and up to date", obs)
+	}
+	got := cr.Status.AtProvider
+	if got.Status != "active" || !got.Locked || got.AutoRenew == nil || !*got.AutoRenew || got.ExpiresAt == nil || len(got.Nameservers) != 2 {
+		t.Errorf("status = %+v, want the domain details", got)
+	}
+	if cr.GetCondition(xpv1.TypeReady).Reason != xpv1.ReasonAvailable {
+		t.Errorf("Ready condition = %+v, want available", cr.GetCondition(xpv1.TypeReady))
+	}
+	if len(recorder.events) != 0 {
+		t.Errorf("events = %+v, want none outside the warning window", recorder.events)
+	}
+}
+
+func TestExternalObserveExpiring(t *testing.T) {
+	recorder := &recordingRecorder{}
+	e := &external{client: &MockDomainClient{domain: newTestPortfolioDomain("active", 10*24*time.Hour+time.Hour)}, recorder: recorder}
+	cr := newTestDomain()
+
+	if _, err := e.Observe(context.Background(), cr); err != nil {
+		t.Fatalf("Observe() error = %v", err)
+	}
+	if len(recorder.events) != 1 || recorder.events[0].Type != event.TypeWarning || recorder.events[0].Reason != reasonExpiring {
+		t.Fatalf("events = %+v, want a DomainExpiring warning", recorder.events)
+	}
+	if msg := recorder.events[0].Message; !strings.Contains(msg, "expires in 10 days") || !strings.Contains(msg, "auto-renew is unknown") {
+		t.Errorf("message = %q, want the days left and the auto-renew state", msg)
+	}
+
+	// A shorter window does not warn yet
+	recorder.events = nil
+	days := int32(7)
+	cr.Spec.ForProvider.ExpiryWarningDays = &days
+	if _, err := e.Observe(context.Background(), cr); err != nil {
+		t.Fatalf("Observe() error = %v", err)
+	}
+	if len(recorder.events) != 0 {
+		t.Errorf("events = %+v, want none outside a 7 day window", recorder.events)
+	}
+}
+
+func TestExternalObserveExpiringRepeated(t *testing.T) {
+	recorder := &recordingRecorder{}
+	client := &MockDomainClient{domain: newTestPortfolioDomain("active", 10*24*time.Hour+time.Hour)}
+	e := &external{client: client, recorder: recorder}
+	cr := newTestDomain()
+
+	for range 2 {
+		if _, err := e.Observe(context.Background(), cr); err != nil {
+			t.Fatalf("Observe() error = %v", err)
+		}
+	}
+	if len(recorder.events) != 1 {
+		t.Fatalf("events = %+v, want one warning for repeated polls", recorder.events)
+	}
+	if b := cr.Status.AtProvider.ExpiryWarningBucket; b == nil || *b != 14 {
+		t.Errorf("expiryWarningBucket = %v, want 14", b)
+	}
+
+	// Fewer days left warns again
+	client.domain = newTestPortfolioDomain("active", 6*24*time.Hour)
+	if _, err := e.Observe(context.Background(), cr); err != nil {
+		t.Fatalf("Observe() error = %v", err)
+	}
+	if len(recorder.events) != 2 || !strings.Contains(recorder.events[1].Message, "expires in 5 days") {
+		t.Errorf("events = %+v, want a second warning", recorder.events)
+	}
+
+	// A renewal clears the bucket
+	client.domain = newTestPortfolioDomain("active", 365*24*time.Hour)
+	if _, err := e.Observe(context.Background(), cr); err != nil {
+		t.Fatalf("Observe() error = %v", err)
+	}
+	if len(recorder.events) != 2 || cr.Status.AtProvider.ExpiryWarningBucket != nil {
+		t.Errorf("events = %+v, bucket = %v, want no warning and no bucket after renewal", recorder.events, cr.Status.AtProvider.ExpiryWarningBucket)
+	}
+}
+
+func TestExpiryBucket(t *testing.T) {
+	day := 24 * time.Hour
+	cases := map[string]struct {
+		remaining time.Duration
+		window    int32
+		want      int32
+	}{
+		"Window":      {remaining: 20 * day, window: 30, want: 30},
+		"Fortnight":   {remaining: 14 * day, window: 30, want: 14},
+		"Week":        {remaining: 6*day + time.Hour, window: 30, want: 7},
+		"LastDay":     {remaining: time.Hour, window: 30, want: 1},
+		"ShortWindow": {remaining: 5 * day, window: 5, want: 5},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if got := expiryBucket(tc.remaining, tc.window); got != tc.want {
+				t.Errorf("expiryBucket() = %d, want %d", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestExternalObserveExpired(t *testing.T) {
+	recorder := &recordingRecorder{}
+	e := &external{client: &MockDomainClient{domain: newTestPortfolioDomain("expired", -24*time.Hour)}, recorder: recorder}
+	cr := newTestDomain()
+
+	if _, err := e.Observe(context.Background(), cr); err != nil {
+		t.Fatalf("Observe() error = %v", err)
+	}
+	if cr.GetCondition(xpv1.TypeReady).Reason != xpv1.ReasonUnavailable {
+		t.Errorf("Ready condition = %+v, want unavailable", cr.GetCondition(xpv1.TypeReady))
+	}
+	if len(recorder.events) != 1 || !strings.Contains(recorder.events[0].Message, "expired on") {
+		t.Errorf("events = %+v, want an expired warning", recorder.events)
+	}
+}
+
+func TestExternalObserveMissing(t *testing.T) {
+	e := &external{client: &MockDomainClient{}}
+
+	obs, err := e.Observe(context.Background(), newTestDomain())
+	if err != nil {
+		t.Fatalf("Observe() error = %v", err)
+	}
+	if obs.ResourceExists {
+		t.Error("Observe() ResourceExists = true, want false for a domain not in the portfolio")
+	}
+}
+
+func TestExternalObserveDeleted(t *testing.T) {
+	e := &external{client: &MockDomainClient{domain: newTestPortfolioDomain("active", 90*24*time.Hour)}}
+	cr := newTestDomain()
+	now := metav1.Now()
+	cr.SetDeletionTimestamp(&now)
+
+	obs, err := e.Observe(context.Background(), cr)
+	if err != nil {
+		t.Fatalf("Observe() error = %v", err)
+	}
+	if obs.ResourceExists {
+		t.Error("Observe() ResourceExists = true, want false for a deleted Domain")
+	}
+}
+
+func TestExternalCreate(t *testing.T) {
+	e := &external{client: &MockDomainClient{}}
+	if _, err := e.Create(context.Background(), newTestDomain()); err == nil {
+		t.Error("Create() error = nil, want domains not to be creatable")
+	}
+}
+
+func TestExternalObserveDrift(t *testing.T) {
+	e := &external{client: &MockDomainClient{domain: newTestPortfolioDomain("active", 200*24*time.Hour)}}
+	cr := newTestDomain()
+	cr.Spec.ForProvider.Nameservers = []string{"NS1.dns-parking.com.", "ns2.dns-parking.com"}
+	locked := true
+	cr.Spec.ForProvider.Locked = &locked
+
+	obs, err := e.Observe(context.Background(), cr)
+	if err != nil {
+		t.Fatalf("Observe() error = %v", err)
+	}
+	if !obs.ResourceUpToDate {
+		t.Error("Observe() ResourceUpToDate = false, want equivalent nameservers to match")
+	}
+
+	cr.Spec.ForProvider.Nameservers = []string{"ns2.dns-parking.com", "ns1.dns-parking.com"}
+	obs, err = e.Observe(context.Background(), cr)
+	if err != nil {
+		t.Fatalf("Observe() error = %v", err)
+	}
+	if obs.ResourceUpToDate {
+		t.Error("Observe() ResourceUpToDate = true, want reordered nameservers to be drift")
+	}
+}
+
+func TestExternalUpdate(t *testing.T) {
+	m := &MockDomainClient{}
+	e := &external{client: m}
+	cr := newTestDomain()
+	cr.Spec.ForProvider.Nameservers = []string{"NS1.example.net.", "ns2.example.net"}
+	locked, privacy := false, true
+	cr.Spec.ForProvider.Locked = &locked
+	cr.Spec.ForProvider.PrivacyProtection = &privacy
+	cr.Status.AtProvider = v1beta1.DomainObservation{
+		Locked:             true,
+		Lockable:           true,
+		PrivacyProtectable: true,
+		Nameservers:        []string{"ns1.dns-parking.com", "ns2.dns-parking.com"},
+	}
+
+	if _, err := e.Update(context.Background(), cr); err != nil {
+		t.Fatalf("Update() error = %v", err)
+	}
+	want := []string{"nameservers [ns1.example.net ns2.example.net]", "lock false", "privacy true"}
+	if !reflect.DeepEqual(m.calls, want) {
+		t.Errorf("calls = %v, want %v", m.calls, want)
+	}
+}
+
+func TestExternalUpdateUnsupported(t *testing.T) {
+	m := &MockDomainClient{}
+	e := &external{client: m}
+	cr := newTestDomain()
+	privacy := true
+	cr.Spec.ForProvider.PrivacyProtection = &privacy
+
+	if _, err := e.Update(context.Background(), cr); err == nil {
+		t.Error("Update() error = nil, want privacy protection to be unsupported")
+	}
+	if len(m.calls) != 0 {
+		t.Errorf("calls = %v, want none", m.calls)
+	}
+}
@@ -0,0 +1,226 @@
+/*
+Copyright 2025 Ross Golder.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package domainforwarding
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+	"k8s.io/client-go/util/workqueue"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/event"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+
+	v1beta1 "github.com/rossigee/provider-hostinger/apis/domain/v1beta1"
+	providerv1beta1 "github.com/rossigee/provider-hostinger/apis/v1beta1"
+	"github.com/rossigee/provider-hostinger/internal/clients"
+	domainclient "github.com/rossigee/provider-hostinger/internal/clients/domain"
+)
+
+const (
+	errNotDomainForwarding = "managed resource is not a DomainForwarding custom resource"
+	errGetPC               = "cannot get ProviderConfig"
+	errNewClient           = "cannot create new Hostinger client"
+
+	errGet    = "failed to get domain forwarding"
+	errCreate = "failed to create domain forwarding"
+	errDelete = "failed to delete domain forwarding"
+)
+
+// Setup adds a controller that reconciles DomainForwarding managed resources.
+func Setup(mgr ctrl.Manager, l logging.Logger, wl workqueue.TypedRateLimiter[any]) error {
+	name := managed.ControllerName(v1beta1.DomainForwardingGroupKind)
+
+	o := controller.Options{
+		MaxConcurrentReconciles: 5,
+	}
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(v1beta1.DomainForwardingGroupVersionKind),
+		managed.WithExternalConnecter(&connector{
+			kube:        mgr.GetClient(),
+			newClientFn: clients.NewClientFactory,
+		}),
+		managed.WithLogger(l.WithValues("controller", name)),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		managed.WithPollInterval(5*time.Minute),
+		managed.WithInitializers(),
+	)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o).
+		For(&v1beta1.DomainForwarding{}).
+		Complete(r)
+}
+
+// A connector is expected to produce typed ExternalClient for the managed
+// resource it is supposed to manage.
+type connector struct {
+	kube        client.Client
+	newClientFn func(client.Client, clients.HTTPClientConfig) *clients.ClientFactory
+}
+
+// Connect produces an ExternalClient using the credentials of the
+// ProviderConfig referenced by the DomainForwarding.
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1beta1.DomainForwarding)
+	if !ok {
+		return nil, errors.New(errNotDomainForwarding)
+	}
+
+	pc := &providerv1beta1.ProviderConfig{}
+	if err := c.kube.Get(ctx, client.ObjectKey{Namespace: cr.GetNamespace(), Name: cr.Spec.ProviderConfigReference.Name}, pc); err != nil {
+		return nil, errors.Wrap(err, errGetPC)
+	}
+
+	clientFactory := c.newClientFn(c.kube, clients.DefaultHTTPClientConfig())
+
+	hc, err := clientFactory.CreateHostingerClient(ctx, pc)
+	if err != nil {
+		return nil, errors.Wrap(err, errNewClient)
+	}
+
+	return &external{client: domainclient.NewDomainClient(hc)}, nil
+}
+
+// An ExternalClient observes, then either creates, updates, or deletes an
+// external resource to ensure it reflects the managed resource's desired state.
+type external struct {
+	client domainclient.ForwardingClient
+}
+
+// Observe reports the live forwarding of the domain.
+func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1beta1.DomainForwarding)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotDomainForwarding)
+	}
+
+	// The external name is the domain
+	externalName := meta.GetExternalName(cr)
+	if externalName == "" {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	f, err := e.client.GetForwarding(ctx, externalName)
+	if err != nil {
+		if clients.IsNotFound(err) {
+			return managed.ExternalObservation{ResourceExists: false}, nil
+		}
+		return managed.ExternalObservation{}, errors.Wrap(err, errGet)
+	}
+
+	cr.Status.AtProvider = domainclient.GetForwardingObservation(f)
+	cr.SetConditions(xpv1.Available())
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: isUpToDate(cr.Spec.ForProvider, cr.Status.AtProvider),
+	}, nil
+}
+
+// Create forwards the domain to the redirect URL.
+func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1beta1.DomainForwarding)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotDomainForwarding)
+	}
+
+	if err := e.create(ctx, cr); err != nil {
+		return managed.ExternalCreation{}, err
+	}
+
+	meta.SetExternalName(cr, cr.Spec.ForProvider.Domain)
+	return managed.ExternalCreation{}, nil
+}
+
+// Update replaces the forwarding. Hostinger has no endpoint to change a
+// forwarding, so it is deleted and created again.
+func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1beta1.DomainForwarding)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotDomainForwarding)
+	}
+
+	if err := domainclient.ValidateRedirectURL(cr.Spec.ForProvider.RedirectURL); err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+	if err := e.client.DeleteForwarding(ctx, meta.GetExternalName(cr)); err != nil && !clients.IsNotFound(err) {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errDelete)
+	}
+
+	return managed.ExternalUpdate{}, e.create(ctx, cr)
+}
+
+// Delete removes the forwarding from the domain.
+func (e *external) Delete(ctx context.Context, mg resource.Managed) (managed.ExternalDelete, error) {
+	cr, ok := mg.(*v1beta1.DomainForwarding)
+	if !ok {
+		return managed.ExternalDelete{}, errors.New(errNotDomainForwarding)
+	}
+
+	if err := e.client.DeleteForwarding(ctx, meta.GetExternalName(cr)); err != nil && !clients.IsNotFound(err) {
+		return managed.ExternalDelete{}, errors.Wrap(err, errDelete)
+	}
+	return managed.ExternalDelete{}, nil
+}
+
+// create forwards the domain as set in spec, after checking that the redirect
+// URL is absolute.
+func (e *external) create(ctx context.Context, cr *v1beta1.DomainForwarding) error {
+	p := cr.Spec.ForProvider
+	if err := domainclient.ValidateRedirectURL(p.RedirectURL); err != nil {
+		return err
+	}
+	f := &domainclient.Forwarding{
+		Domain:       p.Domain,
+		RedirectType: string(redirectType(p)),
+		RedirectURL:  p.RedirectURL,
+	}
+	if err := e.client.CreateForwarding(ctx, f); err != nil {
+		return errors.Wrap(err, errCreate)
+	}
+	return nil
+}
+
+// isUpToDate reports whether the live forwarding matches spec
+func isUpToDate(p v1beta1.DomainForwardingParameters, obs v1beta1.DomainForwardingObservation) bool {
+	return obs.RedirectType == redirectType(p) && obs.RedirectURL == p.RedirectURL
+}
+
+// redirectType returns the redirect type in spec, or a permanent redirect if
+// it is unset
+func redirectType(p v1beta1.DomainForwardingParameters) v1beta1.RedirectType {
+	if p.RedirectType == "" {
+		return v1beta1.RedirectTypePermanent
+	}
+	return p.RedirectType
+}
+
+// Disconnect closes the connection to the external service.
+func (e *external) Disconnect(ctx context.Context) error {
+	return nil
+}
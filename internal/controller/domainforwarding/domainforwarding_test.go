@@ -0,0 +1,159 @@
+/*
+Copyright 2025 Ross Golder.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package domainforwarding
+
+import (
+	"context"
+	"net/http"
+	"reflect"
+	"testing"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+
+	v1beta1 "github.com/rossigee/provider-hostinger/apis/domain/v1beta1"
+	"github.com/rossigee/provider-hostinger/internal/clients"
+	domainclient "github.com/rossigee/provider-hostinger/internal/clients/domain"
+)
+
+// MockForwardingClient is a mock implementation of domainclient.ForwardingClient
+type MockForwardingClient struct {
+	forwarding *domainclient.Forwarding
+	calls      []string
+}
+
+func (m *MockForwardingClient) GetForwarding(ctx context.Context, name string) (*domainclient.Forwarding, error) {
+	if m.forwarding == nil {
+		return nil, clients.ClassifyError(http.StatusNotFound, "not found")
+	}
+	return m.forwarding, nil
+}
+
+func (m *MockForwardingClient) CreateForwarding(ctx context.Context, f *domainclient.Forwarding) error {
+	m.calls = append(m.calls, "create "+f.RedirectType+" "+f.RedirectURL)
+	m.forwarding = f
+	return nil
+}
+
+func (m *MockForwardingClient) DeleteForwarding(ctx context.Context, name string) error {
+	m.calls = append(m.calls, "delete "+name)
+	if m.forwarding == nil {
+		return clients.ClassifyError(http.StatusNotFound, "not found")
+	}
+	m.forwarding = nil
+	return nil
+}
+
+func newTestDomainForwarding() *v1beta1.DomainForwarding {
+	cr := &v1beta1.DomainForwarding{}
+	cr.SetNamespace("default")
+	cr.SetName("example-com")
+	cr.Spec.ForProvider.Domain = "example.com"
+	cr.Spec.ForProvider.RedirectURL = "https://www.example.org/"
+	return cr
+}
+
+func TestExternalObserve(t *testing.T) {
+	e := &external{client: &MockForwardingClient{forwarding: &domainclient.Forwarding{Domain: "example.com", RedirectType: "301", RedirectURL: "https://www.example.org/"}}}
+	cr := newTestDomainForwarding()
+	meta.SetExternalName(cr, "example.com")
+
+	obs, err := e.Observe(context.Background(), cr)
+	if err != nil {
+		t.Fatalf("Observe() error = %v", err)
+	}
+	if !obs.ResourceExists || !obs.ResourceUpToDate {
+		t.Errorf("Observe() = %+v, want existing and up to date with the default redirect type", obs)
+	}
+
+	cr.Spec.ForProvider.RedirectType = v1beta1.RedirectTypeTemporary
+	obs, err = e.Observe(context.Background(), cr)
+	if err != nil {
+		t.Fatalf("Observe() error = %v", err)
+	}
+	if obs.ResourceUpToDate {
+		t.Error("Observe() ResourceUpToDate = true, want a changed redirect type to be out of date")
+	}
+}
+
+func TestExternalObserveMissing(t *testing.T) {
+	e := &external{client: &MockForwardingClient{}}
+	cr := newTestDomainForwarding()
+	meta.SetExternalName(cr, "example.com")
+
+	obs, err := e.Observe(context.Background(), cr)
+	if err != nil {
+		t.Fatalf("Observe() error = %v", err)
+	}
+	if obs.ResourceExists {
+		t.Error("Observe() ResourceExists = true, want false without a forwarding")
+	}
+}
+
+func TestExternalCreate(t *testing.T) {
+	m := &MockForwardingClient{}
+	e := &external{client: m}
+	cr := newTestDomainForwarding()
+
+	if _, err := e.Create(context.Background(), cr); err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+	if meta.GetExternalName(cr) != "example.com" {
+		t.Errorf("external name = %q, want the domain", meta.GetExternalName(cr))
+	}
+	if want := []string{"create 301 https://www.example.org/"}; !reflect.DeepEqual(m.calls, want) {
+		t.Errorf("calls = %v, want %v", m.calls, want)
+	}
+}
+
+func TestExternalCreateRelativeURL(t *testing.T) {
+	m := &MockForwardingClient{}
+	e := &external{client: m}
+	cr := newTestDomainForwarding()
+	cr.Spec.ForProvider.RedirectURL = "www.example.org"
+
+	if _, err := e.Create(context.Background(), cr); err == nil {
+		t.Error("Create() error = nil, want a relative URL to be rejected")
+	}
+	if len(m.calls) != 0 {
+		t.Errorf("calls = %v, want none", m.calls)
+	}
+}
+
+func TestExternalUpdate(t *testing.T) {
+	m := &MockForwardingClient{forwarding: &domainclient.Forwarding{Domain: "example.com", RedirectType: "301", RedirectURL: "https://old.example.org/"}}
+	e := &external{client: m}
+	cr := newTestDomainForwarding()
+	cr.Spec.ForProvider.RedirectType = v1beta1.RedirectTypeTemporary
+	meta.SetExternalName(cr, "example.com")
+
+	if _, err := e.Update(context.Background(), cr); err != nil {
+		t.Fatalf("Update() error = %v", err)
+	}
+	if want := []string{"delete example.com", "create 302 https://www.example.org/"}; !reflect.DeepEqual(m.calls, want) {
+		t.Errorf("calls = %v, want %v", m.calls, want)
+	}
+}
+
+func TestExternalDelete(t *testing.T) {
+	e := &external{client: &MockForwardingClient{}}
+	cr := newTestDomainForwarding()
+	meta.SetExternalName(cr, "example.com")
+
+	if _, err := e.Delete(context.Background(), cr); err != nil {
+		t.Errorf("Delete() error = %v, want an already removed forwarding to be ignored", err)
+	}
+}
@@ -0,0 +1,284 @@
+/*
+Copyright 2025 Ross Golder.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package domainregistration
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/pkg/errors"
+	"k8s.io/client-go/util/workqueue"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/event"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+
+	v1beta1 "github.com/rossigee/provider-hostinger/apis/domain/v1beta1"
+	providerv1beta1 "github.com/rossigee/provider-hostinger/apis/v1beta1"
+	"github.com/rossigee/provider-hostinger/internal/clients"
+	domainclient "github.com/rossigee/provider-hostinger/internal/clients/domain"
+)
+
+const (
+	errNotDomainRegistration = "managed resource is not a DomainRegistration custom resource"
+	errGetPC                 = "cannot get ProviderConfig"
+	errNewClient             = "cannot create new Hostinger client"
+
+	errGet               = "failed to get domain"
+	errCheckAvailability = "failed to check domain availability"
+	errGetPrice          = "failed to get domain price"
+	errGetSubscription   = "failed to get domain subscription"
+	errPurchase          = "failed to purchase domain"
+	errNotAvailable      = "domain is not available for registration"
+	errPurchaseDetails   = "purchase requires a WHOIS profile and paymentMethodId"
+	errWHOISProfileID    = "whoisProfileId is not a WHOIS profile ID"
+
+	statusActive = "active"
+
+	// defaultPeriod is the registration period in years when period is unset
+	defaultPeriod = 1
+)
+
+// Setup adds a controller that reconciles DomainRegistration managed
+// resources.
+func Setup(mgr ctrl.Manager, l logging.Logger, wl workqueue.TypedRateLimiter[any]) error {
+	name := managed.ControllerName(v1beta1.DomainRegistrationGroupKind)
+
+	o := controller.Options{
+		MaxConcurrentReconciles: 5,
+	}
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(v1beta1.DomainRegistrationGroupVersionKind),
+		managed.WithExternalConnecter(&connector{
+			kube:        mgr.GetClient(),
+			newClientFn: clients.NewClientFactory,
+		}),
+		managed.WithLogger(l.WithValues("controller", name)),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		managed.WithPollInterval(10*time.Minute),
+		managed.WithInitializers(),
+	)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o).
+		For(&v1beta1.DomainRegistration{}).
+		Complete(r)
+}
+
+// A connector is expected to produce typed ExternalClient for the managed
+// resource it is supposed to manage.
+type connector struct {
+	kube        client.Client
+	newClientFn func(client.Client, clients.HTTPClientConfig) *clients.ClientFactory
+}
+
+// Connect produces an ExternalClient using the credentials of the
+// ProviderConfig referenced by the DomainRegistration.
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1beta1.DomainRegistration)
+	if !ok {
+		return nil, errors.New(errNotDomainRegistration)
+	}
+
+	pc := &providerv1beta1.ProviderConfig{}
+	if err := c.kube.Get(ctx, client.ObjectKey{Namespace: cr.GetNamespace(), Name: cr.Spec.ProviderConfigReference.Name}, pc); err != nil {
+		return nil, errors.Wrap(err, errGetPC)
+	}
+
+	clientFactory := c.newClientFn(c.kube, clients.DefaultHTTPClientConfig())
+
+	hc, err := clientFactory.CreateHostingerClient(ctx, pc)
+	if err != nil {
+		return nil, errors.Wrap(err, errNewClient)
+	}
+
+	return &external{client: domainclient.NewDomainClient(hc)}, nil
+}
+
+// An ExternalClient observes, then either creates, updates, or deletes an
+// external resource to ensure it reflects the managed resource's desired state.
+type external struct {
+	client domainclient.RegistrationClient
+}
+
+// Observe reports the domain once it is in the portfolio or ordered, and
+// otherwise its availability and price. A registration that is not to be
+// purchased exists as a dry run; one that is to be purchased does not exist
+// until it is ordered, so that Create orders it.
+func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1beta1.DomainRegistration)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotDomainRegistration)
+	}
+
+	// Delete leaves the domain registered, so there is nothing left to
+	// delete once the DomainRegistration is being deleted
+	if meta.WasDeleted(cr) {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	p := cr.Spec.ForProvider
+	obs := &cr.Status.AtProvider
+	obs.OrderID = meta.GetExternalName(cr)
+
+	d, err := e.client.Get(ctx, p.Domain)
+	if err != nil && !clients.IsNotFound(err) {
+		return managed.ExternalObservation{}, errors.Wrap(err, errGet)
+	}
+	if err == nil {
+		obs.Available = nil
+		obs.Restriction = ""
+		obs.DomainStatus = d.Status
+		obs.ExpiresAt = domainclient.GetObservation(d, nil).ExpiresAt
+		if d.Status == statusActive {
+			cr.SetConditions(xpv1.Available())
+		} else {
+			cr.SetConditions(xpv1.Unavailable().WithMessage("domain status is " + d.Status))
+		}
+		return managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true}, nil
+	}
+	if obs.OrderID != "" {
+		// The order is placed but the domain is not in the portfolio yet
+		cr.SetConditions(xpv1.Creating().WithMessage("waiting for order " + obs.OrderID + " to register the domain"))
+		return managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true}, nil
+	}
+	if purchase(p) {
+		// A subscription for the domain means it was ordered, even if the
+		// order ID was not recorded
+		s, err := e.client.GetSubscription(ctx, p.Domain)
+		if err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errGetSubscription)
+		}
+		if s != nil {
+			cr.SetConditions(xpv1.Creating().WithMessage("waiting for subscription " + s.ID + " to register the domain"))
+			return managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true}, nil
+		}
+	}
+
+	a, err := e.client.CheckAvailability(ctx, p.Domain)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errCheckAvailability)
+	}
+	available := a.Available
+	obs.Available = &available
+	obs.Restriction = a.Restriction
+
+	if available {
+		price, err := e.client.GetPrice(ctx, p.Domain, period(p))
+		if err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errGetPrice)
+		}
+		obs.PriceItemID = price.ItemID
+		obs.Currency = price.Currency
+		obs.FirstPeriodPrice = price.FirstPeriodPrice
+		obs.RenewalPrice = price.RenewalPrice
+		cr.SetConditions(xpv1.Available())
+	} else {
+		obs.PriceItemID, obs.Currency, obs.FirstPeriodPrice, obs.RenewalPrice = "", "", 0, 0
+		cr.SetConditions(xpv1.Unavailable().WithMessage(unavailableMessage(a)))
+	}
+
+	return managed.ExternalObservation{
+		ResourceExists:   !purchase(p),
+		ResourceUpToDate: true,
+	}, nil
+}
+
+// Create orders the domain at the price found by Observe. The order ID is
+// the external name, so that the order is never placed twice.
+func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1beta1.DomainRegistration)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotDomainRegistration)
+	}
+
+	p := cr.Spec.ForProvider
+	obs := cr.Status.AtProvider
+	// A WHOISProfileRef or WHOISProfileSelector is resolved to the
+	// WHOISProfileID before Observe
+	if p.WHOISProfileID == nil || p.PaymentMethodID == nil {
+		return managed.ExternalCreation{}, errors.New(errPurchaseDetails)
+	}
+	if obs.Available == nil || !*obs.Available || obs.PriceItemID == "" {
+		return managed.ExternalCreation{}, errors.New(errNotAvailable)
+	}
+	whoisProfileID, err := strconv.ParseInt(*p.WHOISProfileID, 10, 64)
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errWHOISProfileID)
+	}
+	o, err := e.client.Purchase(ctx, &domainclient.Purchase{
+		Domain:          p.Domain,
+		ItemID:          obs.PriceItemID,
+		PaymentMethodID: p.PaymentMethodID,
+		WHOISProfileID:  whoisProfileID,
+	})
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errPurchase)
+	}
+	meta.SetExternalName(cr, o.ID)
+
+	return managed.ExternalCreation{}, nil
+}
+
+// Update does nothing: a registration is never out of date.
+func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	if _, ok := mg.(*v1beta1.DomainRegistration); !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotDomainRegistration)
+	}
+	return managed.ExternalUpdate{}, nil
+}
+
+// Delete is a no-op; deleting a DomainRegistration leaves the domain
+// registered.
+func (e *external) Delete(ctx context.Context, mg resource.Managed) (managed.ExternalDelete, error) {
+	return managed.ExternalDelete{}, nil
+}
+
+// purchase reports whether the domain is to be ordered
+func purchase(p v1beta1.DomainRegistrationParameters) bool {
+	return p.Purchase != nil && *p.Purchase
+}
+
+// period returns the registration period in years
+func period(p v1beta1.DomainRegistrationParameters) int32 {
+	if p.Period == nil {
+		return defaultPeriod
+	}
+	return *p.Period
+}
+
+// unavailableMessage explains why a domain cannot be registered
+func unavailableMessage(a *domainclient.Availability) string {
+	if a.Restriction != "" {
+		return errNotAvailable + ": " + a.Restriction
+	}
+	return errNotAvailable
+}
+
+// Disconnect closes the connection to the external service.
+func (e *external) Disconnect(ctx context.Context) error {
+	return nil
+}
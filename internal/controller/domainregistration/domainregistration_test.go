@@ -0,0 +1,240 @@
+/*
+Copyright 2025 Ross Golder.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package domainregistration
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+
+	v1beta1 "github.com/rossigee/provider-hostinger/apis/domain/v1beta1"
+	"github.com/rossigee/provider-hostinger/internal/clients"
+	"github.com/rossigee/provider-hostinger/internal/clients/billing"
+	domainclient "github.com/rossigee/provider-hostinger/internal/clients/domain"
+)
+
+// MockRegistrationClient is a mock implementation of domainclient.RegistrationClient
+type MockRegistrationClient struct {
+	domain       *domainclient.Domain
+	subscription *billing.Subscription
+	available    bool
+	purchases    []*domainclient.Purchase
+}
+
+func (m *MockRegistrationClient) Get(ctx context.Context, name string) (*domainclient.Domain, error) {
+	if m.domain == nil {
+		return nil, clients.ClassifyError(http.StatusNotFound, "not found")
+	}
+	return m.domain, nil
+}
+
+func (m *MockRegistrationClient) GetSubscription(ctx context.Context, name string) (*billing.Subscription, error) {
+	return m.subscription, nil
+}
+
+func (m *MockRegistrationClient) CheckAvailability(ctx context.Context, name string) (*domainclient.Availability, error) {
+	a := &domainclient.Availability{Domain: name, Available: m.available}
+	if !m.available {
+		a.Restriction = "Domain is taken"
+	}
+	return a, nil
+}
+
+func (m *MockRegistrationClient) GetPrice(ctx context.Context, name string, years int32) (*domainclient.Price, error) {
+	return &domainclient.Price{ItemID: "hostingercom-domain-com-usd-1y", Currency: "USD", FirstPeriodPrice: 899, RenewalPrice: 1599}, nil
+}
+
+func (m *MockRegistrationClient) Purchase(ctx context.Context, p *domainclient.Purchase) (*domainclient.Order, error) {
+	m.purchases = append(m.purchases, p)
+	return &domainclient.Order{ID: "2957086", Status: "completed"}, nil
+}
+
+func newTestDomainRegistration(purchase bool) *v1beta1.DomainRegistration {
+	cr := &v1beta1.DomainRegistration{}
+	cr.SetNamespace("default")
+	cr.SetName("campaign-com")
+	cr.Spec.ForProvider.Domain = "campaign.com"
+	cr.Spec.ForProvider.Purchase = &purchase
+	whoisProfileID, paymentMethodID := "42", int64(77)
+	cr.Spec.ForProvider.WHOISProfileID = &whoisProfileID
+	cr.Spec.ForProvider.PaymentMethodID = &paymentMethodID
+	return cr
+}
+
+func TestExternalObserveDryRun(t *testing.T) {
+	e := &external{client: &MockRegistrationClient{available: true}}
+	cr := newTestDomainRegistration(false)
+
+	obs, err := e.Observe(context.Background(), cr)
+	if err != nil {
+		t.Fatalf("Observe() error = %v", err)
+	}
+	if !obs.ResourceExists || !obs.ResourceUpToDate {
+		t.Errorf("Observe() = %+v, want a dry run to be up to date", obs)
+	}
+	got := cr.Status.AtProvider
+	if got.Available == nil || !*got.Available || got.PriceItemID != "hostingercom-domain-com-usd-1y" || got.FirstPeriodPrice != 899 || got.Currency != "USD" {
+		t.Errorf("status = %+v, want the availability and price", got)
+	}
+}
+
+func TestExternalObserveUnavailable(t *testing.T) {
+	e := &external{client: &MockRegistrationClient{}}
+	cr := newTestDomainRegistration(true)
+
+	if _, err := e.Observe(context.Background(), cr); err != nil {
+		t.Fatalf("Observe() error = %v", err)
+	}
+	if cr.GetCondition(xpv1.TypeReady).Reason != xpv1.ReasonUnavailable {
+		t.Errorf("Ready condition = %+v, want unavailable", cr.GetCondition(xpv1.TypeReady))
+	}
+	if _, err := e.Create(context.Background(), cr); err == nil {
+		t.Error("Create() error = nil, want an unavailable domain not to be purchased")
+	}
+}
+
+func TestExternalPurchase(t *testing.T) {
+	m := &MockRegistrationClient{available: true}
+	e := &external{client: m}
+	cr := newTestDomainRegistration(true)
+
+	obs, err := e.Observe(context.Background(), cr)
+	if err != nil {
+		t.Fatalf("Observe() error = %v", err)
+	}
+	if obs.ResourceExists {
+		t.Fatal("Observe() ResourceExists = true, want a purchase to be pending")
+	}
+	if _, err := e.Create(context.Background(), cr); err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+	if len(m.purchases) != 1 || m.purchases[0].ItemID != "hostingercom-domain-com-usd-1y" || m.purchases[0].WHOISProfileID != 42 {
+		t.Errorf("purchases = %+v, want one order at the observed price", m.purchases)
+	}
+	if got := meta.GetExternalName(cr); got != "2957086" {
+		t.Errorf("external name = %q, want the order ID", got)
+	}
+
+	// The order is placed but the domain is not registered yet. Status set
+	// by Create is not persisted, so the order ID comes from the external
+	// name.
+	cr.Status.AtProvider = v1beta1.DomainRegistrationObservation{}
+	obs, err = e.Observe(context.Background(), cr)
+	if err != nil {
+		t.Fatalf("Observe() error = %v", err)
+	}
+	if !obs.ResourceExists || !obs.ResourceUpToDate {
+		t.Errorf("Observe() = %+v, want a placed order not to be repeated", obs)
+	}
+	if cr.Status.AtProvider.OrderID != "2957086" {
+		t.Errorf("orderId = %q, want the order", cr.Status.AtProvider.OrderID)
+	}
+
+	m.domain = &domainclient.Domain{Domain: "campaign.com", Status: "active"}
+	if _, err := e.Observe(context.Background(), cr); err != nil {
+		t.Fatalf("Observe() error = %v", err)
+	}
+	if cr.Status.AtProvider.DomainStatus != "active" || cr.GetCondition(xpv1.TypeReady).Reason != xpv1.ReasonAvailable {
+		t.Errorf("status = %+v, want the registered domain", cr.Status.AtProvider)
+	}
+}
+
+func TestExternalObserveSubscribed(t *testing.T) {
+	m := &MockRegistrationClient{available: true, subscription: &billing.Subscription{ID: "Azz3nsRv", Name: "campaign.com"}}
+	e := &external{client: m}
+	cr := newTestDomainRegistration(true)
+
+	obs, err := e.Observe(context.Background(), cr)
+	if err != nil {
+		t.Fatalf("Observe() error = %v", err)
+	}
+	if !obs.ResourceExists || !obs.ResourceUpToDate {
+		t.Errorf("Observe() = %+v, want a domain with a subscription not to be ordered again", obs)
+	}
+	if cr.GetCondition(xpv1.TypeReady).Reason != xpv1.ReasonCreating {
+		t.Errorf("Ready condition = %+v, want creating", cr.GetCondition(xpv1.TypeReady))
+	}
+}
+
+func TestExternalObserveDeleted(t *testing.T) {
+	e := &external{client: &MockRegistrationClient{domain: &domainclient.Domain{Domain: "campaign.com", Status: "active"}}}
+	cr := newTestDomainRegistration(true)
+	meta.SetExternalName(cr, "2957086")
+	now := metav1.Now()
+	cr.SetDeletionTimestamp(&now)
+
+	obs, err := e.Observe(context.Background(), cr)
+	if err != nil {
+		t.Fatalf("Observe() error = %v", err)
+	}
+	if obs.ResourceExists {
+		t.Error("Observe() ResourceExists = true, want false for a deleted DomainRegistration")
+	}
+}
+
+func TestExternalCreateMissingDetails(t *testing.T) {
+	m := &MockRegistrationClient{available: true}
+	e := &external{client: m}
+	cr := newTestDomainRegistration(true)
+	cr.Spec.ForProvider.PaymentMethodID = nil
+
+	if _, err := e.Observe(context.Background(), cr); err != nil {
+		t.Fatalf("Observe() error = %v", err)
+	}
+	if _, err := e.Create(context.Background(), cr); err == nil {
+		t.Error("Create() error = nil, want a purchase without a payment method to fail")
+	}
+	if len(m.purchases) != 0 {
+		t.Errorf("purchases = %+v, want none", m.purchases)
+	}
+}
+
+func TestExternalPurchaseWHOISProfileRef(t *testing.T) {
+	profile := &v1beta1.WHOISProfile{}
+	profile.SetNamespace("default")
+	profile.SetName("marketing")
+	meta.SetExternalName(profile, "4242")
+
+	s := runtime.NewScheme()
+	_ = v1beta1.SchemeBuilder.AddToScheme(s)
+	m := &MockRegistrationClient{available: true}
+	e := &external{client: m}
+	cr := newTestDomainRegistration(true)
+	cr.Spec.ForProvider.WHOISProfileID = nil
+	cr.Spec.ForProvider.WHOISProfileRef = &xpv1.Reference{Name: "marketing"}
+
+	// The reconciler resolves references before Observe
+	if err := cr.ResolveReferences(context.Background(), fake.NewClientBuilder().WithScheme(s).WithObjects(profile).Build()); err != nil {
+		t.Fatalf("ResolveReferences() error = %v", err)
+	}
+	if _, err := e.Observe(context.Background(), cr); err != nil {
+		t.Fatalf("Observe() error = %v", err)
+	}
+	if _, err := e.Create(context.Background(), cr); err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+	if len(m.purchases) != 1 || m.purchases[0].WHOISProfileID != 4242 {
+		t.Errorf("purchases = %+v, want the referenced WHOIS profile", m.purchases)
+	}
+}
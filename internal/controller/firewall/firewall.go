@@ -0,0 +1,369 @@
+/*
+Copyright 2025 Ross Golder.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package firewall
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/util/workqueue"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/event"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+
+	v1beta1 "github.com/rossigee/provider-hostinger/apis/firewall/v1beta1"
+	instancev1beta1 "github.com/rossigee/provider-hostinger/apis/instance/v1beta1"
+	providerv1beta1 "github.com/rossigee/provider-hostinger/apis/v1beta1"
+	"github.com/rossigee/provider-hostinger/internal/clients"
+	firewallclient "github.com/rossigee/provider-hostinger/internal/clients/firewall"
+)
+
+const (
+	errNotFirewall = "managed resource is not a Firewall custom resource"
+	errGetPC       = "cannot get ProviderConfig"
+	errNewClient   = "cannot create new Hostinger client"
+
+	errGet             = "failed to get firewall"
+	errActiveInstances = "failed to list instances the firewall is active on"
+	errCreate          = "failed to create firewall"
+	errCreateRule      = "failed to create firewall rule"
+	errUpdateRules     = "failed to update firewall rules"
+	errSync            = "failed to sync firewall to instance %s"
+	errDeactivate      = "failed to deactivate firewall on instance %s"
+	errActivate        = "failed to activate firewall on instances %s"
+	errDelete          = "failed to delete firewall"
+)
+
+// Setup adds a controller that reconciles Firewall managed resources.
+func Setup(mgr ctrl.Manager, l logging.Logger, wl workqueue.TypedRateLimiter[any]) error {
+	name := managed.ControllerName(v1beta1.FirewallGroupKind)
+
+	o := controller.Options{
+		MaxConcurrentReconciles: 5,
+	}
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(v1beta1.FirewallGroupVersionKind),
+		managed.WithExternalConnecter(&connector{
+			kube:        mgr.GetClient(),
+			newClientFn: clients.NewClientFactory,
+		}),
+		managed.WithLogger(l.WithValues("controller", name)),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		managed.WithPollInterval(5*time.Minute),
+		managed.WithInitializers(),
+	)
+
+	// Rules taking their sources from ConfigMaps or Instances are applied
+	// as soon as those change, without waiting for the next poll
+	sourceUsers := firewallclient.SourceUsers(mgr.GetClient(), func() client.ObjectList { return &v1beta1.FirewallList{} })
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o).
+		For(&v1beta1.Firewall{}).
+		Watches(&corev1.ConfigMap{}, handler.EnqueueRequestsFromMapFunc(sourceUsers)).
+		Watches(&instancev1beta1.Instance{}, handler.EnqueueRequestsFromMapFunc(sourceUsers)).
+		Complete(r)
+}
+
+// A connector is expected to produce typed ExternalClient for the managed
+// resource it is supposed to manage.
+type connector struct {
+	kube        client.Client
+	newClientFn func(client.Client, clients.HTTPClientConfig) *clients.ClientFactory
+}
+
+// Connect produces an ExternalClient using the credentials of the
+// ProviderConfig referenced by the Firewall.
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1beta1.Firewall)
+	if !ok {
+		return nil, errors.New(errNotFirewall)
+	}
+
+	pc := &providerv1beta1.ProviderConfig{}
+	if err := c.kube.Get(ctx, client.ObjectKey{Namespace: cr.GetNamespace(), Name: cr.Spec.ProviderConfigReference.Name}, pc); err != nil {
+		return nil, errors.Wrap(err, errGetPC)
+	}
+
+	clientFactory := c.newClientFn(c.kube, clients.DefaultHTTPClientConfig())
+
+	hc, err := clientFactory.CreateHostingerClient(ctx, pc)
+	if err != nil {
+		return nil, errors.Wrap(err, errNewClient)
+	}
+
+	return &external{kube: c.kube, client: firewallclient.NewFirewallClient(hc)}, nil
+}
+
+// An ExternalClient observes, then either creates, updates, or deletes an
+// external resource to ensure it reflects the managed resource's desired state.
+type external struct {
+	kube   client.Client
+	client firewallclient.Client
+}
+
+func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1beta1.Firewall)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotFirewall)
+	}
+
+	// The external name is the ID of the Hostinger firewall
+	externalName := meta.GetExternalName(cr)
+	if externalName == "" {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	fw, err := e.client.Get(ctx, externalName)
+	if err != nil {
+		if clients.IsNotFound(err) {
+			return managed.ExternalObservation{ResourceExists: false}, nil
+		}
+		return managed.ExternalObservation{}, errors.Wrap(err, errGet)
+	}
+
+	// The rules are not needed to delete the firewall, and the objects their
+	// sources are taken from may already be gone
+	if meta.WasDeleted(cr) {
+		return managed.ExternalObservation{ResourceExists: true}, nil
+	}
+
+	desired, _, err := firewallclient.DesiredRules(ctx, e.kube, cr.GetNamespace(), cr.Spec.ForProvider.Rules)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+
+	active, err := e.client.ActiveInstances(ctx, fw.ID)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errActiveInstances)
+	}
+
+	ruleObs := firewallclient.GetObservation(fw)
+	instances := attachments(cr.Spec.ForProvider.InstanceIDs, active, fw.Synced, cr.Status.AtProvider.Instances)
+	cr.Status.AtProvider = v1beta1.FirewallObservation{
+		ID:        ruleObs.ID,
+		Status:    ruleObs.Status,
+		RuleCount: ruleObs.RuleCount,
+		Instances: instances,
+		UpdatedAt: ruleObs.AppliedDate,
+	}
+
+	if failed := failedInstances(instances); len(failed) > 0 {
+		cr.SetConditions(xpv1.Unavailable().WithMessage("activation failed on instances " + strings.Join(failed, ", ")))
+	} else {
+		cr.SetConditions(xpv1.Available())
+	}
+
+	missing, extra := firewallclient.Diff(desired, fw.Rules)
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: len(missing) == 0 && len(extra) == 0 && fw.Synced && sameSet(cr.Spec.ForProvider.InstanceIDs, active),
+	}, nil
+}
+
+// Create creates the firewall and its rules; Update activates it on the
+// instances.
+func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1beta1.Firewall)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotFirewall)
+	}
+
+	// Validate every rule before anything is created
+	desired, _, err := firewallclient.DesiredRules(ctx, e.kube, cr.GetNamespace(), cr.Spec.ForProvider.Rules)
+	if err != nil {
+		return managed.ExternalCreation{}, err
+	}
+
+	name := cr.GetName()
+	if cr.Spec.ForProvider.Name != nil {
+		name = *cr.Spec.ForProvider.Name
+	}
+	fw, err := e.client.Create(ctx, name)
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreate)
+	}
+	meta.SetExternalName(cr, fw.ID)
+
+	for _, r := range desired {
+		if err := e.client.CreateRule(ctx, fw.ID, r); err != nil {
+			return managed.ExternalCreation{}, errors.Wrap(err, errCreateRule)
+		}
+	}
+
+	return managed.ExternalCreation{}, nil
+}
+
+// Update reconciles the rules, deactivates the firewall on instances that
+// are no longer listed, syncs it to those it is active on and activates it
+// on the rest. Activation failures are recorded per instance and do not stop
+// the other instances from being processed.
+func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1beta1.Firewall)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotFirewall)
+	}
+
+	desired, _, err := firewallclient.DesiredRules(ctx, e.kube, cr.GetNamespace(), cr.Spec.ForProvider.Rules)
+	if err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+
+	fw, err := e.client.Get(ctx, meta.GetExternalName(cr))
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errGet)
+	}
+	changed, err := firewallclient.ReconcileRules(ctx, e.client, fw, desired)
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateRules)
+	}
+	active, err := e.client.ActiveInstances(ctx, fw.ID)
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errActiveInstances)
+	}
+
+	wanted := cr.Spec.ForProvider.InstanceIDs
+	for _, id := range active {
+		if !contains(wanted, id) {
+			if err := e.client.Deactivate(ctx, fw.ID, id); err != nil && !clients.IsNotFound(err) {
+				return managed.ExternalUpdate{}, errors.Wrapf(err, errDeactivate, id)
+			}
+		}
+	}
+
+	instances := make([]v1beta1.FirewallAttachmentStatus, 0, len(wanted))
+	failed := []string{}
+	for _, id := range wanted {
+		if contains(active, id) {
+			if changed || !fw.Synced {
+				if err := e.client.Sync(ctx, fw.ID, id); err != nil {
+					return managed.ExternalUpdate{}, errors.Wrapf(err, errSync, id)
+				}
+			}
+			instances = append(instances, v1beta1.FirewallAttachmentStatus{InstanceID: id, State: v1beta1.FirewallAttachmentPending})
+			continue
+		}
+		if err := e.client.Activate(ctx, fw.ID, id); err != nil {
+			failed = append(failed, id)
+			instances = append(instances, v1beta1.FirewallAttachmentStatus{InstanceID: id, State: v1beta1.FirewallAttachmentFailed, Message: err.Error()})
+			continue
+		}
+		instances = append(instances, v1beta1.FirewallAttachmentStatus{InstanceID: id, State: v1beta1.FirewallAttachmentPending})
+	}
+	cr.Status.AtProvider.Instances = instances
+
+	if len(failed) > 0 {
+		return managed.ExternalUpdate{}, errors.Errorf(errActivate, strings.Join(failed, ", "))
+	}
+	return managed.ExternalUpdate{}, nil
+}
+
+func (e *external) Delete(ctx context.Context, mg resource.Managed) (managed.ExternalDelete, error) {
+	cr, ok := mg.(*v1beta1.Firewall)
+	if !ok {
+		return managed.ExternalDelete{}, errors.New(errNotFirewall)
+	}
+
+	firewallID := meta.GetExternalName(cr)
+	active, err := e.client.ActiveInstances(ctx, firewallID)
+	if err != nil {
+		return managed.ExternalDelete{}, errors.Wrap(err, errActiveInstances)
+	}
+	for _, id := range active {
+		if err := e.client.Deactivate(ctx, firewallID, id); err != nil && !clients.IsNotFound(err) {
+			return managed.ExternalDelete{}, errors.Wrapf(err, errDeactivate, id)
+		}
+	}
+	if err := e.client.Delete(ctx, firewallID); err != nil && !clients.IsNotFound(err) {
+		return managed.ExternalDelete{}, errors.Wrap(err, errDelete)
+	}
+
+	return managed.ExternalDelete{}, nil
+}
+
+// Disconnect closes the connection to the external service.
+func (e *external) Disconnect(ctx context.Context) error {
+	return nil
+}
+
+// attachments reports the state of the firewall on each wanted instance. A
+// failed activation stays reported until the firewall becomes active.
+func attachments(wanted, active []string, synced bool, previous []v1beta1.FirewallAttachmentStatus) []v1beta1.FirewallAttachmentStatus {
+	instances := make([]v1beta1.FirewallAttachmentStatus, 0, len(wanted))
+	for _, id := range wanted {
+		status := v1beta1.FirewallAttachmentStatus{InstanceID: id, State: v1beta1.FirewallAttachmentInactive}
+		switch {
+		case contains(active, id) && synced:
+			status.State = v1beta1.FirewallAttachmentSynced
+		case contains(active, id):
+			status.State = v1beta1.FirewallAttachmentPending
+		default:
+			for _, p := range previous {
+				if p.InstanceID == id && p.State == v1beta1.FirewallAttachmentFailed {
+					status = p
+				}
+			}
+		}
+		instances = append(instances, status)
+	}
+	return instances
+}
+
+// failedInstances returns the IDs of instances where activation failed.
+func failedInstances(instances []v1beta1.FirewallAttachmentStatus) []string {
+	failed := []string{}
+	for _, i := range instances {
+		if i.State == v1beta1.FirewallAttachmentFailed {
+			failed = append(failed, i.InstanceID)
+		}
+	}
+	return failed
+}
+
+// sameSet reports whether a and b hold the same IDs, ignoring order.
+func sameSet(a, b []string) bool {
+	x := append([]string{}, a...)
+	y := append([]string{}, b...)
+	sort.Strings(x)
+	sort.Strings(y)
+	return strings.Join(x, ",") == strings.Join(y, ",")
+}
+
+// contains reports whether id is in ids.
+func contains(ids []string, id string) bool {
+	for _, i := range ids {
+		if i == id {
+			return true
+		}
+	}
+	return false
+}
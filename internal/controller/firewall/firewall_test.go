@@ -0,0 +1,209 @@
+/*
+Copyright 2025 Ross Golder.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package firewall
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+
+	v1beta1 "github.com/rossigee/provider-hostinger/apis/firewall/v1beta1"
+	firewallclient "github.com/rossigee/provider-hostinger/internal/clients/firewall"
+)
+
+// MockFirewallClient is a mock implementation of firewallclient.Client
+type MockFirewallClient struct {
+	firewall     *firewallclient.Firewall
+	active       []string
+	failActivate map[string]bool
+	activated    []string
+	deactivated  []string
+	synced       []string
+	createdRules []firewallclient.Rule
+}
+
+func (m *MockFirewallClient) Get(ctx context.Context, firewallID string) (*firewallclient.Firewall, error) {
+	return m.firewall, nil
+}
+
+func (m *MockFirewallClient) Create(ctx context.Context, name string) (*firewallclient.Firewall, error) {
+	return &firewallclient.Firewall{ID: "42", Name: name}, nil
+}
+
+func (m *MockFirewallClient) Delete(ctx context.Context, firewallID string) error {
+	return nil
+}
+
+func (m *MockFirewallClient) CreateRule(ctx context.Context, firewallID string, r firewallclient.Rule) error {
+	m.createdRules = append(m.createdRules, r)
+	return nil
+}
+
+func (m *MockFirewallClient) DeleteRule(ctx context.Context, firewallID, ruleID string) error {
+	return nil
+}
+
+func (m *MockFirewallClient) Activate(ctx context.Context, firewallID, instanceID string) error {
+	if m.failActivate[instanceID] {
+		return errors.New("instance is not running")
+	}
+	m.activated = append(m.activated, instanceID)
+	return nil
+}
+
+func (m *MockFirewallClient) Deactivate(ctx context.Context, firewallID, instanceID string) error {
+	m.deactivated = append(m.deactivated, instanceID)
+	return nil
+}
+
+func (m *MockFirewallClient) Sync(ctx context.Context, firewallID, instanceID string) error {
+	m.synced = append(m.synced, instanceID)
+	return nil
+}
+
+func (m *MockFirewallClient) ActiveInstances(ctx context.Context, firewallID string) ([]string, error) {
+	return m.active, nil
+}
+
+func newTestFirewall(instanceIDs ...string) *v1beta1.Firewall {
+	cr := &v1beta1.Firewall{}
+	cr.SetName("web")
+	cr.Spec.ForProvider.InstanceIDs = instanceIDs
+	meta.SetExternalName(cr, "42")
+	return cr
+}
+
+func TestExternalObserve_Attachments(t *testing.T) {
+	mock := &MockFirewallClient{
+		firewall: &firewallclient.Firewall{ID: "42", Synced: true},
+		active:   []string{"1"},
+	}
+	cr := newTestFirewall("1", "2", "3")
+	cr.Status.AtProvider.Instances = []v1beta1.FirewallAttachmentStatus{
+		{InstanceID: "3", State: v1beta1.FirewallAttachmentFailed, Message: "instance is not running"},
+	}
+
+	obs, err := (&external{client: mock}).Observe(context.Background(), cr)
+	if err != nil {
+		t.Fatalf("Observe() error = %v", err)
+	}
+	if !obs.ResourceExists || obs.ResourceUpToDate {
+		t.Errorf("Observe() = %+v, want existing but not up to date", obs)
+	}
+
+	want := []string{v1beta1.FirewallAttachmentSynced, v1beta1.FirewallAttachmentInactive, v1beta1.FirewallAttachmentFailed}
+	instances := cr.Status.AtProvider.Instances
+	if len(instances) != len(want) {
+		t.Fatalf("Instances = %+v, want %d entries", instances, len(want))
+	}
+	for i, state := range want {
+		if instances[i].State != state {
+			t.Errorf("Instances[%d].State = %q, want %q", i, instances[i].State, state)
+		}
+	}
+	if instances[2].Message == "" {
+		t.Error("failed activation message should be kept")
+	}
+	if cr.GetCondition(xpv1.TypeReady).Reason != xpv1.ReasonUnavailable {
+		t.Error("firewall should be Unavailable while activation has failed")
+	}
+}
+
+func TestExternalObserve_UpToDate(t *testing.T) {
+	mock := &MockFirewallClient{
+		firewall: &firewallclient.Firewall{ID: "42", Synced: true},
+		active:   []string{"2", "1"},
+	}
+
+	obs, err := (&external{client: mock}).Observe(context.Background(), newTestFirewall("1", "2"))
+	if err != nil {
+		t.Fatalf("Observe() error = %v", err)
+	}
+	if !obs.ResourceUpToDate {
+		t.Error("Observe() should be up to date when active on exactly the listed instances")
+	}
+}
+
+func TestExternalObserve_Deleted(t *testing.T) {
+	mock := &MockFirewallClient{firewall: &firewallclient.Firewall{ID: "42", Synced: true}, active: []string{"1"}}
+	cr := newTestFirewall("1")
+	cr.Spec.ForProvider.Rules = []v1beta1.FirewallRuleSpec{
+		{Port: "22", Protocol: v1beta1.FirewallProtocolTCP, Direction: v1beta1.FirewallDirectionInbound, SourceFrom: &v1beta1.FirewallRuleSourceFrom{
+			ConfigMapKeyRef: &v1beta1.ConfigMapKeySelector{Name: "office", Key: "cidrs"},
+		}},
+	}
+	now := metav1.Now()
+	cr.SetDeletionTimestamp(&now)
+
+	// The ConfigMap the rule takes its sources from is already gone
+	obs, err := (&external{kube: fake.NewClientBuilder().Build(), client: mock}).Observe(context.Background(), cr)
+	if err != nil {
+		t.Fatalf("Observe() error = %v", err)
+	}
+	if !obs.ResourceExists {
+		t.Error("Observe() ResourceExists = false, want true until the firewall is deleted")
+	}
+}
+
+func TestExternalCreate(t *testing.T) {
+	mock := &MockFirewallClient{}
+	cr := newTestFirewall("1")
+	meta.SetExternalName(cr, "")
+	cr.Spec.ForProvider.Rules = []v1beta1.FirewallRuleSpec{
+		{Port: "443", Protocol: v1beta1.FirewallProtocolTCP, Direction: v1beta1.FirewallDirectionInbound},
+	}
+
+	if _, err := (&external{client: mock}).Create(context.Background(), cr); err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+	if meta.GetExternalName(cr) != "42" || len(mock.createdRules) != 1 {
+		t.Errorf("Create() external name = %q, rules = %+v", meta.GetExternalName(cr), mock.createdRules)
+	}
+}
+
+func TestExternalUpdate_Attachments(t *testing.T) {
+	mock := &MockFirewallClient{
+		firewall:     &firewallclient.Firewall{ID: "42"},
+		active:       []string{"1", "4"},
+		failActivate: map[string]bool{"3": true},
+	}
+	cr := newTestFirewall("1", "2", "3")
+
+	if _, err := (&external{client: mock}).Update(context.Background(), cr); err == nil {
+		t.Fatal("Update() error = nil, want error for the failed activation")
+	}
+	if len(mock.deactivated) != 1 || mock.deactivated[0] != "4" {
+		t.Errorf("deactivated = %v, want [4]", mock.deactivated)
+	}
+	if len(mock.activated) != 1 || mock.activated[0] != "2" {
+		t.Errorf("activated = %v, want [2]", mock.activated)
+	}
+	if len(mock.synced) != 1 || mock.synced[0] != "1" {
+		t.Errorf("synced = %v, want [1]", mock.synced)
+	}
+
+	instances := cr.Status.AtProvider.Instances
+	if len(instances) != 3 || instances[2].State != v1beta1.FirewallAttachmentFailed || instances[2].Message == "" {
+		t.Errorf("Instances = %+v, want instance 3 recorded as failed", instances)
+	}
+}
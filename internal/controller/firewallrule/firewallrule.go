@@ -0,0 +1,343 @@
+/*
+Copyright 2025 Ross Golder.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package firewallrule
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/client-go/util/workqueue"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/event"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+
+	v1beta1 "github.com/rossigee/provider-hostinger/apis/firewall/v1beta1"
+	instancev1beta1 "github.com/rossigee/provider-hostinger/apis/instance/v1beta1"
+	providerv1beta1 "github.com/rossigee/provider-hostinger/apis/v1beta1"
+	"github.com/rossigee/provider-hostinger/internal/clients"
+	firewallclient "github.com/rossigee/provider-hostinger/internal/clients/firewall"
+)
+
+const (
+	errNotFirewallRule = "managed resource is not a FirewallRule custom resource"
+	errGetPC           = "cannot get ProviderConfig"
+	errNewClient       = "cannot create new Hostinger client"
+
+	errGet             = "failed to get firewall"
+	errActiveInstances = "failed to list instances the firewall is active on"
+	errCreate          = "failed to create firewall"
+	errCreateRule      = "failed to create firewall rule"
+	errUpdateRules     = "failed to update firewall rules"
+	errActivate        = "failed to activate firewall on instance"
+	errSync            = "failed to sync firewall to instance"
+	errDeactivate      = "failed to deactivate firewall on instance"
+	errDelete          = "failed to delete firewall"
+
+	reasonDrift event.Reason = "FirewallDrift"
+)
+
+// Setup adds a controller that reconciles FirewallRule managed resources.
+func Setup(mgr ctrl.Manager, l logging.Logger, wl workqueue.TypedRateLimiter[any]) error {
+	name := managed.ControllerName(v1beta1.FirewallRuleGroupKind)
+
+	o := controller.Options{
+		MaxConcurrentReconciles: 5,
+	}
+
+	recorder := event.NewAPIRecorder(mgr.GetEventRecorderFor(name))
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(v1beta1.FirewallRuleGroupVersionKind),
+		managed.WithExternalConnecter(&connector{
+			kube:        mgr.GetClient(),
+			newClientFn: clients.NewClientFactory,
+			recorder:    recorder,
+		}),
+		managed.WithLogger(l.WithValues("controller", name)),
+		managed.WithRecorder(recorder),
+		managed.WithPollInterval(5*time.Minute),
+		managed.WithInitializers(),
+	)
+
+	// Rules taking their sources from ConfigMaps or Instances are applied
+	// as soon as those change, without waiting for the next poll
+	sourceUsers := firewallclient.SourceUsers(mgr.GetClient(), func() client.ObjectList { return &v1beta1.FirewallRuleList{} })
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o).
+		For(&v1beta1.FirewallRule{}).
+		Watches(&corev1.ConfigMap{}, handler.EnqueueRequestsFromMapFunc(sourceUsers)).
+		Watches(&instancev1beta1.Instance{}, handler.EnqueueRequestsFromMapFunc(sourceUsers)).
+		Complete(r)
+}
+
+// A connector is expected to produce typed ExternalClient for the managed
+// resource it is supposed to manage.
+type connector struct {
+	kube        client.Client
+	newClientFn func(client.Client, clients.HTTPClientConfig) *clients.ClientFactory
+	recorder    event.Recorder
+}
+
+// Connect produces an ExternalClient using the credentials of the
+// ProviderConfig referenced by the FirewallRule.
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1beta1.FirewallRule)
+	if !ok {
+		return nil, errors.New(errNotFirewallRule)
+	}
+
+	pc := &providerv1beta1.ProviderConfig{}
+	if err := c.kube.Get(ctx, client.ObjectKey{Namespace: cr.GetNamespace(), Name: cr.Spec.ProviderConfigReference.Name}, pc); err != nil {
+		return nil, errors.Wrap(err, errGetPC)
+	}
+
+	clientFactory := c.newClientFn(c.kube, clients.DefaultHTTPClientConfig())
+
+	hc, err := clientFactory.CreateHostingerClient(ctx, pc)
+	if err != nil {
+		return nil, errors.Wrap(err, errNewClient)
+	}
+
+	return &external{kube: c.kube, client: firewallclient.NewFirewallClient(hc), recorder: c.recorder}, nil
+}
+
+// An ExternalClient observes, then either creates, updates, or deletes an
+// external resource to ensure it reflects the managed resource's desired state.
+type external struct {
+	kube     client.Client
+	client   firewallclient.Client
+	recorder event.Recorder
+}
+
+func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1beta1.FirewallRule)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotFirewallRule)
+	}
+
+	// The external name is the ID of the Hostinger firewall
+	externalName := meta.GetExternalName(cr)
+	if externalName == "" {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	fw, err := e.client.Get(ctx, externalName)
+	if err != nil {
+		if clients.IsNotFound(err) {
+			return managed.ExternalObservation{ResourceExists: false}, nil
+		}
+		return managed.ExternalObservation{}, errors.Wrap(err, errGet)
+	}
+
+	// The rules are not needed to delete the firewall, and the objects their
+	// sources are taken from may already be gone
+	if meta.WasDeleted(cr) {
+		return managed.ExternalObservation{ResourceExists: true}, nil
+	}
+
+	desired, origin, err := firewallclient.DesiredRules(ctx, e.kube, cr.GetNamespace(), cr.Spec.ForProvider.Rules)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+
+	// Rules are compared in canonical form so that equivalent spellings,
+	// such as "80-80" and "80" or "10.0.0.1" and "10.0.0.1/32", match
+	drift := firewallclient.Compare(desired, fw.Rules)
+	previous := cr.Status.AtProvider.Drift
+
+	cr.Status.AtProvider = *firewallclient.GetObservation(fw)
+	cr.Status.AtProvider.Drift = firewallclient.GetDrift(drift)
+	specIndexes(cr.Status.AtProvider.Drift, origin)
+
+	// Drift is reported once when it appears or changes, before any
+	// remediation happens in Update
+	if !drift.Empty() && !equality.Semantic.DeepEqual(previous, cr.Status.AtProvider.Drift) {
+		e.recordEvent(cr, event.Warning(reasonDrift, errors.New(drift.String())))
+	}
+
+	// A firewall that could not be activated in Create is activated by
+	// Update
+	active, err := e.client.ActiveInstances(ctx, fw.ID)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errActiveInstances)
+	}
+	activated := contains(active, cr.Spec.ForProvider.InstanceID)
+
+	if reportOnly(cr) {
+		condition := xpv1.Available()
+		if !drift.Empty() {
+			condition = condition.WithMessage("firewall rules have drifted; drift is reported only")
+		}
+		cr.SetConditions(condition)
+		return managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: activated}, nil
+	}
+
+	cr.SetConditions(xpv1.Available())
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: drift.Empty() && fw.Synced && activated,
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1beta1.FirewallRule)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotFirewallRule)
+	}
+
+	// Validate every rule before anything is created
+	desired, _, err := firewallclient.DesiredRules(ctx, e.kube, cr.GetNamespace(), cr.Spec.ForProvider.Rules)
+	if err != nil {
+		return managed.ExternalCreation{}, err
+	}
+
+	fw, err := e.client.Create(ctx, cr.GetName())
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreate)
+	}
+	meta.SetExternalName(cr, fw.ID)
+
+	for _, r := range desired {
+		if err := e.client.CreateRule(ctx, fw.ID, r); err != nil {
+			return managed.ExternalCreation{}, errors.Wrap(err, errCreateRule)
+		}
+	}
+	if err := e.client.Activate(ctx, fw.ID, cr.Spec.ForProvider.InstanceID); err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errActivate)
+	}
+
+	return managed.ExternalCreation{}, nil
+}
+
+// Update removes undesired rules, adds missing ones and syncs the firewall
+// to the instance, activating it if it is not active there yet. Drift is
+// not corrected when it is reported only.
+func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1beta1.FirewallRule)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotFirewallRule)
+	}
+
+	firewallID := meta.GetExternalName(cr)
+	if !reportOnly(cr) {
+		desired, _, err := firewallclient.DesiredRules(ctx, e.kube, cr.GetNamespace(), cr.Spec.ForProvider.Rules)
+		if err != nil {
+			return managed.ExternalUpdate{}, err
+		}
+
+		fw, err := e.client.Get(ctx, firewallID)
+		if err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errGet)
+		}
+
+		if _, err := firewallclient.ReconcileRules(ctx, e.client, fw, desired); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateRules)
+		}
+	}
+
+	active, err := e.client.ActiveInstances(ctx, firewallID)
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errActiveInstances)
+	}
+	instanceID := cr.Spec.ForProvider.InstanceID
+	if !contains(active, instanceID) {
+		return managed.ExternalUpdate{}, errors.Wrap(e.client.Activate(ctx, firewallID, instanceID), errActivate)
+	}
+	if reportOnly(cr) {
+		return managed.ExternalUpdate{}, nil
+	}
+
+	if err := e.client.Sync(ctx, firewallID, instanceID); err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errSync)
+	}
+
+	return managed.ExternalUpdate{}, nil
+}
+
+func (e *external) Delete(ctx context.Context, mg resource.Managed) (managed.ExternalDelete, error) {
+	cr, ok := mg.(*v1beta1.FirewallRule)
+	if !ok {
+		return managed.ExternalDelete{}, errors.New(errNotFirewallRule)
+	}
+
+	firewallID := meta.GetExternalName(cr)
+	if err := e.client.Deactivate(ctx, firewallID, cr.Spec.ForProvider.InstanceID); err != nil && !clients.IsNotFound(err) {
+		return managed.ExternalDelete{}, errors.Wrap(err, errDeactivate)
+	}
+	if err := e.client.Delete(ctx, firewallID); err != nil && !clients.IsNotFound(err) {
+		return managed.ExternalDelete{}, errors.Wrap(err, errDelete)
+	}
+
+	return managed.ExternalDelete{}, nil
+}
+
+// recordEvent emits an event if a recorder is configured
+func (e *external) recordEvent(cr *v1beta1.FirewallRule, ev event.Event) {
+	if e.recorder != nil {
+		e.recorder.Event(cr, ev)
+	}
+}
+
+// specIndexes maps drift indexes in the expanded rules back to the rules in
+// the spec they were expanded from
+func specIndexes(drift *v1beta1.FirewallRuleDrift, origin []int) {
+	if drift == nil {
+		return
+	}
+	for _, diffs := range [][]v1beta1.FirewallRuleDiff{drift.Missing, drift.Modified} {
+		for i := range diffs {
+			if idx := diffs[i].Index; idx != nil && int(*idx) < len(origin) {
+				*idx = int32(origin[*idx])
+			}
+		}
+	}
+}
+
+// reportOnly returns true if drift should be reported but not corrected
+func reportOnly(cr *v1beta1.FirewallRule) bool {
+	p := cr.Spec.ForProvider.DriftPolicy
+	return p != nil && *p == v1beta1.FirewallDriftPolicyReport
+}
+
+// contains reports whether id is in ids.
+func contains(ids []string, id string) bool {
+	for _, i := range ids {
+		if i == id {
+			return true
+		}
+	}
+	return false
+}
+
+// Disconnect closes the connection to the external service.
+func (e *external) Disconnect(ctx context.Context) error {
+	return nil
+}
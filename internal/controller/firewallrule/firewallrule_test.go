@@ -0,0 +1,336 @@
+/*
+Copyright 2025 Ross Golder.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package firewallrule
+
+import (
+	"context"
+	"testing"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/event"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	v1beta1 "github.com/rossigee/provider-hostinger/apis/firewall/v1beta1"
+	firewallclient "github.com/rossigee/provider-hostinger/internal/clients/firewall"
+)
+
+// MockFirewallClient is a mock implementation of firewallclient.Client
+type MockFirewallClient struct {
+	firewall     *firewallclient.Firewall
+	createdRules []firewallclient.Rule
+	deletedRules []string
+	active       []string
+	activated    bool
+	synced       bool
+}
+
+func (m *MockFirewallClient) Get(ctx context.Context, firewallID string) (*firewallclient.Firewall, error) {
+	return m.firewall, nil
+}
+
+func (m *MockFirewallClient) Create(ctx context.Context, name string) (*firewallclient.Firewall, error) {
+	return &firewallclient.Firewall{ID: "42", Name: name}, nil
+}
+
+func (m *MockFirewallClient) Delete(ctx context.Context, firewallID string) error {
+	return nil
+}
+
+func (m *MockFirewallClient) CreateRule(ctx context.Context, firewallID string, r firewallclient.Rule) error {
+	m.createdRules = append(m.createdRules, r)
+	return nil
+}
+
+func (m *MockFirewallClient) DeleteRule(ctx context.Context, firewallID, ruleID string) error {
+	m.deletedRules = append(m.deletedRules, ruleID)
+	return nil
+}
+
+func (m *MockFirewallClient) Activate(ctx context.Context, firewallID, instanceID string) error {
+	m.activated = true
+	return nil
+}
+
+func (m *MockFirewallClient) Deactivate(ctx context.Context, firewallID, instanceID string) error {
+	return nil
+}
+
+func (m *MockFirewallClient) Sync(ctx context.Context, firewallID, instanceID string) error {
+	m.synced = true
+	return nil
+}
+
+func (m *MockFirewallClient) ActiveInstances(ctx context.Context, firewallID string) ([]string, error) {
+	return m.active, nil
+}
+
+func newTestFirewallRule(rules ...v1beta1.FirewallRuleSpec) *v1beta1.FirewallRule {
+	cr := &v1beta1.FirewallRule{}
+	cr.SetName("web")
+	cr.Spec.ForProvider.InstanceID = "123"
+	cr.Spec.ForProvider.Rules = rules
+	return cr
+}
+
+func sshFrom(source string) v1beta1.FirewallRuleSpec {
+	return v1beta1.FirewallRuleSpec{
+		Port:      "22-22",
+		Protocol:  v1beta1.FirewallProtocolTCP,
+		Direction: v1beta1.FirewallDirectionInbound,
+		Source:    &source,
+	}
+}
+
+func remoteSSH(id, source string) firewallclient.Rule {
+	return firewallclient.Rule{ID: id, Protocol: "tcp", Port: "22", Direction: "inbound", Action: "allow", Source: source, Destination: "any"}
+}
+
+func TestExternalObserve_EquivalentSpelling(t *testing.T) {
+	mock := &MockFirewallClient{firewall: &firewallclient.Firewall{
+		ID: "42", Synced: true, Rules: []firewallclient.Rule{remoteSSH("1", "10.0.0.1/32")},
+	}, active: []string{"123"}}
+	cr := newTestFirewallRule(sshFrom("10.0.0.1"))
+	meta.SetExternalName(cr, "42")
+
+	obs, err := (&external{client: mock}).Observe(context.Background(), cr)
+	if err != nil {
+		t.Fatalf("Observe() error = %v", err)
+	}
+	if !obs.ResourceExists || !obs.ResourceUpToDate {
+		t.Errorf("Observe() = %+v, want equivalent rules to be up to date", obs)
+	}
+}
+
+// recordingRecorder records the events it is given
+type recordingRecorder struct {
+	events []event.Event
+}
+
+func (r *recordingRecorder) Event(obj runtime.Object, e event.Event) {
+	r.events = append(r.events, e)
+}
+
+func (r *recordingRecorder) WithAnnotations(keysAndValues ...string) event.Recorder {
+	return r
+}
+
+func TestExternalObserve_Drift(t *testing.T) {
+	mock := &MockFirewallClient{firewall: &firewallclient.Firewall{
+		ID: "42", Synced: true, Rules: []firewallclient.Rule{
+			remoteSSH("1", "10.0.0.2/32"),
+			{ID: "2", Protocol: "tcp", Port: "80", Direction: "inbound", Action: "allow", Source: "any", Destination: "any"},
+		},
+	}}
+	cr := newTestFirewallRule(sshFrom("10.0.0.1"), v1beta1.FirewallRuleSpec{
+		Port: "443", Protocol: v1beta1.FirewallProtocolTCP, Direction: v1beta1.FirewallDirectionInbound,
+	})
+	meta.SetExternalName(cr, "42")
+	recorder := &recordingRecorder{}
+	e := &external{client: mock, recorder: recorder}
+
+	obs, err := e.Observe(context.Background(), cr)
+	if err != nil {
+		t.Fatalf("Observe() error = %v", err)
+	}
+	if obs.ResourceUpToDate {
+		t.Error("Observe() should report drifted rules as not up to date")
+	}
+
+	drift := cr.Status.AtProvider.Drift
+	if drift == nil || len(drift.Missing) != 1 || len(drift.Extra) != 1 || len(drift.Modified) != 1 {
+		t.Fatalf("drift = %+v, want one missing, extra and modified rule", drift)
+	}
+	if m := drift.Modified[0]; m.RuleID != "1" || m.Index == nil || *m.Index != 0 || *m.Desired.Source != "10.0.0.1/32" || *m.Actual.Source != "10.0.0.2/32" {
+		t.Errorf("modified = %+v, want rule 1 against desired rule 0", m)
+	}
+	if drift.Extra[0].RuleID != "2" || drift.Missing[0].Index == nil || *drift.Missing[0].Index != 1 {
+		t.Errorf("drift = %+v, want extra rule 2 and missing rule 1", drift)
+	}
+	if len(recorder.events) != 1 || recorder.events[0].Reason != reasonDrift {
+		t.Errorf("events = %+v, want one drift event", recorder.events)
+	}
+
+	// Unchanged drift is not reported again
+	if _, err := e.Observe(context.Background(), cr); err != nil {
+		t.Fatalf("Observe() error = %v", err)
+	}
+	if len(recorder.events) != 1 {
+		t.Errorf("events = %d, want unchanged drift to be reported once", len(recorder.events))
+	}
+}
+
+func TestExternalObserve_DriftReportOnly(t *testing.T) {
+	mock := &MockFirewallClient{firewall: &firewallclient.Firewall{
+		ID: "42", Synced: false, Rules: []firewallclient.Rule{remoteSSH("1", "10.0.0.2/32")},
+	}, active: []string{"123"}}
+	cr := newTestFirewallRule(sshFrom("10.0.0.1"))
+	policy := v1beta1.FirewallDriftPolicyReport
+	cr.Spec.ForProvider.DriftPolicy = &policy
+	meta.SetExternalName(cr, "42")
+
+	obs, err := (&external{client: mock, recorder: event.NewNopRecorder()}).Observe(context.Background(), cr)
+	if err != nil {
+		t.Fatalf("Observe() error = %v", err)
+	}
+	if !obs.ResourceUpToDate {
+		t.Error("Observe() should not correct drift when the policy is Report")
+	}
+	if cr.Status.AtProvider.Drift == nil || len(cr.Status.AtProvider.Drift.Modified) != 1 {
+		t.Errorf("drift = %+v, want the modified rule to be reported", cr.Status.AtProvider.Drift)
+	}
+}
+
+func TestExternalObserve_Inactive(t *testing.T) {
+	mock := &MockFirewallClient{firewall: &firewallclient.Firewall{
+		ID: "42", Synced: true, Rules: []firewallclient.Rule{remoteSSH("1", "10.0.0.1/32")},
+	}}
+	cr := newTestFirewallRule(sshFrom("10.0.0.1"))
+	meta.SetExternalName(cr, "42")
+
+	obs, err := (&external{client: mock}).Observe(context.Background(), cr)
+	if err != nil {
+		t.Fatalf("Observe() error = %v", err)
+	}
+	if !obs.ResourceExists || obs.ResourceUpToDate {
+		t.Errorf("Observe() = %+v, want a firewall that is not active on the instance to be out of date", obs)
+	}
+}
+
+func TestExternalObserve_SourceFrom(t *testing.T) {
+	cm := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "egress"},
+		Data:       map[string]string{"cidrs": "10.0.0.1, 10.0.0.2"},
+	}
+	mock := &MockFirewallClient{firewall: &firewallclient.Firewall{
+		ID: "42", Synced: true, Rules: []firewallclient.Rule{remoteSSH("1", "10.0.0.1/32")},
+	}}
+	fromConfigMap := sshFrom("")
+	fromConfigMap.Source = nil
+	fromConfigMap.SourceFrom = &v1beta1.FirewallRuleSourceFrom{
+		ConfigMapKeyRef: &v1beta1.ConfigMapKeySelector{Name: "egress", Key: "cidrs"},
+	}
+	cr := newTestFirewallRule(sshFrom("10.0.0.1"), fromConfigMap)
+	cr.SetNamespace("default")
+	meta.SetExternalName(cr, "42")
+
+	e := &external{kube: fake.NewClientBuilder().WithObjects(cm).Build(), client: mock, recorder: event.NewNopRecorder()}
+	obs, err := e.Observe(context.Background(), cr)
+	if err != nil {
+		t.Fatalf("Observe() error = %v", err)
+	}
+	if obs.ResourceUpToDate {
+		t.Error("Observe() should report rules expanded from the ConfigMap as missing")
+	}
+
+	// Both expanded rules come from rule 1 of the spec
+	drift := cr.Status.AtProvider.Drift
+	if drift == nil || len(drift.Missing) != 2 || *drift.Missing[0].Index != 1 || *drift.Missing[1].Index != 1 {
+		t.Errorf("drift = %+v, want two missing rules from spec rule 1", drift)
+	}
+}
+
+func TestExternalObserve_InvalidRule(t *testing.T) {
+	cr := newTestFirewallRule(sshFrom("10.0.0.300"))
+	meta.SetExternalName(cr, "42")
+
+	if _, err := (&external{client: &MockFirewallClient{}}).Observe(context.Background(), cr); err == nil {
+		t.Error("Observe() error = nil, want error for an invalid source")
+	}
+}
+
+func TestExternalObserve_Deleted(t *testing.T) {
+	mock := &MockFirewallClient{firewall: &firewallclient.Firewall{ID: "42", Synced: true}, active: []string{"123"}}
+	fromInstance := sshFrom("")
+	fromInstance.Source = nil
+	fromInstance.SourceFrom = &v1beta1.FirewallRuleSourceFrom{InstanceRef: &xpv1.NamespacedReference{Name: "web"}}
+	cr := newTestFirewallRule(fromInstance)
+	cr.SetNamespace("default")
+	meta.SetExternalName(cr, "42")
+	now := metav1.Now()
+	cr.SetDeletionTimestamp(&now)
+
+	// The Instance the rule takes its sources from is already gone
+	e := &external{kube: fake.NewClientBuilder().Build(), client: mock, recorder: event.NewNopRecorder()}
+	obs, err := e.Observe(context.Background(), cr)
+	if err != nil {
+		t.Fatalf("Observe() error = %v", err)
+	}
+	if !obs.ResourceExists {
+		t.Error("Observe() ResourceExists = false, want true until the firewall is deleted")
+	}
+}
+
+func TestExternalCreate(t *testing.T) {
+	mock := &MockFirewallClient{}
+	cr := newTestFirewallRule(sshFrom("10.0.0.1"))
+
+	if _, err := (&external{client: mock}).Create(context.Background(), cr); err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+	if meta.GetExternalName(cr) != "42" {
+		t.Errorf("external name = %q, want 42", meta.GetExternalName(cr))
+	}
+	if len(mock.createdRules) != 1 || mock.createdRules[0].Source != "10.0.0.1/32" || mock.createdRules[0].Port != "22" {
+		t.Errorf("created rules = %+v, want the normalised ssh rule", mock.createdRules)
+	}
+	if !mock.activated {
+		t.Error("Activate should be called on create")
+	}
+}
+
+func TestExternalUpdate(t *testing.T) {
+	mock := &MockFirewallClient{firewall: &firewallclient.Firewall{
+		ID: "42", Rules: []firewallclient.Rule{remoteSSH("1", "10.0.0.1/32"), remoteSSH("2", "0.0.0.0/0")},
+	}, active: []string{"123"}}
+	cr := newTestFirewallRule(sshFrom("10.0.0.1"), sshFrom("192.168.0.0/16"))
+	meta.SetExternalName(cr, "42")
+
+	if _, err := (&external{client: mock}).Update(context.Background(), cr); err != nil {
+		t.Fatalf("Update() error = %v", err)
+	}
+	if len(mock.deletedRules) != 1 || mock.deletedRules[0] != "2" {
+		t.Errorf("deleted rules = %v, want [2]", mock.deletedRules)
+	}
+	if len(mock.createdRules) != 1 || mock.createdRules[0].Source != "192.168.0.0/16" {
+		t.Errorf("created rules = %+v, want the 192.168.0.0/16 rule", mock.createdRules)
+	}
+	if !mock.synced {
+		t.Error("Sync should be called after updating rules")
+	}
+}
+
+func TestExternalUpdate_Activates(t *testing.T) {
+	mock := &MockFirewallClient{firewall: &firewallclient.Firewall{
+		ID: "42", Rules: []firewallclient.Rule{remoteSSH("1", "10.0.0.1/32")},
+	}}
+	cr := newTestFirewallRule(sshFrom("10.0.0.1"))
+	meta.SetExternalName(cr, "42")
+
+	if _, err := (&external{client: mock}).Update(context.Background(), cr); err != nil {
+		t.Fatalf("Update() error = %v", err)
+	}
+	if !mock.activated {
+		t.Error("Activate should be called when the firewall is not active on the instance")
+	}
+	if mock.synced {
+		t.Error("Sync should not be called on an inactive firewall")
+	}
+}
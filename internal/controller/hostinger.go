@@ -22,10 +22,47 @@ import (
 
 	"github.com/crossplane/crossplane-runtime/v2/pkg/logging"
 
+	"github.com/rossigee/provider-hostinger/internal/controller/backup"
+	"github.com/rossigee/provider-hostinger/internal/controller/backuppolicy"
+	"github.com/rossigee/provider-hostinger/internal/controller/backuprestore"
+	"github.com/rossigee/provider-hostinger/internal/controller/dnszone"
+	"github.com/rossigee/provider-hostinger/internal/controller/dnszonesnapshot"
+	"github.com/rossigee/provider-hostinger/internal/controller/domain"
+	"github.com/rossigee/provider-hostinger/internal/controller/domainforwarding"
+	"github.com/rossigee/provider-hostinger/internal/controller/domainregistration"
+	"github.com/rossigee/provider-hostinger/internal/controller/firewall"
+	"github.com/rossigee/provider-hostinger/internal/controller/firewallrule"
 	"github.com/rossigee/provider-hostinger/internal/controller/instance"
+	"github.com/rossigee/provider-hostinger/internal/controller/postinstallscript"
+	"github.com/rossigee/provider-hostinger/internal/controller/record"
+	"github.com/rossigee/provider-hostinger/internal/controller/snapshot"
+	"github.com/rossigee/provider-hostinger/internal/controller/sshkey"
+	"github.com/rossigee/provider-hostinger/internal/controller/whoisprofile"
 )
 
 // Setup registers all Hostinger provider controllers with the manager
 func Setup(mgr ctrl.Manager, l logging.Logger, wl workqueue.TypedRateLimiter[any]) error {
-	return instance.Setup(mgr, l, wl)
+	for _, setup := range []func(ctrl.Manager, logging.Logger, workqueue.TypedRateLimiter[any]) error{
+		instance.Setup,
+		postinstallscript.Setup,
+		snapshot.Setup,
+		backup.Setup,
+		backuppolicy.Setup,
+		backuprestore.Setup,
+		firewall.Setup,
+		firewallrule.Setup,
+		sshkey.Setup,
+		record.Setup,
+		dnszone.Setup,
+		dnszonesnapshot.Setup,
+		domain.Setup,
+		domainforwarding.Setup,
+		domainregistration.Setup,
+		whoisprofile.Setup,
+	} {
+		if err := setup(mgr, l, wl); err != nil {
+			return err
+		}
+	}
+	return nil
 }
@@ -18,6 +18,7 @@ package instance
 
 import (
 	"context"
+	"strings"
 	"time"
 
 	"github.com/pkg/errors"
@@ -26,6 +27,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller"
 
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
 	"github.com/crossplane/crossplane-runtime/v2/pkg/event"
 	"github.com/crossplane/crossplane-runtime/v2/pkg/logging"
 	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
@@ -43,6 +45,20 @@ const (
 	errTrackPCUsage = "cannot track ProviderConfig usage"
 	errGetPC        = "cannot get ProviderConfig"
 	errNewClient    = "cannot create new Hostinger client"
+
+	errGetPasswordSecret  = "cannot get root password secret"
+	errRecreateNotAllowed = "osId changed but allowRecreate is not set; refusing to wipe the instance disk"
+	errRecreate           = "failed to recreate instance"
+	errGetAction          = "cannot get instance action"
+	errResolvePlan        = "cannot resolve instance plan"
+	errPlanDowngrade      = "plan %q is smaller than the current instance; downgrades are not supported"
+	errUpgrade            = "failed to upgrade instance plan"
+	errDataCenterChanged  = "dataCenterId %s differs from data center %s of the instance, which cannot be moved"
+
+	errGetRecoveryPasswordSecret = "cannot get recovery mode root password secret"
+	errNoRecoveryPassword        = "recoveryMode.rootPasswordSecretRef must be set to enable recovery mode"
+	errStartRecovery             = "failed to start recovery mode"
+	errStopRecovery              = "failed to stop recovery mode"
 )
 
 // Setup adds a controller that reconciles Instance managed resources.
@@ -107,12 +123,13 @@ func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.E
 	// Create the instance client
 	instanceClient := instanceclient.NewInstanceClient(hc)
 
-	return &external{client: instanceClient}, nil
+	return &external{kube: c.kube, client: instanceClient}, nil
 }
 
 // An ExternalClient observes, then either creates, updates, or deletes an
 // external resource to ensure it reflects the managed resource's desired state.
 type external struct {
+	kube   client.Client
 	client instanceclient.Client
 }
 
@@ -140,8 +157,61 @@ func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.Ex
 		return managed.ExternalObservation{}, err
 	}
 
-	// Update the observation status
+	// Update the observation status, keeping track of any action in flight
+	recreateActionID := cr.Status.AtProvider.RecreateActionID
+	upgradeActionID := cr.Status.AtProvider.UpgradeActionID
+	recoveryActionID := cr.Status.AtProvider.RecoveryActionID
 	cr.Status.AtProvider = *e.client.GetObservation(instance)
+	cr.Status.AtProvider.RecreateActionID = recreateActionID
+	cr.Status.AtProvider.UpgradeActionID = upgradeActionID
+	cr.Status.AtProvider.RecoveryActionID = recoveryActionID
+
+	switch instance.Status {
+	case instanceclient.StateRunning:
+		cr.SetConditions(xpv1.Available())
+	case instanceclient.StateRecovery:
+		cr.SetConditions(xpv1.Unavailable().WithMessage("instance is booted into recovery mode"))
+	default:
+		cr.SetConditions(xpv1.Unavailable())
+	}
+
+	// Don't drive any further changes while the operating system is being
+	// reinstalled, otherwise a lagging template ID would trigger it again.
+	recreating, err := e.observeRecreate(ctx, cr, externalName, instance)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+	if recreating {
+		return managed.ExternalObservation{
+			ResourceExists:   true,
+			ResourceUpToDate: true,
+		}, nil
+	}
+
+	// Likewise a paid upgrade must not be ordered again while the API still
+	// reports the old plan.
+	upgrading, err := e.observeUpgrade(ctx, cr, externalName)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+	if upgrading {
+		return managed.ExternalObservation{
+			ResourceExists:   true,
+			ResourceUpToDate: true,
+		}, nil
+	}
+
+	// Nor toggle recovery mode again while the instance is still rebooting.
+	toggling, err := e.observeRecovery(ctx, cr, externalName)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+	if toggling {
+		return managed.ExternalObservation{
+			ResourceExists:   true,
+			ResourceUpToDate: true,
+		}, nil
+	}
 
 	// Check if the instance is up-to-date
 	upToDate := e.client.UpToDate(instance, &cr.Spec.ForProvider)
@@ -158,8 +228,13 @@ func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.Ext
 		return managed.ExternalCreation{}, errors.New(errNotInstance)
 	}
 
+	password, err := e.rootPassword(ctx, cr)
+	if err != nil {
+		return managed.ExternalCreation{}, err
+	}
+
 	// Create the instance
-	instance, err := e.client.Create(ctx, &cr.Spec.ForProvider)
+	instance, err := e.client.Create(ctx, &cr.Spec.ForProvider, password)
 	if err != nil {
 		return managed.ExternalCreation{}, errors.Wrap(err, "failed to create instance")
 	}
@@ -186,6 +261,28 @@ func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.Ext
 		return managed.ExternalUpdate{}, errors.New("external name not set")
 	}
 
+	// Instances cannot be moved between data centers
+	current := cr.Status.AtProvider.DataCenterID
+	if dc := cr.Spec.ForProvider.DataCenterID; dc != nil && current != "" && *dc != current {
+		return managed.ExternalUpdate{}, errors.Errorf(errDataCenterChanged, *dc, current)
+	}
+
+	// Entering or leaving recovery mode takes precedence over other changes,
+	// which are applied once the instance runs its own operating system again
+	if instanceclient.RecoveryModeChanged(&cr.Spec.ForProvider, cr.Status.AtProvider.RecoveryMode) {
+		return managed.ExternalUpdate{}, e.toggleRecovery(ctx, cr, externalName)
+	}
+
+	// Changing the operating system requires reinstalling the instance
+	if cr.Status.AtProvider.CurrentOSId != "" && cr.Spec.ForProvider.OSId != cr.Status.AtProvider.CurrentOSId {
+		return managed.ExternalUpdate{}, e.recreate(ctx, cr, externalName)
+	}
+
+	// Moving to a different plan goes through the purchase/upgrade flow
+	if planChanged(&cr.Spec.ForProvider, &cr.Status.AtProvider) {
+		return managed.ExternalUpdate{}, e.upgrade(ctx, cr, externalName)
+	}
+
 	// Update the instance
 	if err := e.client.Update(ctx, externalName, &cr.Spec.ForProvider); err != nil {
 		return managed.ExternalUpdate{}, errors.Wrap(err, "failed to update instance")
@@ -213,6 +310,180 @@ func (e *external) Delete(ctx context.Context, mg resource.Managed) (managed.Ext
 	return managed.ExternalDelete{}, nil
 }
 
+// observeRecreate reports whether an operating system reinstall is still in
+// progress, and records its outcome on the Recreate condition once it is done.
+func (e *external) observeRecreate(ctx context.Context, cr *v1beta1.Instance, externalName string, instance *instanceclient.Instance) (bool, error) {
+	if instance.Status == instanceclient.StateRecreating {
+		cr.SetConditions(v1beta1.Recreating())
+		return true, nil
+	}
+
+	actionID := cr.Status.AtProvider.RecreateActionID
+	if actionID == nil {
+		return false, nil
+	}
+
+	action, err := e.client.GetAction(ctx, externalName, *actionID)
+	if err != nil {
+		return false, errors.Wrap(err, errGetAction)
+	}
+	if !action.IsDone() {
+		cr.SetConditions(v1beta1.Recreating())
+		return true, nil
+	}
+
+	cr.Status.AtProvider.RecreateActionID = nil
+	if action.State == clients.ActionStateError {
+		cr.SetConditions(v1beta1.RecreateFailed())
+		return false, nil
+	}
+	cr.SetConditions(v1beta1.RecreateComplete())
+	return false, nil
+}
+
+// observeUpgrade reports whether a plan upgrade is still in progress, and
+// records its outcome on the Upgrade condition once the instance reports the
+// new plan.
+func (e *external) observeUpgrade(ctx context.Context, cr *v1beta1.Instance, externalName string) (bool, error) {
+	actionID := cr.Status.AtProvider.UpgradeActionID
+	if actionID == nil {
+		return false, nil
+	}
+
+	action, err := e.client.GetAction(ctx, externalName, *actionID)
+	if err != nil {
+		return false, errors.Wrap(err, errGetAction)
+	}
+	if action.State == clients.ActionStateError {
+		cr.Status.AtProvider.UpgradeActionID = nil
+		cr.SetConditions(v1beta1.UpgradeFailed())
+		return false, nil
+	}
+	if !action.IsDone() || planChanged(&cr.Spec.ForProvider, &cr.Status.AtProvider) {
+		cr.SetConditions(v1beta1.Upgrading())
+		return true, nil
+	}
+
+	cr.Status.AtProvider.UpgradeActionID = nil
+	cr.SetConditions(v1beta1.UpgradeComplete())
+	return false, nil
+}
+
+// observeRecovery reports whether recovery mode is still being entered or
+// left. A failed toggle is forgotten so that Update tries it again.
+func (e *external) observeRecovery(ctx context.Context, cr *v1beta1.Instance, externalName string) (bool, error) {
+	actionID := cr.Status.AtProvider.RecoveryActionID
+	if actionID == nil {
+		return false, nil
+	}
+
+	action, err := e.client.GetAction(ctx, externalName, *actionID)
+	if err != nil {
+		return false, errors.Wrap(err, errGetAction)
+	}
+	if action.State != clients.ActionStateError &&
+		(!action.IsDone() || instanceclient.RecoveryModeChanged(&cr.Spec.ForProvider, cr.Status.AtProvider.RecoveryMode)) {
+		return true, nil
+	}
+
+	cr.Status.AtProvider.RecoveryActionID = nil
+	return false, nil
+}
+
+// recreate reinstalls the operating system of the instance if allowed to.
+func (e *external) recreate(ctx context.Context, cr *v1beta1.Instance, externalName string) error {
+	if cr.Spec.ForProvider.AllowRecreate == nil || !*cr.Spec.ForProvider.AllowRecreate {
+		cr.SetConditions(v1beta1.RecreateNotAllowed())
+		return errors.New(errRecreateNotAllowed)
+	}
+
+	password, err := e.rootPassword(ctx, cr)
+	if err != nil {
+		return err
+	}
+
+	action, err := e.client.Recreate(ctx, externalName, &cr.Spec.ForProvider, password)
+	if err != nil {
+		return errors.Wrap(err, errRecreate)
+	}
+
+	cr.Status.AtProvider.RecreateActionID = &action.ID
+	cr.SetConditions(xpv1.Unavailable(), v1beta1.Recreating())
+	return nil
+}
+
+// upgrade moves the instance to the plan described by its parameters.
+func (e *external) upgrade(ctx context.Context, cr *v1beta1.Instance, externalName string) error {
+	plan, err := e.client.ResolvePlan(ctx, &cr.Spec.ForProvider)
+	if err != nil {
+		return errors.Wrap(err, errResolvePlan)
+	}
+
+	current := cr.Status.AtProvider
+	if plan.IsSmallerThan(current.CurrentCPUCount, current.CurrentRAM, current.CurrentDiskSize) {
+		return errors.Errorf(errPlanDowngrade, plan.Name)
+	}
+
+	action, err := e.client.Upgrade(ctx, externalName, plan, cr.Spec.ForProvider.PaymentMethodID)
+	if err != nil {
+		return errors.Wrap(err, errUpgrade)
+	}
+
+	cr.Status.AtProvider.UpgradeActionID = &action.ID
+	cr.SetConditions(v1beta1.Upgrading())
+	return nil
+}
+
+// toggleRecovery boots the instance into or out of the rescue system.
+func (e *external) toggleRecovery(ctx context.Context, cr *v1beta1.Instance, externalName string) error {
+	recovery := cr.Spec.ForProvider.RecoveryMode
+	if !recovery.Enabled {
+		action, err := e.client.StopRecovery(ctx, externalName)
+		if err != nil {
+			return errors.Wrap(err, errStopRecovery)
+		}
+		cr.Status.AtProvider.RecoveryActionID = &action.ID
+		return nil
+	}
+
+	if recovery.RootPasswordSecretRef == nil {
+		return errors.New(errNoRecoveryPassword)
+	}
+	password, err := clients.GetSecretKey(ctx, e.kube, *recovery.RootPasswordSecretRef, cr.GetNamespace())
+	if err != nil {
+		return errors.Wrap(err, errGetRecoveryPasswordSecret)
+	}
+
+	action, err := e.client.StartRecovery(ctx, externalName, password)
+	if err != nil {
+		return errors.Wrap(err, errStartRecovery)
+	}
+	cr.Status.AtProvider.RecoveryActionID = &action.ID
+	return nil
+}
+
+// planChanged reports whether the desired plan, or the desired size when no
+// plan is named, differs from what is currently observed.
+func planChanged(params *v1beta1.InstanceParameters, obs *v1beta1.InstanceObservation) bool {
+	if params.Plan != nil {
+		return !strings.EqualFold(*params.Plan, obs.CurrentPlan)
+	}
+	return (params.CPUCount > 0 && params.CPUCount != obs.CurrentCPUCount) ||
+		(params.RAM > 0 && params.RAM != obs.CurrentRAM) ||
+		(params.DiskSize > 0 && params.DiskSize != obs.CurrentDiskSize)
+}
+
+// rootPassword reads the root password from the referenced secret, if any.
+func (e *external) rootPassword(ctx context.Context, cr *v1beta1.Instance) (string, error) {
+	ref := cr.Spec.ForProvider.RootPasswordSecretRef
+	if ref == nil {
+		return "", nil
+	}
+
+	password, err := clients.GetSecretKey(ctx, e.kube, *ref, cr.GetNamespace())
+	return password, errors.Wrap(err, errGetPasswordSecret)
+}
+
 // Disconnect closes the connection to the external service.
 func (e *external) Disconnect(ctx context.Context) error {
 	// No cleanup needed for Hostinger client
@@ -21,7 +21,15 @@ import (
 	"fmt"
 	"testing"
 
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+
 	instanceapi "github.com/rossigee/provider-hostinger/apis/instance/v1beta1"
+	"github.com/rossigee/provider-hostinger/internal/clients"
 	instanceclient "github.com/rossigee/provider-hostinger/internal/clients/instance"
 )
 
@@ -31,9 +39,16 @@ type MockHostingerClient struct {
 
 // MockInstanceClient is a mock implementation of instanceclient.Client
 type MockInstanceClient struct {
+	instance       *instanceclient.Instance
+	action         *clients.Action
+	plan           *instanceclient.Plan
+	recreateCalled bool
+	updateCalled   bool
+	upgradeCalled  bool
+	recoveryCalls  []string
 }
 
-func (m *MockInstanceClient) Create(ctx context.Context, params *instanceapi.InstanceParameters) (*instanceclient.Instance, error) {
+func (m *MockInstanceClient) Create(ctx context.Context, params *instanceapi.InstanceParameters, password string) (*instanceclient.Instance, error) {
 	return &instanceclient.Instance{
 		ID:       "mock-instance-123",
 		Hostname: params.Hostname,
@@ -45,6 +60,9 @@ func (m *MockInstanceClient) Get(ctx context.Context, instanceID string) (*insta
 	if instanceID == "" {
 		return nil, fmt.Errorf("instance ID cannot be empty")
 	}
+	if m.instance != nil {
+		return m.instance, nil
+	}
 	return &instanceclient.Instance{
 		ID:       instanceID,
 		Hostname: "mock-host",
@@ -56,6 +74,7 @@ func (m *MockInstanceClient) Update(ctx context.Context, instanceID string, para
 	if instanceID == "" {
 		return fmt.Errorf("instance ID cannot be empty")
 	}
+	m.updateCalled = true
 	return nil
 }
 
@@ -78,6 +97,9 @@ func (m *MockInstanceClient) GetObservation(instance *instanceclient.Instance) *
 		ID:              instance.ID,
 		Status:          instance.Status,
 		CurrentHostname: instance.Hostname,
+		CurrentOSId:     instance.OSId,
+		CurrentPlan:     instance.Plan,
+		RecoveryMode:    instance.Status == instanceclient.StateRecovery,
 	}
 }
 
@@ -86,7 +108,45 @@ func (m *MockInstanceClient) LateInitialize(instance *instanceclient.Instance, p
 }
 
 func (m *MockInstanceClient) UpToDate(instance *instanceclient.Instance, params *instanceapi.InstanceParameters) bool {
-	return true
+	return !instanceclient.NeedsRecreate(instance, params)
+}
+
+func (m *MockInstanceClient) Recreate(ctx context.Context, instanceID string, params *instanceapi.InstanceParameters, password string) (*clients.Action, error) {
+	m.recreateCalled = true
+	return &clients.Action{ID: 42, Name: "recreate", State: clients.ActionStateInitiated}, nil
+}
+
+func (m *MockInstanceClient) ResolvePlan(ctx context.Context, params *instanceapi.InstanceParameters) (*instanceclient.Plan, error) {
+	if m.plan == nil {
+		return nil, fmt.Errorf("plan not found")
+	}
+	return m.plan, nil
+}
+
+func (m *MockInstanceClient) Upgrade(ctx context.Context, instanceID string, plan *instanceclient.Plan, paymentMethodID *int64) (*clients.Action, error) {
+	m.upgradeCalled = true
+	return &clients.Action{ID: 45, Name: "upgrade", State: clients.ActionStateInitiated}, nil
+}
+
+func (m *MockInstanceClient) ListDataCenters(ctx context.Context) ([]*instanceclient.DataCenter, error) {
+	return nil, nil
+}
+
+func (m *MockInstanceClient) StartRecovery(ctx context.Context, instanceID, rootPassword string) (*clients.Action, error) {
+	m.recoveryCalls = append(m.recoveryCalls, "start:"+rootPassword)
+	return &clients.Action{ID: 43, Name: "recovery_start", State: clients.ActionStateInitiated}, nil
+}
+
+func (m *MockInstanceClient) StopRecovery(ctx context.Context, instanceID string) (*clients.Action, error) {
+	m.recoveryCalls = append(m.recoveryCalls, "stop")
+	return &clients.Action{ID: 44, Name: "recovery_stop", State: clients.ActionStateInitiated}, nil
+}
+
+func (m *MockInstanceClient) GetAction(ctx context.Context, instanceID string, actionID int64) (*clients.Action, error) {
+	if m.action == nil {
+		return nil, fmt.Errorf("action %d not found", actionID)
+	}
+	return m.action, nil
 }
 
 
@@ -144,6 +204,369 @@ func TestExternalDisconnect(t *testing.T) {
 	}
 }
 
+func newTestInstance(osID, currentOSID string) *instanceapi.Instance {
+	cr := &instanceapi.Instance{}
+	cr.Spec.ForProvider.OSId = osID
+	cr.Status.AtProvider.CurrentOSId = currentOSID
+	meta.SetExternalName(cr, "inst-123")
+	return cr
+}
+
+func TestExternalUpdate_RecreateNotAllowed(t *testing.T) {
+	mock := &MockInstanceClient{}
+	ext := &external{client: mock}
+	cr := newTestInstance("1002", "1001")
+
+	if _, err := ext.Update(context.Background(), cr); err == nil {
+		t.Fatal("Update() error = nil, want error when allowRecreate is not set")
+	}
+	if mock.recreateCalled {
+		t.Error("Recreate should not be called without allowRecreate")
+	}
+	if got := cr.GetCondition(instanceapi.TypeRecreate).Reason; got != instanceapi.ReasonRecreateNotAllowed {
+		t.Errorf("Recreate condition reason = %v, want %v", got, instanceapi.ReasonRecreateNotAllowed)
+	}
+}
+
+func TestExternalUpdate_Recreate(t *testing.T) {
+	allow := true
+	mock := &MockInstanceClient{}
+	ext := &external{client: mock}
+	cr := newTestInstance("1002", "1001")
+	cr.Spec.ForProvider.AllowRecreate = &allow
+
+	if _, err := ext.Update(context.Background(), cr); err != nil {
+		t.Fatalf("Update() error = %v", err)
+	}
+	if !mock.recreateCalled {
+		t.Error("Recreate should be called when osId changes and allowRecreate is set")
+	}
+	if mock.updateCalled {
+		t.Error("Update should not be called while recreating")
+	}
+	if cr.Status.AtProvider.RecreateActionID == nil || *cr.Status.AtProvider.RecreateActionID != 42 {
+		t.Errorf("RecreateActionID = %v, want 42", cr.Status.AtProvider.RecreateActionID)
+	}
+	if got := cr.GetCondition(instanceapi.TypeRecreate).Reason; got != instanceapi.ReasonRecreating {
+		t.Errorf("Recreate condition reason = %v, want %v", got, instanceapi.ReasonRecreating)
+	}
+}
+
+func TestExternalObserve_RecreateInProgress(t *testing.T) {
+	actionID := int64(42)
+	mock := &MockInstanceClient{
+		instance: &instanceclient.Instance{ID: "inst-123", Status: instanceclient.StateRunning, OSId: "1001"},
+		action:   &clients.Action{ID: actionID, State: clients.ActionStateSent},
+	}
+	ext := &external{client: mock}
+	cr := newTestInstance("1002", "1001")
+	cr.Status.AtProvider.RecreateActionID = &actionID
+
+	obs, err := ext.Observe(context.Background(), cr)
+	if err != nil {
+		t.Fatalf("Observe() error = %v", err)
+	}
+	if !obs.ResourceUpToDate {
+		t.Error("Observe should report up to date while a recreate is in progress")
+	}
+	if cr.Status.AtProvider.RecreateActionID == nil {
+		t.Error("RecreateActionID should be kept while the action is running")
+	}
+}
+
+func TestExternalObserve_RecreateComplete(t *testing.T) {
+	actionID := int64(42)
+	mock := &MockInstanceClient{
+		instance: &instanceclient.Instance{ID: "inst-123", Status: instanceclient.StateRunning, OSId: "1002"},
+		action:   &clients.Action{ID: actionID, State: clients.ActionStateSuccess},
+	}
+	ext := &external{client: mock}
+	cr := newTestInstance("1002", "1001")
+	cr.Status.AtProvider.RecreateActionID = &actionID
+
+	obs, err := ext.Observe(context.Background(), cr)
+	if err != nil {
+		t.Fatalf("Observe() error = %v", err)
+	}
+	if !obs.ResourceUpToDate {
+		t.Error("Observe should report up to date once the new template is installed")
+	}
+	if cr.Status.AtProvider.RecreateActionID != nil {
+		t.Error("RecreateActionID should be cleared once the action is done")
+	}
+	if got := cr.GetCondition(instanceapi.TypeRecreate).Reason; got != instanceapi.ReasonRecreateComplete {
+		t.Errorf("Recreate condition reason = %v, want %v", got, instanceapi.ReasonRecreateComplete)
+	}
+}
+
+func TestExternalUpdate_Upgrade(t *testing.T) {
+	plan := "KVM 4"
+	mock := &MockInstanceClient{
+		plan: &instanceclient.Plan{Name: "KVM 4", CPUCount: 4, RAM: 16384, DiskSize: 200},
+	}
+	ext := &external{client: mock}
+	cr := newTestInstance("1002", "1002")
+	cr.Spec.ForProvider.Plan = &plan
+	cr.Status.AtProvider.CurrentPlan = "KVM 2"
+	cr.Status.AtProvider.CurrentCPUCount = 2
+	cr.Status.AtProvider.CurrentRAM = 8192
+	cr.Status.AtProvider.CurrentDiskSize = 100
+
+	if _, err := ext.Update(context.Background(), cr); err != nil {
+		t.Fatalf("Update() error = %v", err)
+	}
+	if !mock.upgradeCalled {
+		t.Error("Upgrade should be called when the plan changes")
+	}
+	if cr.Status.AtProvider.UpgradeActionID == nil || *cr.Status.AtProvider.UpgradeActionID != 45 {
+		t.Errorf("UpgradeActionID = %v, want 45", cr.Status.AtProvider.UpgradeActionID)
+	}
+	if got := cr.GetCondition(instanceapi.TypeUpgrade).Reason; got != instanceapi.ReasonUpgrading {
+		t.Errorf("Upgrade condition reason = %v, want %v", got, instanceapi.ReasonUpgrading)
+	}
+}
+
+func TestExternalObserve_UpgradeInProgress(t *testing.T) {
+	plan := "KVM 4"
+	actionID := int64(45)
+
+	cases := map[string]struct {
+		state string
+	}{
+		"ActionRunning": {state: clients.ActionStateSent},
+		// The action can finish before the API reports the new plan
+		"PlanNotReportedYet": {state: clients.ActionStateSuccess},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			mock := &MockInstanceClient{
+				instance: &instanceclient.Instance{ID: "inst-123", Status: instanceclient.StateRunning, OSId: "1002", Plan: "KVM 2"},
+				action:   &clients.Action{ID: actionID, State: tc.state},
+			}
+			ext := &external{client: mock}
+			cr := newTestInstance("1002", "1002")
+			cr.Spec.ForProvider.Plan = &plan
+			cr.Status.AtProvider.UpgradeActionID = &actionID
+
+			obs, err := ext.Observe(context.Background(), cr)
+			if err != nil {
+				t.Fatalf("Observe() error = %v", err)
+			}
+			if !obs.ResourceUpToDate {
+				t.Error("Observe should report up to date while an upgrade is in progress")
+			}
+			if cr.Status.AtProvider.UpgradeActionID == nil {
+				t.Error("UpgradeActionID should be kept until the new plan is reported")
+			}
+		})
+	}
+}
+
+func TestExternalObserve_UpgradeComplete(t *testing.T) {
+	plan := "KVM 4"
+	actionID := int64(45)
+	mock := &MockInstanceClient{
+		instance: &instanceclient.Instance{ID: "inst-123", Status: instanceclient.StateRunning, OSId: "1002", Plan: "KVM 4"},
+		action:   &clients.Action{ID: actionID, State: clients.ActionStateSuccess},
+	}
+	ext := &external{client: mock}
+	cr := newTestInstance("1002", "1002")
+	cr.Spec.ForProvider.Plan = &plan
+	cr.Status.AtProvider.UpgradeActionID = &actionID
+
+	if _, err := ext.Observe(context.Background(), cr); err != nil {
+		t.Fatalf("Observe() error = %v", err)
+	}
+	if cr.Status.AtProvider.UpgradeActionID != nil {
+		t.Error("UpgradeActionID should be cleared once the new plan is reported")
+	}
+	if got := cr.GetCondition(instanceapi.TypeUpgrade).Reason; got != instanceapi.ReasonUpgradeComplete {
+		t.Errorf("Upgrade condition reason = %v, want %v", got, instanceapi.ReasonUpgradeComplete)
+	}
+}
+
+func TestExternalObserve_UpgradeFailed(t *testing.T) {
+	plan := "KVM 4"
+	actionID := int64(45)
+	mock := &MockInstanceClient{
+		instance: &instanceclient.Instance{ID: "inst-123", Status: instanceclient.StateRunning, OSId: "1002", Plan: "KVM 2"},
+		action:   &clients.Action{ID: actionID, State: clients.ActionStateError},
+	}
+	ext := &external{client: mock}
+	cr := newTestInstance("1002", "1002")
+	cr.Spec.ForProvider.Plan = &plan
+	cr.Status.AtProvider.UpgradeActionID = &actionID
+
+	if _, err := ext.Observe(context.Background(), cr); err != nil {
+		t.Fatalf("Observe() error = %v", err)
+	}
+	if cr.Status.AtProvider.UpgradeActionID != nil {
+		t.Error("UpgradeActionID should be cleared once the action failed")
+	}
+	if got := cr.GetCondition(instanceapi.TypeUpgrade).Reason; got != instanceapi.ReasonUpgradeFailed {
+		t.Errorf("Upgrade condition reason = %v, want %v", got, instanceapi.ReasonUpgradeFailed)
+	}
+}
+
+func TestExternalUpdate_RefusesDataCenterChange(t *testing.T) {
+	dataCenter := "19"
+	mock := &MockInstanceClient{}
+	ext := &external{client: mock}
+	cr := newTestInstance("1002", "1002")
+	cr.Spec.ForProvider.DataCenterID = &dataCenter
+	cr.Status.AtProvider.DataCenterID = "17"
+
+	if _, err := ext.Update(context.Background(), cr); err == nil {
+		t.Fatal("Update() error = nil, want error for a data center change")
+	}
+	if mock.updateCalled {
+		t.Error("Update should not be called when the data center changed")
+	}
+}
+
+func TestExternalUpdate_RefusesDowngrade(t *testing.T) {
+	plan := "KVM 1"
+	mock := &MockInstanceClient{
+		plan: &instanceclient.Plan{Name: "KVM 1", CPUCount: 1, RAM: 4096, DiskSize: 50},
+	}
+	ext := &external{client: mock}
+	cr := newTestInstance("1002", "1002")
+	cr.Spec.ForProvider.Plan = &plan
+	cr.Status.AtProvider.CurrentPlan = "KVM 2"
+	cr.Status.AtProvider.CurrentCPUCount = 2
+	cr.Status.AtProvider.CurrentRAM = 8192
+	cr.Status.AtProvider.CurrentDiskSize = 100
+
+	if _, err := ext.Update(context.Background(), cr); err == nil {
+		t.Fatal("Update() error = nil, want error for a plan downgrade")
+	}
+	if mock.upgradeCalled {
+		t.Error("Upgrade should not be called for a smaller plan")
+	}
+}
+
+func TestExternalUpdate_StartRecovery(t *testing.T) {
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "rescue", Namespace: "default"},
+		Data:       map[string][]byte{"password": []byte("r3scue")},
+	}
+	mock := &MockInstanceClient{}
+	ext := &external{kube: fake.NewClientBuilder().WithObjects(secret).Build(), client: mock}
+	cr := newTestInstance("1002", "1002")
+	cr.Namespace = "default"
+	cr.Spec.ForProvider.RecoveryMode = &instanceapi.RecoveryMode{
+		Enabled: true,
+		RootPasswordSecretRef: &xpv1.SecretKeySelector{
+			SecretReference: xpv1.SecretReference{Name: "rescue"},
+			Key:             "password",
+		},
+	}
+
+	if _, err := ext.Update(context.Background(), cr); err != nil {
+		t.Fatalf("Update() error = %v", err)
+	}
+	if len(mock.recoveryCalls) != 1 || mock.recoveryCalls[0] != "start:r3scue" {
+		t.Errorf("recovery calls = %v, want start with the rescue password", mock.recoveryCalls)
+	}
+	if cr.Status.AtProvider.RecoveryActionID == nil || *cr.Status.AtProvider.RecoveryActionID != 43 {
+		t.Errorf("RecoveryActionID = %v, want 43", cr.Status.AtProvider.RecoveryActionID)
+	}
+	if mock.updateCalled {
+		t.Error("Update should wait until recovery mode has been toggled")
+	}
+}
+
+func TestExternalUpdate_StopRecovery(t *testing.T) {
+	mock := &MockInstanceClient{}
+	ext := &external{client: mock}
+	cr := newTestInstance("1002", "1002")
+	cr.Spec.ForProvider.RecoveryMode = &instanceapi.RecoveryMode{Enabled: false}
+	cr.Status.AtProvider.RecoveryMode = true
+
+	if _, err := ext.Update(context.Background(), cr); err != nil {
+		t.Fatalf("Update() error = %v", err)
+	}
+	if len(mock.recoveryCalls) != 1 || mock.recoveryCalls[0] != "stop" {
+		t.Errorf("recovery calls = %v, want stop", mock.recoveryCalls)
+	}
+	if cr.Status.AtProvider.RecoveryActionID == nil || *cr.Status.AtProvider.RecoveryActionID != 44 {
+		t.Errorf("RecoveryActionID = %v, want 44", cr.Status.AtProvider.RecoveryActionID)
+	}
+}
+
+func TestExternalObserve_RecoveryToggle(t *testing.T) {
+	actionID := int64(43)
+
+	cases := map[string]struct {
+		status     string
+		state      string
+		wantHold   bool
+		wantAction bool
+	}{
+		"ActionRunning": {
+			status:     instanceclient.StateRunning,
+			state:      clients.ActionStateSent,
+			wantHold:   true,
+			wantAction: true,
+		},
+		// The action can finish before the API reports the new state
+		"StateNotReportedYet": {
+			status:     instanceclient.StateRunning,
+			state:      clients.ActionStateSuccess,
+			wantHold:   true,
+			wantAction: true,
+		},
+		"Done": {
+			status: instanceclient.StateRecovery,
+			state:  clients.ActionStateSuccess,
+		},
+		"Failed": {
+			status: instanceclient.StateRunning,
+			state:  clients.ActionStateError,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			mock := &MockInstanceClient{
+				instance: &instanceclient.Instance{ID: "inst-123", OSId: "1002", Status: tc.status},
+				action:   &clients.Action{ID: actionID, State: tc.state},
+			}
+			ext := &external{client: mock}
+			cr := newTestInstance("1002", "1002")
+			cr.Spec.ForProvider.RecoveryMode = &instanceapi.RecoveryMode{Enabled: true}
+			cr.Status.AtProvider.RecoveryActionID = &actionID
+
+			obs, err := ext.Observe(context.Background(), cr)
+			if err != nil {
+				t.Fatalf("Observe() error = %v", err)
+			}
+			if tc.wantHold && !obs.ResourceUpToDate {
+				t.Error("Observe should report up to date while recovery mode is being toggled")
+			}
+			if got := cr.Status.AtProvider.RecoveryActionID != nil; got != tc.wantAction {
+				t.Errorf("RecoveryActionID kept = %v, want %v", got, tc.wantAction)
+			}
+		})
+	}
+}
+
+func TestExternalObserve_RecoveryNotReady(t *testing.T) {
+	mock := &MockInstanceClient{
+		instance: &instanceclient.Instance{ID: "inst-123", OSId: "1002", Status: instanceclient.StateRecovery},
+	}
+	ext := &external{client: mock}
+	cr := newTestInstance("1002", "1002")
+
+	if _, err := ext.Observe(context.Background(), cr); err != nil {
+		t.Fatalf("Observe() error = %v", err)
+	}
+	if got := cr.GetCondition(xpv1.TypeReady).Reason; got != xpv1.ReasonUnavailable {
+		t.Errorf("Ready reason = %v, want %v", got, xpv1.ReasonUnavailable)
+	}
+}
+
 // Integration test structure for reference
 // These would require:
 // - envtest for running a real Kubernetes API server
@@ -0,0 +1,230 @@
+/*
+Copyright 2025 Ross Golder.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package postinstallscript
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+	"k8s.io/client-go/util/workqueue"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/event"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+
+	v1beta1 "github.com/rossigee/provider-hostinger/apis/postinstallscript/v1beta1"
+	providerv1beta1 "github.com/rossigee/provider-hostinger/apis/v1beta1"
+	"github.com/rossigee/provider-hostinger/internal/clients"
+	scriptclient "github.com/rossigee/provider-hostinger/internal/clients/postinstallscript"
+)
+
+const (
+	errNotPostInstallScript = "managed resource is not a PostInstallScript custom resource"
+	errGetPC                = "cannot get ProviderConfig"
+	errNewClient            = "cannot create new Hostinger client"
+
+	errGetContentSecret = "cannot get post-install script content secret"
+	errNoContent        = "one of content or contentSecretRef must be set"
+	errCreate           = "failed to create post-install script"
+	errUpdate           = "failed to update post-install script"
+	errDelete           = "failed to delete post-install script"
+)
+
+// Setup adds a controller that reconciles PostInstallScript managed resources.
+func Setup(mgr ctrl.Manager, l logging.Logger, wl workqueue.TypedRateLimiter[any]) error {
+	name := managed.ControllerName(v1beta1.PostInstallScriptGroupKind)
+
+	o := controller.Options{
+		MaxConcurrentReconciles: 5,
+	}
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(v1beta1.PostInstallScriptGroupVersionKind),
+		managed.WithExternalConnecter(&connector{
+			kube:        mgr.GetClient(),
+			newClientFn: clients.NewClientFactory,
+		}),
+		managed.WithLogger(l.WithValues("controller", name)),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		managed.WithPollInterval(5*time.Minute),
+		managed.WithInitializers(),
+	)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o).
+		For(&v1beta1.PostInstallScript{}).
+		Complete(r)
+}
+
+// A connector is expected to produce typed ExternalClient for the managed
+// resource it is supposed to manage.
+type connector struct {
+	kube        client.Client
+	newClientFn func(client.Client, clients.HTTPClientConfig) *clients.ClientFactory
+}
+
+// Connect produces an ExternalClient using the credentials of the
+// ProviderConfig referenced by the PostInstallScript.
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1beta1.PostInstallScript)
+	if !ok {
+		return nil, errors.New(errNotPostInstallScript)
+	}
+
+	pc := &providerv1beta1.ProviderConfig{}
+	if err := c.kube.Get(ctx, client.ObjectKey{Namespace: cr.GetNamespace(), Name: cr.Spec.ProviderConfigReference.Name}, pc); err != nil {
+		return nil, errors.Wrap(err, errGetPC)
+	}
+
+	clientFactory := c.newClientFn(c.kube, clients.DefaultHTTPClientConfig())
+
+	hc, err := clientFactory.CreateHostingerClient(ctx, pc)
+	if err != nil {
+		return nil, errors.Wrap(err, errNewClient)
+	}
+
+	return &external{kube: c.kube, client: scriptclient.NewPostInstallScriptClient(hc)}, nil
+}
+
+// An ExternalClient observes, then either creates, updates, or deletes an
+// external resource to ensure it reflects the managed resource's desired state.
+type external struct {
+	kube   client.Client
+	client scriptclient.Client
+}
+
+func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1beta1.PostInstallScript)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotPostInstallScript)
+	}
+
+	externalName := meta.GetExternalName(cr)
+	if externalName == "" {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	script, err := e.client.Get(ctx, externalName)
+	if err != nil {
+		if clients.IsNotFound(err) {
+			return managed.ExternalObservation{ResourceExists: false}, nil
+		}
+		return managed.ExternalObservation{}, err
+	}
+
+	cr.Status.AtProvider = *e.client.GetObservation(script)
+	cr.SetConditions(xpv1.Available())
+
+	// The content is not needed to delete the script, and its secret may
+	// already be gone
+	if meta.WasDeleted(cr) {
+		return managed.ExternalObservation{ResourceExists: true}, nil
+	}
+
+	content, err := e.content(ctx, cr)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: e.client.UpToDate(script, &cr.Spec.ForProvider, content),
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1beta1.PostInstallScript)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotPostInstallScript)
+	}
+
+	content, err := e.content(ctx, cr)
+	if err != nil {
+		return managed.ExternalCreation{}, err
+	}
+
+	script, err := e.client.Create(ctx, &cr.Spec.ForProvider, content)
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreate)
+	}
+
+	meta.SetExternalName(cr, script.ID)
+	return managed.ExternalCreation{}, nil
+}
+
+func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1beta1.PostInstallScript)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotPostInstallScript)
+	}
+
+	content, err := e.content(ctx, cr)
+	if err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+
+	err = e.client.Update(ctx, meta.GetExternalName(cr), &cr.Spec.ForProvider, content)
+	return managed.ExternalUpdate{}, errors.Wrap(err, errUpdate)
+}
+
+func (e *external) Delete(ctx context.Context, mg resource.Managed) (managed.ExternalDelete, error) {
+	cr, ok := mg.(*v1beta1.PostInstallScript)
+	if !ok {
+		return managed.ExternalDelete{}, errors.New(errNotPostInstallScript)
+	}
+
+	externalName := meta.GetExternalName(cr)
+	if externalName == "" {
+		return managed.ExternalDelete{}, nil
+	}
+
+	err := e.client.Delete(ctx, externalName)
+	if clients.IsNotFound(err) {
+		return managed.ExternalDelete{}, nil
+	}
+	return managed.ExternalDelete{}, errors.Wrap(err, errDelete)
+}
+
+// content returns the script content, either inline or from the referenced
+// secret.
+func (e *external) content(ctx context.Context, cr *v1beta1.PostInstallScript) (string, error) {
+	params := cr.Spec.ForProvider
+	if params.Content != nil {
+		return *params.Content, nil
+	}
+
+	ref := params.ContentSecretRef
+	if ref == nil {
+		return "", errors.New(errNoContent)
+	}
+
+	content, err := clients.GetSecretKey(ctx, e.kube, *ref, cr.GetNamespace())
+	return content, errors.Wrap(err, errGetContentSecret)
+}
+
+// Disconnect closes the connection to the external service.
+func (e *external) Disconnect(ctx context.Context) error {
+	return nil
+}
@@ -0,0 +1,175 @@
+/*
+Copyright 2025 Ross Golder.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package postinstallscript
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+
+	v1beta1 "github.com/rossigee/provider-hostinger/apis/postinstallscript/v1beta1"
+	"github.com/rossigee/provider-hostinger/internal/clients"
+	scriptclient "github.com/rossigee/provider-hostinger/internal/clients/postinstallscript"
+)
+
+// MockScriptClient is a mock implementation of scriptclient.Client
+type MockScriptClient struct {
+	script         *scriptclient.Script
+	createdContent string
+	updatedContent string
+	deleteErr      error
+}
+
+func (m *MockScriptClient) Create(ctx context.Context, params *v1beta1.PostInstallScriptParameters, content string) (*scriptclient.Script, error) {
+	m.createdContent = content
+	return &scriptclient.Script{ID: "325", Name: params.Name, Content: content}, nil
+}
+
+func (m *MockScriptClient) Get(ctx context.Context, id string) (*scriptclient.Script, error) {
+	if m.script == nil {
+		return nil, clients.ClassifyError(http.StatusNotFound, "post-install script not found")
+	}
+	return m.script, nil
+}
+
+func (m *MockScriptClient) Update(ctx context.Context, id string, params *v1beta1.PostInstallScriptParameters, content string) error {
+	m.updatedContent = content
+	return nil
+}
+
+func (m *MockScriptClient) Delete(ctx context.Context, id string) error {
+	return m.deleteErr
+}
+
+func (m *MockScriptClient) GetObservation(script *scriptclient.Script) *v1beta1.PostInstallScriptObservation {
+	return &v1beta1.PostInstallScriptObservation{ID: script.ID, Name: script.Name}
+}
+
+func (m *MockScriptClient) UpToDate(script *scriptclient.Script, params *v1beta1.PostInstallScriptParameters, content string) bool {
+	return script.Name == params.Name && script.Content == content
+}
+
+func newTestScript(content string) *v1beta1.PostInstallScript {
+	cr := &v1beta1.PostInstallScript{ObjectMeta: metav1.ObjectMeta{Name: "bootstrap", Namespace: "default"}}
+	cr.Spec.ForProvider.Name = "bootstrap"
+	if content != "" {
+		cr.Spec.ForProvider.Content = &content
+	}
+	return cr
+}
+
+func TestExternalObserve_NoExternalName(t *testing.T) {
+	ext := &external{client: &MockScriptClient{}}
+
+	obs, err := ext.Observe(context.Background(), newTestScript("echo hi"))
+	if err != nil {
+		t.Fatalf("Observe() error = %v", err)
+	}
+	if obs.ResourceExists {
+		t.Error("Observe() ResourceExists = true, want false")
+	}
+}
+
+func TestExternalObserve_ContentDrift(t *testing.T) {
+	ext := &external{client: &MockScriptClient{script: &scriptclient.Script{ID: "325", Name: "bootstrap", Content: "echo old"}}}
+	cr := newTestScript("echo new")
+	meta.SetExternalName(cr, "325")
+
+	obs, err := ext.Observe(context.Background(), cr)
+	if err != nil {
+		t.Fatalf("Observe() error = %v", err)
+	}
+	if !obs.ResourceExists || obs.ResourceUpToDate {
+		t.Errorf("Observe() = %+v, want existing but not up to date", obs)
+	}
+}
+
+func TestExternalObserve_Deleted(t *testing.T) {
+	ext := &external{kube: fake.NewClientBuilder().Build(), client: &MockScriptClient{script: &scriptclient.Script{ID: "325", Name: "bootstrap"}}}
+	cr := newTestScript("")
+	cr.Spec.ForProvider.ContentSecretRef = &xpv1.SecretKeySelector{
+		SecretReference: xpv1.SecretReference{Name: "bootstrap-script"},
+		Key:             "script",
+	}
+	meta.SetExternalName(cr, "325")
+	now := metav1.Now()
+	cr.SetDeletionTimestamp(&now)
+
+	obs, err := ext.Observe(context.Background(), cr)
+	if err != nil {
+		t.Fatalf("Observe() error = %v", err)
+	}
+	if !obs.ResourceExists {
+		t.Error("Observe() ResourceExists = false, want true")
+	}
+}
+
+func TestExternalCreate_ContentFromSecret(t *testing.T) {
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "bootstrap-script", Namespace: "default"},
+		Data:       map[string][]byte{"script": []byte("#!/bin/sh\necho secret")},
+	}
+	mock := &MockScriptClient{}
+	ext := &external{kube: fake.NewClientBuilder().WithObjects(secret).Build(), client: mock}
+
+	cr := newTestScript("")
+	cr.Spec.ForProvider.ContentSecretRef = &xpv1.SecretKeySelector{
+		SecretReference: xpv1.SecretReference{Name: "bootstrap-script"},
+		Key:             "script",
+	}
+
+	if _, err := ext.Create(context.Background(), cr); err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+	if mock.createdContent != "#!/bin/sh\necho secret" {
+		t.Errorf("created content = %q", mock.createdContent)
+	}
+	if meta.GetExternalName(cr) != "325" {
+		t.Errorf("external name = %q, want 325", meta.GetExternalName(cr))
+	}
+}
+
+func TestExternalUpdate(t *testing.T) {
+	mock := &MockScriptClient{}
+	ext := &external{client: mock}
+	cr := newTestScript("echo new")
+	meta.SetExternalName(cr, "325")
+
+	if _, err := ext.Update(context.Background(), cr); err != nil {
+		t.Fatalf("Update() error = %v", err)
+	}
+	if mock.updatedContent != "echo new" {
+		t.Errorf("updated content = %q, want echo new", mock.updatedContent)
+	}
+}
+
+func TestExternalDelete_AlreadyGone(t *testing.T) {
+	ext := &external{client: &MockScriptClient{deleteErr: clients.ClassifyError(http.StatusNotFound, "post-install script not found")}}
+	cr := newTestScript("echo hi")
+	meta.SetExternalName(cr, "325")
+
+	if _, err := ext.Delete(context.Background(), cr); err != nil {
+		t.Errorf("Delete() error = %v, want nil for a missing script", err)
+	}
+}
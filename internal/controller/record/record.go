@@ -0,0 +1,410 @@
+/*
+Copyright 2025 Ross Golder.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package record
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/workqueue"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/event"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+
+	v1beta1 "github.com/rossigee/provider-hostinger/apis/dns/v1beta1"
+	instancev1beta1 "github.com/rossigee/provider-hostinger/apis/instance/v1beta1"
+	providerv1beta1 "github.com/rossigee/provider-hostinger/apis/v1beta1"
+	"github.com/rossigee/provider-hostinger/internal/clients"
+	dnsclient "github.com/rossigee/provider-hostinger/internal/clients/dns"
+)
+
+const (
+	errNotRecord = "managed resource is not a Record custom resource"
+	errGetPC     = "cannot get ProviderConfig"
+	errNewClient = "cannot create new Hostinger client"
+
+	errInvalidValues = "invalid record values"
+	errGetInstance   = "cannot get referenced instance"
+	errNoInstanceIP  = "referenced instance has no address for the record type"
+	errGetZone       = "failed to get DNS zone"
+	errCNAMEConflict = "a CNAME record with another value already exists at this name"
+	errUpdateZone    = "failed to update DNS zone"
+	errDeleteRecords = "failed to delete DNS records"
+)
+
+// Setup adds a controller that reconciles Record managed resources.
+func Setup(mgr ctrl.Manager, l logging.Logger, wl workqueue.TypedRateLimiter[any]) error {
+	name := managed.ControllerName(v1beta1.RecordGroupKind)
+
+	o := controller.Options{
+		MaxConcurrentReconciles: 5,
+	}
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(v1beta1.RecordGroupVersionKind),
+		managed.WithExternalConnecter(&connector{
+			kube:        mgr.GetClient(),
+			newClientFn: clients.NewClientFactory,
+		}),
+		managed.WithLogger(l.WithValues("controller", name)),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		managed.WithPollInterval(5*time.Minute),
+		managed.WithInitializers(),
+	)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o).
+		For(&v1beta1.Record{}).
+		Complete(r)
+}
+
+// A connector is expected to produce typed ExternalClient for the managed
+// resource it is supposed to manage.
+type connector struct {
+	kube        client.Client
+	newClientFn func(client.Client, clients.HTTPClientConfig) *clients.ClientFactory
+}
+
+// Connect produces an ExternalClient using the credentials of the
+// ProviderConfig referenced by the Record.
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1beta1.Record)
+	if !ok {
+		return nil, errors.New(errNotRecord)
+	}
+
+	pc := &providerv1beta1.ProviderConfig{}
+	if err := c.kube.Get(ctx, client.ObjectKey{Namespace: cr.GetNamespace(), Name: cr.Spec.ProviderConfigReference.Name}, pc); err != nil {
+		return nil, errors.Wrap(err, errGetPC)
+	}
+
+	clientFactory := c.newClientFn(c.kube, clients.DefaultHTTPClientConfig())
+
+	hc, err := clientFactory.CreateHostingerClient(ctx, pc)
+	if err != nil {
+		return nil, errors.Wrap(err, errNewClient)
+	}
+
+	return &external{kube: c.kube, client: dnsclient.NewDNSClient(hc)}, nil
+}
+
+// An ExternalClient observes, then either creates, updates, or deletes an
+// external resource to ensure it reflects the managed resource's desired state.
+type external struct {
+	kube   client.Client
+	client dnsclient.Client
+}
+
+// Observe compares the values this Record owns with the live record set.
+// Values at the same name and type that the Record does not own are
+// reported but otherwise ignored.
+func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1beta1.Record)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotRecord)
+	}
+
+	if meta.GetExternalName(cr) == "" {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	// The instance values are resolved from may already be gone, so a
+	// Record being deleted exists while any value it owns is live
+	if meta.WasDeleted(cr) {
+		set, live, err := e.liveSet(ctx, cr)
+		if err != nil {
+			if clients.IsNotFound(errors.Cause(err)) {
+				return managed.ExternalObservation{ResourceExists: false}, nil
+			}
+			return managed.ExternalObservation{}, err
+		}
+		owned := cr.Status.AtProvider.ManagedValues
+		return managed.ExternalObservation{ResourceExists: set != nil && len(intersect(live, owned)) > 0}, nil
+	}
+
+	desired, err := e.desiredValues(ctx, cr)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+	set, live, err := e.liveSet(ctx, cr)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+	owned := ownedValues(cr, desired, live)
+	if set == nil || len(intersect(live, append(owned, desired...))) == 0 {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	p := cr.Spec.ForProvider
+	cr.Status.AtProvider.FQDN = dnsclient.FQDN(p.Zone, p.Name)
+	cr.Status.AtProvider.TTL = set.TTL
+	cr.Status.AtProvider.Values = live
+	cr.Status.AtProvider.ManagedValues = owned
+	cr.SetConditions(xpv1.Available())
+
+	upToDate := len(intersect(live, desired)) == len(desired) &&
+		len(intersect(live, subtract(owned, desired))) == 0 &&
+		(p.TTL == nil || *p.TTL == set.TTL)
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: upToDate,
+	}, nil
+}
+
+// Create adds the desired values to the record set, keeping any values
+// already there.
+func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1beta1.Record)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotRecord)
+	}
+
+	if err := e.write(ctx, cr); err != nil {
+		return managed.ExternalCreation{}, err
+	}
+
+	p := cr.Spec.ForProvider
+	meta.SetExternalName(cr, dnsclient.NormalizeName(p.Zone, p.Name)+"/"+string(p.Type))
+	return managed.ExternalCreation{}, nil
+}
+
+// Update writes the desired values and removes owned values that are no
+// longer desired, keeping values the Record does not own.
+func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1beta1.Record)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotRecord)
+	}
+
+	return managed.ExternalUpdate{}, e.write(ctx, cr)
+}
+
+// Delete removes the owned values from the record set, and the record set
+// itself once no other values remain.
+func (e *external) Delete(ctx context.Context, mg resource.Managed) (managed.ExternalDelete, error) {
+	cr, ok := mg.(*v1beta1.Record)
+	if !ok {
+		return managed.ExternalDelete{}, errors.New(errNotRecord)
+	}
+
+	set, live, err := e.liveSet(ctx, cr)
+	if err != nil {
+		if clients.IsNotFound(errors.Cause(err)) {
+			return managed.ExternalDelete{}, nil
+		}
+		return managed.ExternalDelete{}, err
+	}
+	if set == nil {
+		return managed.ExternalDelete{}, nil
+	}
+
+	// Values resolved from an instance may be gone, so only owned values are
+	// removed
+	owned := cr.Status.AtProvider.ManagedValues
+	if len(owned) == 0 {
+		desired, err := e.desiredValues(ctx, cr)
+		if err != nil {
+			return managed.ExternalDelete{}, err
+		}
+		owned = intersect(desired, live)
+	}
+
+	remaining := rawValues(set, subtract(live, owned))
+	p := cr.Spec.ForProvider
+	if len(remaining) == 0 && len(set.Disabled) == 0 {
+		err := e.client.DeleteRecords(ctx, p.Zone, []dnsclient.Filter{{Name: set.Name, Type: set.Type}})
+		if err != nil && !clients.IsNotFound(err) {
+			return managed.ExternalDelete{}, errors.Wrap(err, errDeleteRecords)
+		}
+		return managed.ExternalDelete{}, nil
+	}
+	if len(remaining) == len(set.Values) {
+		return managed.ExternalDelete{}, nil
+	}
+	remainingSet := dnsclient.RecordSet{Name: set.Name, Type: set.Type, TTL: set.TTL, Values: remaining, Disabled: set.Disabled}
+	if err := e.client.UpdateZone(ctx, p.Zone, true, []dnsclient.RecordSet{remainingSet}); err != nil {
+		return managed.ExternalDelete{}, errors.Wrap(err, errUpdateZone)
+	}
+	return managed.ExternalDelete{}, nil
+}
+
+// write replaces the record set with the values the Record does not own
+// followed by the desired values. The zone update overwrites whole record
+// sets, so the values of other owners and disabled values have to be written
+// back.
+func (e *external) write(ctx context.Context, cr *v1beta1.Record) error {
+	desired, err := e.desiredValues(ctx, cr)
+	if err != nil {
+		return err
+	}
+	set, live, err := e.liveSet(ctx, cr)
+	if err != nil {
+		return err
+	}
+
+	p := cr.Spec.ForProvider
+	ttl := int32(dnsclient.DefaultTTL)
+	values := []string{}
+	var disabled []string
+	if set != nil {
+		ttl = set.TTL
+		disabled = set.Disabled
+		foreign := subtract(live, append(ownedValues(cr, desired, live), desired...))
+		if p.Type == v1beta1.RecordTypeCNAME && len(foreign) > 0 {
+			return errors.New(errCNAMEConflict)
+		}
+		values = rawValues(set, foreign)
+	}
+	if p.TTL != nil {
+		ttl = *p.TTL
+	}
+
+	want := dnsclient.RecordSet{
+		Name:     dnsclient.NormalizeName(p.Zone, p.Name),
+		Type:     string(p.Type),
+		TTL:      ttl,
+		Values:   append(values, desired...),
+		Disabled: disabled,
+	}
+	if err := e.client.UpdateZone(ctx, p.Zone, true, []dnsclient.RecordSet{want}); err != nil {
+		return errors.Wrap(err, errUpdateZone)
+	}
+	cr.Status.AtProvider.ManagedValues = desired
+	return nil
+}
+
+// desiredValues returns the normalized values the Record should own
+func (e *external) desiredValues(ctx context.Context, cr *v1beta1.Record) ([]string, error) {
+	p := cr.Spec.ForProvider
+	values := p.Values
+	if ref := p.InstanceRef; ref != nil {
+		instance := &instancev1beta1.Instance{}
+		if err := e.kube.Get(ctx, types.NamespacedName{Namespace: clients.DefaultNamespace(ref.Namespace, cr.GetNamespace()), Name: ref.Name}, instance); err != nil {
+			return nil, errors.Wrap(err, errGetInstance)
+		}
+		address := instance.Status.AtProvider.IPAddress
+		if p.Type == v1beta1.RecordTypeAAAA {
+			address = instance.Status.AtProvider.IPv6Address
+		}
+		if address == "" {
+			return nil, errors.New(errNoInstanceIP)
+		}
+		values = []string{address}
+	}
+	normalized, err := dnsclient.NormalizeValues(string(p.Type), values)
+	return normalized, errors.Wrap(err, errInvalidValues)
+}
+
+// liveSet returns the record set at the Record's name and type, or nil, and
+// its normalized values
+func (e *external) liveSet(ctx context.Context, cr *v1beta1.Record) (*dnsclient.RecordSet, []string, error) {
+	p := cr.Spec.ForProvider
+	sets, err := e.client.GetZone(ctx, p.Zone)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, errGetZone)
+	}
+	set := dnsclient.FindSet(sets, p.Zone, p.Name, string(p.Type))
+	if set == nil {
+		return nil, nil, nil
+	}
+	live := make([]string, 0, len(set.Values))
+	for _, v := range set.Values {
+		// Values that cannot be parsed are kept as they are, so that they
+		// are still written back untouched
+		n, err := dnsclient.NormalizeValue(set.Type, v)
+		if err != nil {
+			n = v
+		}
+		live = append(live, n)
+	}
+	return set, live, nil
+}
+
+// ownedValues returns the values the Record manages. Before the first write
+// these are the desired values that are already live, so that adopting an
+// existing record set does not claim values it does not declare.
+func ownedValues(cr *v1beta1.Record, desired, live []string) []string {
+	if len(cr.Status.AtProvider.ManagedValues) > 0 {
+		return cr.Status.AtProvider.ManagedValues
+	}
+	return intersect(desired, live)
+}
+
+// rawValues returns the values of the record set, as returned by the API,
+// whose normalized form is in keep
+func rawValues(set *dnsclient.RecordSet, keep []string) []string {
+	values := []string{}
+	for _, v := range set.Values {
+		n, err := dnsclient.NormalizeValue(set.Type, v)
+		if err != nil {
+			n = v
+		}
+		if contains(keep, n) {
+			values = append(values, strings.TrimSpace(v))
+		}
+	}
+	return values
+}
+
+// intersect returns the values of a that are also in b
+func intersect(a, b []string) []string {
+	out := []string{}
+	for _, v := range a {
+		if contains(b, v) && !contains(out, v) {
+			out = append(out, v)
+		}
+	}
+	return out
+}
+
+// subtract returns the values of a that are not in b
+func subtract(a, b []string) []string {
+	out := []string{}
+	for _, v := range a {
+		if !contains(b, v) && !contains(out, v) {
+			out = append(out, v)
+		}
+	}
+	return out
+}
+
+// contains returns true if s contains v
+func contains(s []string, v string) bool {
+	for _, x := range s {
+		if x == v {
+			return true
+		}
+	}
+	return false
+}
+
+// Disconnect closes the connection to the external service.
+func (e *external) Disconnect(ctx context.Context) error {
+	return nil
+}
@@ -0,0 +1,289 @@
+/*
+Copyright 2025 Ross Golder.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package record
+
+import (
+	"context"
+	"net/http"
+	"reflect"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+
+	v1beta1 "github.com/rossigee/provider-hostinger/apis/dns/v1beta1"
+	instancev1beta1 "github.com/rossigee/provider-hostinger/apis/instance/v1beta1"
+	"github.com/rossigee/provider-hostinger/internal/clients"
+	dnsclient "github.com/rossigee/provider-hostinger/internal/clients/dns"
+)
+
+// MockDNSClient is a mock implementation of dnsclient.Client
+type MockDNSClient struct {
+	zone    []dnsclient.RecordSet
+	updates [][]dnsclient.RecordSet
+	deletes [][]dnsclient.Filter
+}
+
+func (m *MockDNSClient) GetZone(ctx context.Context, domain string) ([]dnsclient.RecordSet, error) {
+	if m.zone == nil {
+		return nil, clients.ClassifyError(http.StatusNotFound, "not found")
+	}
+	return m.zone, nil
+}
+
+func (m *MockDNSClient) UpdateZone(ctx context.Context, domain string, overwrite bool, sets []dnsclient.RecordSet) error {
+	m.updates = append(m.updates, sets)
+	return nil
+}
+
+func (m *MockDNSClient) DeleteRecords(ctx context.Context, domain string, filters []dnsclient.Filter) error {
+	m.deletes = append(m.deletes, filters)
+	return nil
+}
+
+func (m *MockDNSClient) ResetZone(ctx context.Context, domain string, resetEmailRecords bool, keepTypes []string) error {
+	return nil
+}
+
+func (m *MockDNSClient) ListSnapshots(ctx context.Context, domain string) ([]*dnsclient.Snapshot, error) {
+	return nil, nil
+}
+
+func (m *MockDNSClient) RestoreSnapshot(ctx context.Context, domain, snapshotID string) error {
+	return nil
+}
+
+func newTestRecord(recordType v1beta1.RecordType, values ...string) *v1beta1.Record {
+	cr := &v1beta1.Record{}
+	cr.SetNamespace("default")
+	cr.SetName("www")
+	cr.Spec.ForProvider.Zone = "example.com"
+	cr.Spec.ForProvider.Name = "www"
+	cr.Spec.ForProvider.Type = recordType
+	cr.Spec.ForProvider.Values = values
+	return cr
+}
+
+func newTestExternal(mock *MockDNSClient, objs ...client.Object) *external {
+	s := runtime.NewScheme()
+	_ = clientgoscheme.AddToScheme(s)
+	_ = instancev1beta1.SchemeBuilder.AddToScheme(s)
+	return &external{kube: fake.NewClientBuilder().WithScheme(s).WithObjects(objs...).Build(), client: mock}
+}
+
+func TestExternalObserve(t *testing.T) {
+	mock := &MockDNSClient{zone: []dnsclient.RecordSet{
+		{Name: "www", Type: "A", TTL: 300, Values: []string{"192.0.2.1", "198.51.100.1"}},
+	}}
+	cr := newTestRecord(v1beta1.RecordTypeA, "192.0.2.1")
+	meta.SetExternalName(cr, "www/A")
+
+	obs, err := newTestExternal(mock).Observe(context.Background(), cr)
+	if err != nil {
+		t.Fatalf("Observe() error = %v", err)
+	}
+	if !obs.ResourceExists || !obs.ResourceUpToDate {
+		t.Errorf("Observe() = %+v, want existing and up to date despite the unmanaged value", obs)
+	}
+	if !reflect.DeepEqual(cr.Status.AtProvider.ManagedValues, []string{"192.0.2.1"}) {
+		t.Errorf("ManagedValues = %v, want only the declared value", cr.Status.AtProvider.ManagedValues)
+	}
+	if cr.Status.AtProvider.FQDN != "www.example.com" {
+		t.Errorf("FQDN = %q, want www.example.com", cr.Status.AtProvider.FQDN)
+	}
+
+	ttl := int32(3600)
+	cr.Spec.ForProvider.TTL = &ttl
+	obs, err = newTestExternal(mock).Observe(context.Background(), cr)
+	if err != nil {
+		t.Fatalf("Observe() error = %v", err)
+	}
+	if obs.ResourceUpToDate {
+		t.Error("Observe() ResourceUpToDate = true, want false for a different TTL")
+	}
+}
+
+func TestExternalObserveMissing(t *testing.T) {
+	mock := &MockDNSClient{zone: []dnsclient.RecordSet{
+		{Name: "www", Type: "A", TTL: 300, Values: []string{"198.51.100.1"}},
+	}}
+	cr := newTestRecord(v1beta1.RecordTypeA, "192.0.2.1")
+	meta.SetExternalName(cr, "www/A")
+
+	obs, err := newTestExternal(mock).Observe(context.Background(), cr)
+	if err != nil {
+		t.Fatalf("Observe() error = %v", err)
+	}
+	if obs.ResourceExists {
+		t.Error("Observe() ResourceExists = true, want false when none of the values are live")
+	}
+}
+
+func TestExternalCreateKeepsOtherValues(t *testing.T) {
+	mock := &MockDNSClient{zone: []dnsclient.RecordSet{
+		{Name: "www", Type: "A", TTL: 300, Values: []string{"198.51.100.1"}},
+	}}
+	cr := newTestRecord(v1beta1.RecordTypeA, "192.0.2.1")
+
+	if _, err := newTestExternal(mock).Create(context.Background(), cr); err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+	want := [][]dnsclient.RecordSet{{{Name: "www", Type: "A", TTL: 300, Values: []string{"198.51.100.1", "192.0.2.1"}}}}
+	if !reflect.DeepEqual(mock.updates, want) {
+		t.Errorf("updates = %+v, want %+v", mock.updates, want)
+	}
+	if meta.GetExternalName(cr) != "www/A" {
+		t.Errorf("external name = %q, want www/A", meta.GetExternalName(cr))
+	}
+}
+
+func TestExternalUpdateKeepsDisabledValues(t *testing.T) {
+	mock := &MockDNSClient{zone: []dnsclient.RecordSet{
+		{Name: "www", Type: "A", TTL: 300, Values: []string{"198.51.100.1"}, Disabled: []string{"198.51.100.2"}},
+	}}
+	cr := newTestRecord(v1beta1.RecordTypeA, "192.0.2.1")
+
+	if _, err := newTestExternal(mock).Update(context.Background(), cr); err != nil {
+		t.Fatalf("Update() error = %v", err)
+	}
+	want := [][]dnsclient.RecordSet{{{Name: "www", Type: "A", TTL: 300, Values: []string{"198.51.100.1", "192.0.2.1"}, Disabled: []string{"198.51.100.2"}}}}
+	if !reflect.DeepEqual(mock.updates, want) {
+		t.Errorf("updates = %+v, want %+v", mock.updates, want)
+	}
+}
+
+func TestExternalCreateCNAMEConflict(t *testing.T) {
+	mock := &MockDNSClient{zone: []dnsclient.RecordSet{
+		{Name: "www", Type: "CNAME", TTL: 300, Values: []string{"other.example.net."}},
+	}}
+	cr := newTestRecord(v1beta1.RecordTypeCNAME, "example.com")
+
+	if _, err := newTestExternal(mock).Create(context.Background(), cr); err == nil {
+		t.Error("Create() error = nil, want a conflict with the existing CNAME")
+	}
+	if len(mock.updates) != 0 {
+		t.Errorf("updates = %+v, want none", mock.updates)
+	}
+}
+
+func TestExternalUpdateRemovesStaleOwnedValues(t *testing.T) {
+	mock := &MockDNSClient{zone: []dnsclient.RecordSet{
+		{Name: "@", Type: "MX", TTL: 300, Values: []string{"10 mx1.example.com.", "20 mx2.example.com.", "30 backup.example.net."}},
+	}}
+	cr := newTestRecord(v1beta1.RecordTypeMX, "10 mx1.example.com", "15 mx3.example.com")
+	cr.Spec.ForProvider.Name = "@"
+	cr.Status.AtProvider.ManagedValues = []string{"10 mx1.example.com", "20 mx2.example.com"}
+
+	if _, err := newTestExternal(mock).Update(context.Background(), cr); err != nil {
+		t.Fatalf("Update() error = %v", err)
+	}
+	want := [][]dnsclient.RecordSet{{{Name: "@", Type: "MX", TTL: 300, Values: []string{"30 backup.example.net.", "10 mx1.example.com", "15 mx3.example.com"}}}}
+	if !reflect.DeepEqual(mock.updates, want) {
+		t.Errorf("updates = %+v, want %+v", mock.updates, want)
+	}
+	if !reflect.DeepEqual(cr.Status.AtProvider.ManagedValues, []string{"10 mx1.example.com", "15 mx3.example.com"}) {
+		t.Errorf("ManagedValues = %v, want the desired values", cr.Status.AtProvider.ManagedValues)
+	}
+}
+
+func TestExternalInstanceRef(t *testing.T) {
+	inst := &instancev1beta1.Instance{ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "web"}}
+	inst.Status.AtProvider.IPAddress = "203.0.113.10"
+	inst.Status.AtProvider.IPv6Address = "2001:db8::10"
+
+	for recordType, want := range map[v1beta1.RecordType]string{v1beta1.RecordTypeA: "203.0.113.10", v1beta1.RecordTypeAAAA: "2001:db8::10"} {
+		mock := &MockDNSClient{zone: []dnsclient.RecordSet{}}
+		cr := newTestRecord(recordType)
+		cr.Spec.ForProvider.InstanceRef = &xpv1.NamespacedReference{Name: "web"}
+
+		if _, err := newTestExternal(mock, inst).Create(context.Background(), cr); err != nil {
+			t.Fatalf("Create(%s) error = %v", recordType, err)
+		}
+		if len(mock.updates) != 1 || !reflect.DeepEqual(mock.updates[0][0].Values, []string{want}) {
+			t.Errorf("Create(%s) updates = %+v, want value %s", recordType, mock.updates, want)
+		}
+		if mock.updates[0][0].TTL != dnsclient.DefaultTTL {
+			t.Errorf("Create(%s) TTL = %d, want the default", recordType, mock.updates[0][0].TTL)
+		}
+	}
+}
+
+func TestExternalObserveDeleted(t *testing.T) {
+	mock := &MockDNSClient{zone: []dnsclient.RecordSet{
+		{Name: "www", Type: "A", TTL: 300, Values: []string{"203.0.113.10", "198.51.100.1"}},
+	}}
+	// The Instance the value was resolved from is already gone
+	cr := newTestRecord(v1beta1.RecordTypeA)
+	cr.Spec.ForProvider.InstanceRef = &xpv1.NamespacedReference{Name: "web"}
+	cr.Status.AtProvider.ManagedValues = []string{"203.0.113.10"}
+	meta.SetExternalName(cr, "www/A")
+	now := metav1.Now()
+	cr.SetDeletionTimestamp(&now)
+
+	obs, err := newTestExternal(mock).Observe(context.Background(), cr)
+	if err != nil {
+		t.Fatalf("Observe() error = %v", err)
+	}
+	if !obs.ResourceExists {
+		t.Error("Observe() ResourceExists = false, want true while an owned value is live")
+	}
+
+	mock.zone = []dnsclient.RecordSet{{Name: "www", Type: "A", TTL: 300, Values: []string{"198.51.100.1"}}}
+	obs, err = newTestExternal(mock).Observe(context.Background(), cr)
+	if err != nil {
+		t.Fatalf("Observe() error = %v", err)
+	}
+	if obs.ResourceExists {
+		t.Error("Observe() ResourceExists = true, want false once the owned values are removed")
+	}
+}
+
+func TestExternalDelete(t *testing.T) {
+	mock := &MockDNSClient{zone: []dnsclient.RecordSet{
+		{Name: "www", Type: "A", TTL: 300, Values: []string{"192.0.2.1", "198.51.100.1"}},
+	}}
+	cr := newTestRecord(v1beta1.RecordTypeA, "192.0.2.1")
+	cr.Status.AtProvider.ManagedValues = []string{"192.0.2.1"}
+
+	if _, err := newTestExternal(mock).Delete(context.Background(), cr); err != nil {
+		t.Fatalf("Delete() error = %v", err)
+	}
+	want := [][]dnsclient.RecordSet{{{Name: "www", Type: "A", TTL: 300, Values: []string{"198.51.100.1"}}}}
+	if !reflect.DeepEqual(mock.updates, want) || len(mock.deletes) != 0 {
+		t.Errorf("updates = %+v, deletes = %+v, want only the owned value removed", mock.updates, mock.deletes)
+	}
+
+	mock = &MockDNSClient{zone: []dnsclient.RecordSet{
+		{Name: "www", Type: "A", TTL: 300, Values: []string{"192.0.2.1"}},
+	}}
+	if _, err := newTestExternal(mock).Delete(context.Background(), cr); err != nil {
+		t.Fatalf("Delete() error = %v", err)
+	}
+	if want := [][]dnsclient.Filter{{{Name: "www", Type: "A"}}}; !reflect.DeepEqual(mock.deletes, want) {
+		t.Errorf("deletes = %+v, want %+v", mock.deletes, want)
+	}
+
+	if _, err := newTestExternal(&MockDNSClient{}).Delete(context.Background(), cr); err != nil {
+		t.Errorf("Delete() error = %v, want nil for a missing zone", err)
+	}
+}
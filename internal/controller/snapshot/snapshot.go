@@ -0,0 +1,345 @@
+/*
+Copyright 2025 Ross Golder.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package snapshot
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"k8s.io/client-go/util/workqueue"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/event"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+
+	v1beta1 "github.com/rossigee/provider-hostinger/apis/snapshot/v1beta1"
+	providerv1beta1 "github.com/rossigee/provider-hostinger/apis/v1beta1"
+	"github.com/rossigee/provider-hostinger/internal/clients"
+	snapshotclient "github.com/rossigee/provider-hostinger/internal/clients/snapshot"
+)
+
+const (
+	errNotSnapshot = "managed resource is not a Snapshot custom resource"
+	errGetPC       = "cannot get ProviderConfig"
+	errNewClient   = "cannot create new Hostinger client"
+
+	errNoInstanceID    = "instanceId is not set or could not be resolved"
+	errExternalName    = "cannot parse external name"
+	errSnapshotExists  = "instance %s already has a snapshot; set overwritePolicy to Always to replace it"
+	errGetSnapshot     = "cannot get snapshot"
+	errGetAction       = "cannot get snapshot action"
+	errCreateSnapshot  = "failed to create snapshot"
+	errRestoreSnapshot = "failed to restore snapshot"
+	errDeleteSnapshot  = "failed to delete snapshot"
+)
+
+// Setup adds a controller that reconciles Snapshot managed resources.
+func Setup(mgr ctrl.Manager, l logging.Logger, wl workqueue.TypedRateLimiter[any]) error {
+	name := managed.ControllerName(v1beta1.SnapshotGroupKind)
+
+	o := controller.Options{
+		MaxConcurrentReconciles: 5,
+	}
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(v1beta1.SnapshotGroupVersionKind),
+		managed.WithExternalConnecter(&connector{
+			kube:        mgr.GetClient(),
+			newClientFn: clients.NewClientFactory,
+		}),
+		managed.WithLogger(l.WithValues("controller", name)),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		managed.WithPollInterval(5*time.Minute),
+		managed.WithInitializers(),
+	)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o).
+		For(&v1beta1.Snapshot{}).
+		Complete(r)
+}
+
+// A connector is expected to produce typed ExternalClient for the managed
+// resource it is supposed to manage.
+type connector struct {
+	kube        client.Client
+	newClientFn func(client.Client, clients.HTTPClientConfig) *clients.ClientFactory
+}
+
+// Connect produces an ExternalClient using the credentials of the
+// ProviderConfig referenced by the Snapshot.
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1beta1.Snapshot)
+	if !ok {
+		return nil, errors.New(errNotSnapshot)
+	}
+
+	pc := &providerv1beta1.ProviderConfig{}
+	if err := c.kube.Get(ctx, client.ObjectKey{Namespace: cr.GetNamespace(), Name: cr.Spec.ProviderConfigReference.Name}, pc); err != nil {
+		return nil, errors.Wrap(err, errGetPC)
+	}
+
+	clientFactory := c.newClientFn(c.kube, clients.DefaultHTTPClientConfig())
+
+	hc, err := clientFactory.CreateHostingerClient(ctx, pc)
+	if err != nil {
+		return nil, errors.Wrap(err, errNewClient)
+	}
+
+	return &external{client: snapshotclient.NewSnapshotClient(hc)}, nil
+}
+
+// An ExternalClient observes, then either creates, updates, or deletes an
+// external resource to ensure it reflects the managed resource's desired state.
+type external struct {
+	client snapshotclient.Client
+}
+
+func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1beta1.Snapshot)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotSnapshot)
+	}
+
+	// The external name is the ID of the instance the snapshot belongs to,
+	// followed by the ID of the creation action while it is running
+	if meta.GetExternalName(cr) == "" {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+	instanceID, createActionID, err := parseExternalName(meta.GetExternalName(cr))
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errExternalName)
+	}
+
+	// Creating or adopting a snapshot must not restore it, so the first
+	// restoreGeneration seen is taken as already restored
+	if cr.Status.AtProvider.RestoredGeneration == nil {
+		gen := restoreGeneration(cr)
+		cr.Status.AtProvider.RestoredGeneration = &gen
+	}
+
+	// The snapshot only appears once the creation action has finished
+	lateInitialized := false
+	if createActionID != nil {
+		action, err := e.client.GetAction(ctx, instanceID, *createActionID)
+		if err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errGetAction)
+		}
+		if !action.IsDone() {
+			cr.Status.AtProvider.CreateActionID = createActionID
+			cr.SetConditions(xpv1.Creating())
+			return managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true}, nil
+		}
+
+		// A failed creation is retried by Create, which sets a new external
+		// name; otherwise the action ID is dropped from it
+		meta.SetExternalName(cr, instanceID)
+		if action.State == clients.ActionStateError {
+			return managed.ExternalObservation{ResourceExists: false}, nil
+		}
+		lateInitialized = true
+	}
+
+	snapshot, err := e.client.Get(ctx, instanceID)
+	if err != nil {
+		if clients.IsNotFound(err) {
+			return managed.ExternalObservation{ResourceExists: false}, nil
+		}
+		return managed.ExternalObservation{}, errors.Wrap(err, errGetSnapshot)
+	}
+
+	current := cr.Status.AtProvider
+	cr.Status.AtProvider = *e.client.GetObservation(snapshot)
+	cr.Status.AtProvider.RestoreActionID = current.RestoreActionID
+	cr.Status.AtProvider.RestoredGeneration = current.RestoredGeneration
+	cr.Status.AtProvider.LastRestoreState = current.LastRestoreState
+	cr.SetConditions(xpv1.Available())
+
+	// Persisting the external name discards the status set above, which is
+	// observed again right after
+	if lateInitialized {
+		return managed.ExternalObservation{
+			ResourceExists:          true,
+			ResourceUpToDate:        true,
+			ResourceLateInitialized: true,
+		}, nil
+	}
+
+	// Don't start another restore while one is still running
+	if err := e.observeRestore(ctx, cr, instanceID); err != nil {
+		return managed.ExternalObservation{}, err
+	}
+	if cr.Status.AtProvider.RestoreActionID != nil {
+		return managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true}, nil
+	}
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: !restoreRequested(cr),
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1beta1.Snapshot)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotSnapshot)
+	}
+
+	instanceID := cr.Spec.ForProvider.InstanceID
+	if instanceID == nil || *instanceID == "" {
+		return managed.ExternalCreation{}, errors.New(errNoInstanceID)
+	}
+
+	// Hostinger keeps one snapshot per instance, so creating a snapshot
+	// silently replaces whatever was there before
+	if !overwriteAllowed(cr) {
+		_, err := e.client.Get(ctx, *instanceID)
+		if err == nil {
+			return managed.ExternalCreation{}, errors.Errorf(errSnapshotExists, *instanceID)
+		}
+		if !clients.IsNotFound(err) {
+			return managed.ExternalCreation{}, errors.Wrap(err, errGetSnapshot)
+		}
+	}
+
+	action, err := e.client.Create(ctx, *instanceID)
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreateSnapshot)
+	}
+
+	// Status set here is not persisted, so the creation action is tracked
+	// in the external name instead
+	meta.SetExternalName(cr, *instanceID+"/"+strconv.FormatInt(action.ID, 10))
+
+	return managed.ExternalCreation{}, nil
+}
+
+func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1beta1.Snapshot)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotSnapshot)
+	}
+
+	if !restoreRequested(cr) {
+		return managed.ExternalUpdate{}, nil
+	}
+
+	instanceID, _, err := parseExternalName(meta.GetExternalName(cr))
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errExternalName)
+	}
+
+	action, err := e.client.Restore(ctx, instanceID)
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errRestoreSnapshot)
+	}
+
+	gen := restoreGeneration(cr)
+	cr.Status.AtProvider.RestoreActionID = &action.ID
+	cr.Status.AtProvider.RestoredGeneration = &gen
+	cr.Status.AtProvider.LastRestoreState = action.State
+	return managed.ExternalUpdate{}, nil
+}
+
+func (e *external) Delete(ctx context.Context, mg resource.Managed) (managed.ExternalDelete, error) {
+	cr, ok := mg.(*v1beta1.Snapshot)
+	if !ok {
+		return managed.ExternalDelete{}, errors.New(errNotSnapshot)
+	}
+
+	if meta.GetExternalName(cr) == "" {
+		return managed.ExternalDelete{}, nil
+	}
+	instanceID, _, err := parseExternalName(meta.GetExternalName(cr))
+	if err != nil {
+		return managed.ExternalDelete{}, errors.Wrap(err, errExternalName)
+	}
+
+	err = e.client.Delete(ctx, instanceID)
+	if clients.IsNotFound(err) {
+		return managed.ExternalDelete{}, nil
+	}
+	return managed.ExternalDelete{}, errors.Wrap(err, errDeleteSnapshot)
+}
+
+// observeRestore records the outcome of a finished restore action.
+func (e *external) observeRestore(ctx context.Context, cr *v1beta1.Snapshot, instanceID string) error {
+	actionID := cr.Status.AtProvider.RestoreActionID
+	if actionID == nil {
+		return nil
+	}
+
+	action, err := e.client.GetAction(ctx, instanceID, *actionID)
+	if err != nil {
+		return errors.Wrap(err, errGetAction)
+	}
+	cr.Status.AtProvider.LastRestoreState = action.State
+	if action.IsDone() {
+		cr.Status.AtProvider.RestoreActionID = nil
+	}
+	return nil
+}
+
+// restoreRequested reports whether restoreGeneration has been increased past
+// the last restore.
+func restoreRequested(cr *v1beta1.Snapshot) bool {
+	restored := cr.Status.AtProvider.RestoredGeneration
+	return restored != nil && restoreGeneration(cr) > *restored
+}
+
+// restoreGeneration returns the requested restore generation, 0 if unset.
+func restoreGeneration(cr *v1beta1.Snapshot) int64 {
+	if gen := cr.Spec.ForProvider.RestoreGeneration; gen != nil {
+		return *gen
+	}
+	return 0
+}
+
+// parseExternalName splits an external name of the form <instance ID> or
+// <instance ID>/<creation action ID>.
+func parseExternalName(name string) (string, *int64, error) {
+	instanceID, action, found := strings.Cut(name, "/")
+	if !found {
+		return instanceID, nil, nil
+	}
+	actionID, err := strconv.ParseInt(action, 10, 64)
+	if err != nil || instanceID == "" {
+		return "", nil, errors.Errorf("external name %q is not <instance ID>/<action ID>", name)
+	}
+	return instanceID, &actionID, nil
+}
+
+// overwriteAllowed reports whether an existing snapshot may be replaced.
+func overwriteAllowed(cr *v1beta1.Snapshot) bool {
+	policy := cr.Spec.ForProvider.OverwritePolicy
+	return policy != nil && *policy == v1beta1.OverwriteAlways
+}
+
+// Disconnect closes the connection to the external service.
+func (e *external) Disconnect(ctx context.Context) error {
+	return nil
+}
@@ -0,0 +1,311 @@
+/*
+Copyright 2025 Ross Golder.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package snapshot
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+	xpfake "github.com/crossplane/crossplane-runtime/v2/pkg/resource/fake"
+
+	v1beta1 "github.com/rossigee/provider-hostinger/apis/snapshot/v1beta1"
+	"github.com/rossigee/provider-hostinger/internal/clients"
+	snapshotclient "github.com/rossigee/provider-hostinger/internal/clients/snapshot"
+)
+
+// MockSnapshotClient is a mock implementation of snapshotclient.Client
+type MockSnapshotClient struct {
+	snapshot     *snapshotclient.Snapshot
+	action       *clients.Action
+	createCalls  int
+	restoreCalls int
+}
+
+func (m *MockSnapshotClient) Get(ctx context.Context, instanceID string) (*snapshotclient.Snapshot, error) {
+	if m.snapshot == nil {
+		return nil, clients.ClassifyError(http.StatusNotFound, "snapshot not found")
+	}
+	return m.snapshot, nil
+}
+
+func (m *MockSnapshotClient) Create(ctx context.Context, instanceID string) (*clients.Action, error) {
+	m.createCalls++
+	return &clients.Action{ID: 51, State: clients.ActionStateInitiated}, nil
+}
+
+func (m *MockSnapshotClient) Restore(ctx context.Context, instanceID string) (*clients.Action, error) {
+	m.restoreCalls++
+	return &clients.Action{ID: 52, State: clients.ActionStateInitiated}, nil
+}
+
+func (m *MockSnapshotClient) Delete(ctx context.Context, instanceID string) error {
+	return nil
+}
+
+func (m *MockSnapshotClient) GetAction(ctx context.Context, instanceID string, actionID int64) (*clients.Action, error) {
+	return m.action, nil
+}
+
+func (m *MockSnapshotClient) GetObservation(snapshot *snapshotclient.Snapshot) *v1beta1.SnapshotObservation {
+	return &v1beta1.SnapshotObservation{ID: snapshot.ID}
+}
+
+func newTestSnapshot() *v1beta1.Snapshot {
+	instanceID := "123"
+	cr := &v1beta1.Snapshot{}
+	cr.Spec.ForProvider.InstanceID = &instanceID
+	return cr
+}
+
+func TestExternalCreate_RefusesOverwrite(t *testing.T) {
+	mock := &MockSnapshotClient{snapshot: &snapshotclient.Snapshot{ID: "9"}}
+	ext := &external{client: mock}
+
+	if _, err := ext.Create(context.Background(), newTestSnapshot()); err == nil {
+		t.Fatal("Create() error = nil, want error for an existing snapshot")
+	}
+	if mock.createCalls != 0 {
+		t.Error("Create should not replace an existing snapshot by default")
+	}
+}
+
+func TestExternalCreate_OverwriteAllowed(t *testing.T) {
+	mock := &MockSnapshotClient{snapshot: &snapshotclient.Snapshot{ID: "9"}}
+	ext := &external{client: mock}
+	cr := newTestSnapshot()
+	policy := v1beta1.OverwriteAlways
+	cr.Spec.ForProvider.OverwritePolicy = &policy
+
+	if _, err := ext.Create(context.Background(), cr); err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+	if mock.createCalls != 1 {
+		t.Error("Create should replace the snapshot when overwritePolicy is Always")
+	}
+	if meta.GetExternalName(cr) != "123/51" {
+		t.Errorf("external name = %q, want the instance ID and creation action ID", meta.GetExternalName(cr))
+	}
+}
+
+func TestExternalObserve_Creating(t *testing.T) {
+	mock := &MockSnapshotClient{action: &clients.Action{ID: 51, State: clients.ActionStateSent}}
+	ext := &external{client: mock}
+	cr := newTestSnapshot()
+	meta.SetExternalName(cr, "123/51")
+
+	obs, err := ext.Observe(context.Background(), cr)
+	if err != nil {
+		t.Fatalf("Observe() error = %v", err)
+	}
+	if !obs.ResourceExists {
+		t.Error("Observe() should report a snapshot being created as existing")
+	}
+	if cr.Status.AtProvider.CreateActionID == nil || *cr.Status.AtProvider.CreateActionID != 51 {
+		t.Errorf("CreateActionID = %v, want 51", cr.Status.AtProvider.CreateActionID)
+	}
+}
+
+func TestExternalObserve_Created(t *testing.T) {
+	mock := &MockSnapshotClient{
+		snapshot: &snapshotclient.Snapshot{ID: "9"},
+		action:   &clients.Action{ID: 51, State: clients.ActionStateSuccess},
+	}
+	ext := &external{client: mock}
+	cr := newTestSnapshot()
+	meta.SetExternalName(cr, "123/51")
+
+	obs, err := ext.Observe(context.Background(), cr)
+	if err != nil {
+		t.Fatalf("Observe() error = %v", err)
+	}
+	if !obs.ResourceExists || !obs.ResourceLateInitialized {
+		t.Errorf("Observe() = %+v, want an existing snapshot and the external name persisted", obs)
+	}
+	if meta.GetExternalName(cr) != "123" {
+		t.Errorf("external name = %q, want the instance ID", meta.GetExternalName(cr))
+	}
+}
+
+func TestExternalObserve_CreateFailed(t *testing.T) {
+	mock := &MockSnapshotClient{action: &clients.Action{ID: 51, State: clients.ActionStateError}}
+	ext := &external{client: mock}
+	cr := newTestSnapshot()
+	meta.SetExternalName(cr, "123/51")
+
+	obs, err := ext.Observe(context.Background(), cr)
+	if err != nil {
+		t.Fatalf("Observe() error = %v", err)
+	}
+	if obs.ResourceExists {
+		t.Error("Observe() should report a failed creation as not existing so that it is retried")
+	}
+}
+
+func TestExternalObserve_RestoreBaseline(t *testing.T) {
+	mock := &MockSnapshotClient{snapshot: &snapshotclient.Snapshot{ID: "9"}}
+	ext := &external{client: mock}
+	cr := newTestSnapshot()
+	meta.SetExternalName(cr, "123")
+	gen := int64(3)
+	cr.Spec.ForProvider.RestoreGeneration = &gen
+
+	obs, err := ext.Observe(context.Background(), cr)
+	if err != nil {
+		t.Fatalf("Observe() error = %v", err)
+	}
+	if !obs.ResourceUpToDate {
+		t.Error("Observe() should not restore an adopted snapshot")
+	}
+	if got := cr.Status.AtProvider.RestoredGeneration; got == nil || *got != 3 {
+		t.Errorf("RestoredGeneration = %v, want 3", got)
+	}
+}
+
+func TestExternalRestoreGeneration(t *testing.T) {
+	mock := &MockSnapshotClient{snapshot: &snapshotclient.Snapshot{ID: "9"}}
+	ext := &external{client: mock}
+	cr := newTestSnapshot()
+	meta.SetExternalName(cr, "123")
+	gen, restored := int64(2), int64(1)
+	cr.Spec.ForProvider.RestoreGeneration = &gen
+	cr.Status.AtProvider.RestoredGeneration = &restored
+
+	obs, err := ext.Observe(context.Background(), cr)
+	if err != nil {
+		t.Fatalf("Observe() error = %v", err)
+	}
+	if obs.ResourceUpToDate {
+		t.Fatal("Observe() should request a restore when restoreGeneration increases")
+	}
+
+	if _, err := ext.Update(context.Background(), cr); err != nil {
+		t.Fatalf("Update() error = %v", err)
+	}
+	if mock.restoreCalls != 1 {
+		t.Error("Update should restore the snapshot")
+	}
+	if got := cr.Status.AtProvider.RestoredGeneration; got == nil || *got != 2 || cr.Status.AtProvider.RestoreActionID == nil {
+		t.Errorf("status = %+v, want restored generation 2 and a restore action", cr.Status.AtProvider)
+	}
+
+	mock.action = &clients.Action{ID: 52, State: clients.ActionStateSuccess}
+	obs, err = ext.Observe(context.Background(), cr)
+	if err != nil {
+		t.Fatalf("Observe() error = %v", err)
+	}
+	if !obs.ResourceUpToDate || cr.Status.AtProvider.RestoreActionID != nil {
+		t.Errorf("Observe() = %+v, status = %+v, want restore complete", obs, cr.Status.AtProvider)
+	}
+	if cr.Status.AtProvider.LastRestoreState != clients.ActionStateSuccess {
+		t.Errorf("LastRestoreState = %q, want success", cr.Status.AtProvider.LastRestoreState)
+	}
+}
+
+// TestReconcile runs the managed reconciler against a fake API server, which
+// drops status set in Create just like a real one.
+func TestReconcile(t *testing.T) {
+	s := runtime.NewScheme()
+	_ = v1beta1.SchemeBuilder.AddToScheme(s)
+
+	gen := int64(3)
+	cr := newTestSnapshot()
+	cr.ObjectMeta = metav1.ObjectMeta{Name: "snap", Namespace: "default"}
+	cr.Spec.ForProvider.RestoreGeneration = &gen
+	kube := fake.NewClientBuilder().WithScheme(s).WithObjects(cr).WithStatusSubresource(cr).Build()
+
+	mock := &MockSnapshotClient{}
+	r := managed.NewReconciler(&xpfake.Manager{Client: kube, Scheme: s},
+		resource.ManagedKind(v1beta1.SnapshotGroupVersionKind),
+		managed.WithExternalConnector(managed.ExternalConnectorFn(func(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+			return &external{client: mock}, nil
+		})),
+		managed.WithInitializers(),
+	)
+
+	req := reconcile.Request{NamespacedName: types.NamespacedName{Name: "snap", Namespace: "default"}}
+	reconcileAndGet := func() *v1beta1.Snapshot {
+		t.Helper()
+		if _, err := r.Reconcile(context.Background(), req); err != nil {
+			t.Fatalf("Reconcile() error = %v", err)
+		}
+		got := &v1beta1.Snapshot{}
+		if err := kube.Get(context.Background(), req.NamespacedName, got); err != nil {
+			t.Fatalf("Get() error = %v", err)
+		}
+		return got
+	}
+
+	// The snapshot is taken, and the creation action kept in the external name
+	got := reconcileAndGet()
+	if meta.GetExternalName(got) != "123/51" {
+		t.Fatalf("external name = %q, want 123/51", meta.GetExternalName(got))
+	}
+
+	// While the action runs the snapshot is not taken again
+	mock.action = &clients.Action{ID: 51, State: clients.ActionStateSent}
+	got = reconcileAndGet()
+	if mock.createCalls != 1 {
+		t.Errorf("Create called %d times while the action runs, want 1", mock.createCalls)
+	}
+	if r := got.Status.AtProvider.RestoredGeneration; r == nil || *r != 3 {
+		t.Errorf("RestoredGeneration = %v, want the baseline 3", r)
+	}
+
+	// Once it has finished the snapshot is adopted without being restored
+	mock.action = &clients.Action{ID: 51, State: clients.ActionStateSuccess}
+	mock.snapshot = &snapshotclient.Snapshot{ID: "9"}
+	for range 2 {
+		got = reconcileAndGet()
+	}
+	if meta.GetExternalName(got) != "123" {
+		t.Errorf("external name = %q, want 123", meta.GetExternalName(got))
+	}
+	if mock.createCalls != 1 || mock.restoreCalls != 0 {
+		t.Errorf("Create called %d times and Restore %d times, want 1 and 0", mock.createCalls, mock.restoreCalls)
+	}
+
+	// Increasing restoreGeneration restores the snapshot once
+	gen = 4
+	got.Spec.ForProvider.RestoreGeneration = &gen
+	if err := kube.Update(context.Background(), got); err != nil {
+		t.Fatalf("Update() error = %v", err)
+	}
+	mock.action = &clients.Action{ID: 52, State: clients.ActionStateSent}
+	for range 2 {
+		got = reconcileAndGet()
+	}
+	if mock.restoreCalls != 1 {
+		t.Errorf("Restore called %d times, want 1", mock.restoreCalls)
+	}
+	if r := got.Status.AtProvider.RestoredGeneration; r == nil || *r != 4 {
+		t.Errorf("RestoredGeneration = %v, want 4", r)
+	}
+	if c := got.GetCondition(xpv1.TypeSynced); c.Reason != xpv1.ReasonReconcileSuccess {
+		t.Errorf("Synced condition = %+v, want ReconcileSuccess", c)
+	}
+}
@@ -0,0 +1,519 @@
+/*
+Copyright 2025 Ross Golder.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sshkey
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/workqueue"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/event"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+
+	instancev1beta1 "github.com/rossigee/provider-hostinger/apis/instance/v1beta1"
+	v1beta1 "github.com/rossigee/provider-hostinger/apis/sshkey/v1beta1"
+	providerv1beta1 "github.com/rossigee/provider-hostinger/apis/v1beta1"
+	"github.com/rossigee/provider-hostinger/internal/clients"
+	sshkeyclient "github.com/rossigee/provider-hostinger/internal/clients/sshkey"
+)
+
+const (
+	errNotSSHKey = "managed resource is not a SSHKey custom resource"
+	errGetPC     = "cannot get ProviderConfig"
+	errNewClient = "cannot create new Hostinger client"
+
+	errGetPublicKeySecret  = "cannot get public key secret"
+	errEmptyPublicKey      = "public key secret is empty"
+	errInvalidPublicKey    = "invalid public key"
+	errNoConnectionSecret  = "writeConnectionSecretToRef must be set to generate a key"
+	errGetConnectionSecret = "cannot get connection secret"
+	errGenerate            = "failed to generate SSH key"
+	errParseUploadedKey    = "cannot parse uploaded SSH key"
+	errGet                 = "failed to get SSH key"
+	errCreate              = "failed to upload SSH key"
+	errDeleteReplaced      = "failed to delete replaced SSH key"
+	errAttachedKeys        = "failed to list SSH keys attached to instance"
+	errAttach              = "failed to attach SSH key to instance"
+	errDelete              = "failed to delete SSH key"
+	errInstanceSelector    = "invalid instanceSelector"
+	errListInstances       = "cannot list instances matching instanceSelector"
+)
+
+// Setup adds a controller that reconciles SSHKey managed resources.
+func Setup(mgr ctrl.Manager, l logging.Logger, wl workqueue.TypedRateLimiter[any]) error {
+	name := managed.ControllerName(v1beta1.SSHKeyGroupKind)
+
+	o := controller.Options{
+		MaxConcurrentReconciles: 5,
+	}
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(v1beta1.SSHKeyGroupVersionKind),
+		managed.WithExternalConnecter(&connector{
+			kube:        mgr.GetClient(),
+			newClientFn: clients.NewClientFactory,
+		}),
+		managed.WithLogger(l.WithValues("controller", name)),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		managed.WithPollInterval(5*time.Minute),
+		managed.WithInitializers(),
+	)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o).
+		For(&v1beta1.SSHKey{}).
+		Watches(&instancev1beta1.Instance{}, handler.EnqueueRequestsFromMapFunc(selectingKeys(mgr.GetClient()))).
+		Complete(r)
+}
+
+// selectingKeys maps an Instance to the SSHKeys in its namespace that select
+// instances by label, so that new instances are attached without waiting
+// for the next poll.
+func selectingKeys(kube client.Client) handler.MapFunc {
+	return func(ctx context.Context, obj client.Object) []reconcile.Request {
+		keys := &v1beta1.SSHKeyList{}
+		if err := kube.List(ctx, keys, client.InNamespace(obj.GetNamespace())); err != nil {
+			return nil
+		}
+		requests := []reconcile.Request{}
+		for _, k := range keys.Items {
+			if k.Spec.ForProvider.InstanceSelector != nil {
+				requests = append(requests, reconcile.Request{NamespacedName: types.NamespacedName{Namespace: k.GetNamespace(), Name: k.GetName()}})
+			}
+		}
+		return requests
+	}
+}
+
+// A connector is expected to produce typed ExternalClient for the managed
+// resource it is supposed to manage.
+type connector struct {
+	kube        client.Client
+	newClientFn func(client.Client, clients.HTTPClientConfig) *clients.ClientFactory
+}
+
+// Connect produces an ExternalClient using the credentials of the
+// ProviderConfig referenced by the SSHKey.
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1beta1.SSHKey)
+	if !ok {
+		return nil, errors.New(errNotSSHKey)
+	}
+
+	pc := &providerv1beta1.ProviderConfig{}
+	if err := c.kube.Get(ctx, client.ObjectKey{Namespace: cr.GetNamespace(), Name: cr.Spec.ProviderConfigReference.Name}, pc); err != nil {
+		return nil, errors.Wrap(err, errGetPC)
+	}
+
+	clientFactory := c.newClientFn(c.kube, clients.DefaultHTTPClientConfig())
+
+	hc, err := clientFactory.CreateHostingerClient(ctx, pc)
+	if err != nil {
+		return nil, errors.Wrap(err, errNewClient)
+	}
+
+	return &external{kube: c.kube, client: sshkeyclient.NewSSHKeyClient(hc)}, nil
+}
+
+// An ExternalClient observes, then either creates, updates, or deletes an
+// external resource to ensure it reflects the managed resource's desired state.
+type external struct {
+	kube   client.Client
+	client sshkeyclient.Client
+}
+
+// Observe compares the uploaded key with the desired key. Hostinger keys
+// cannot be changed, so a different key is reported as out of date and
+// Update uploads a replacement.
+func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1beta1.SSHKey)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotSSHKey)
+	}
+
+	// The external name is the ID of the Hostinger public key
+	externalName := meta.GetExternalName(cr)
+	if externalName == "" {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	// A replacement uploaded by Update becomes the external name; the
+	// external name cannot be changed by Update itself
+	obs := &cr.Status.AtProvider
+	if obs.ReplacementID != "" && !meta.WasDeleted(cr) {
+		if obs.ReplacementID != externalName {
+			meta.SetExternalName(cr, obs.ReplacementID)
+			return managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true, ResourceLateInitialized: true}, nil
+		}
+		obs.ReplacementID = ""
+	}
+
+	// Keys left over from a replacement are deleted with the SSHKey
+	if meta.WasDeleted(cr) && (obs.ReplacementID != "" || obs.ReplacedID != "") {
+		return managed.ExternalObservation{ResourceExists: true}, nil
+	}
+
+	key, err := e.client.Get(ctx, externalName)
+	if err != nil {
+		if clients.IsNotFound(err) {
+			return managed.ExternalObservation{ResourceExists: false}, nil
+		}
+		return managed.ExternalObservation{}, errors.Wrap(err, errGet)
+	}
+
+	// The desired key is not needed to delete the uploaded one, and its
+	// secret may already be gone
+	if meta.WasDeleted(cr) {
+		return managed.ExternalObservation{ResourceExists: true}, nil
+	}
+
+	desired, replace, err := e.replacementNeeded(ctx, cr, key)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+	if replace {
+		cr.SetConditions(xpv1.Available().WithMessage("key changed and will be replaced"))
+		return managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false}, nil
+	}
+
+	wanted, err := e.wantedInstances(ctx, cr)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+	attached, err := e.attachedInstances(ctx, wanted, key.ID)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+
+	// Instances that were attached but are no longer wanted keep the key,
+	// as the Hostinger API cannot detach it
+	previous := []string{}
+	for _, instanceID := range append(obs.AttachedInstances, obs.UnmatchedInstances...) {
+		if !contains(wanted, instanceID) && !contains(previous, instanceID) {
+			previous = append(previous, instanceID)
+		}
+	}
+	unmatched, err := e.attachedInstances(ctx, previous, key.ID)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+
+	obs.ID = key.ID
+	obs.Fingerprint = desired.Fingerprint
+	obs.PublicKeyHash = desired.Hash
+	obs.KeyType = desired.Type
+	obs.Bits = int32(desired.Bits)
+	obs.Comment = desired.Comment
+	obs.AttachedInstances = attached
+	obs.UnmatchedInstances = unmatched
+
+	condition := xpv1.Available()
+	if len(unmatched) > 0 {
+		condition = condition.WithMessage("key is still attached to unmatched instances " + strings.Join(unmatched, ", ") + " and must be removed from them manually")
+	}
+	cr.SetConditions(condition)
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: len(attached) == len(wanted) && obs.ReplacedID == "",
+	}, nil
+}
+
+// Create uploads the key, generating it first if requested. Instances are
+// attached by Update.
+func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1beta1.SSHKey)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotSSHKey)
+	}
+
+	key, details, err := e.upload(ctx, cr)
+	if err != nil {
+		return managed.ExternalCreation{}, err
+	}
+
+	meta.SetExternalName(cr, key.ID)
+	return managed.ExternalCreation{ConnectionDetails: details}, nil
+}
+
+// Update uploads a replacement for a changed key, deletes the key it
+// replaced once the external name points to the replacement, and attaches
+// the key to the instances it is not attached to yet.
+func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1beta1.SSHKey)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotSSHKey)
+	}
+
+	keyID := meta.GetExternalName(cr)
+	key, err := e.client.Get(ctx, keyID)
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errGet)
+	}
+	_, replace, err := e.replacementNeeded(ctx, cr, key)
+	if err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+	if replace {
+		// The replaced key is deleted once Observe has switched the
+		// external name to the replacement
+		replacement, details, err := e.upload(ctx, cr)
+		if err != nil {
+			return managed.ExternalUpdate{}, err
+		}
+		cr.Status.AtProvider.ReplacementID = replacement.ID
+		cr.Status.AtProvider.ReplacedID = keyID
+		return managed.ExternalUpdate{ConnectionDetails: details}, nil
+	}
+
+	if replaced := cr.Status.AtProvider.ReplacedID; replaced != "" {
+		if err := e.client.Delete(ctx, replaced); err != nil && !clients.IsNotFound(err) {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errDeleteReplaced)
+		}
+		cr.Status.AtProvider.ReplacedID = ""
+	}
+
+	wanted, err := e.wantedInstances(ctx, cr)
+	if err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+	attached, err := e.attachedInstances(ctx, wanted, keyID)
+	if err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+
+	for _, instanceID := range wanted {
+		if contains(attached, instanceID) {
+			continue
+		}
+		if _, err := e.client.Attach(ctx, keyID, instanceID); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrapf(err, "%s %s", errAttach, instanceID)
+		}
+	}
+
+	return managed.ExternalUpdate{}, nil
+}
+
+// Delete deletes the key, together with any replacement or replaced key
+// that is still recorded in status.
+func (e *external) Delete(ctx context.Context, mg resource.Managed) (managed.ExternalDelete, error) {
+	cr, ok := mg.(*v1beta1.SSHKey)
+	if !ok {
+		return managed.ExternalDelete{}, errors.New(errNotSSHKey)
+	}
+
+	obs := &cr.Status.AtProvider
+	for _, keyID := range []*string{&obs.ReplacementID, &obs.ReplacedID} {
+		if *keyID == "" {
+			continue
+		}
+		if err := e.client.Delete(ctx, *keyID); err != nil && !clients.IsNotFound(err) {
+			return managed.ExternalDelete{}, errors.Wrap(err, errDelete)
+		}
+		*keyID = ""
+	}
+
+	if err := e.client.Delete(ctx, meta.GetExternalName(cr)); err != nil && !clients.IsNotFound(err) {
+		return managed.ExternalDelete{}, errors.Wrap(err, errDelete)
+	}
+
+	return managed.ExternalDelete{}, nil
+}
+
+// upload uploads the key, generating it first if requested. Generated keys
+// are returned as connection details.
+func (e *external) upload(ctx context.Context, cr *v1beta1.SSHKey) (*sshkeyclient.Key, managed.ConnectionDetails, error) {
+	var publicKey string
+	var details managed.ConnectionDetails
+	if g := cr.Spec.ForProvider.Generate; g != nil {
+		if cr.GetWriteConnectionSecretToReference() == nil {
+			return nil, nil, errors.New(errNoConnectionSecret)
+		}
+		bits := 0
+		if g.Bits != nil {
+			bits = int(*g.Bits)
+		}
+		kp, err := sshkeyclient.Generate(string(g.Type), bits, cr.Spec.ForProvider.Name)
+		if err != nil {
+			return nil, nil, errors.Wrap(err, errGenerate)
+		}
+		publicKey = kp.PublicKey
+		details = managed.ConnectionDetails{
+			sshkeyclient.ConnectionKeyPrivateKey:  kp.PrivateKey,
+			sshkeyclient.ConnectionKeyPublicKey:   []byte(kp.PublicKey),
+			sshkeyclient.ConnectionKeyFingerprint: []byte(kp.Fingerprint),
+		}
+	} else {
+		pk, err := e.secretPublicKey(ctx, cr)
+		if err != nil {
+			return nil, nil, err
+		}
+		publicKey = pk.AuthorizedKey
+	}
+
+	key, err := e.client.Create(ctx, cr.Spec.ForProvider.Name, publicKey)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, errCreate)
+	}
+	return key, details, nil
+}
+
+// replacementNeeded returns the desired key and whether the uploaded key
+// must be replaced: because it was rotated, or because the private key of a
+// generated key was lost.
+func (e *external) replacementNeeded(ctx context.Context, cr *v1beta1.SSHKey, key *sshkeyclient.Key) (*sshkeyclient.PublicKey, bool, error) {
+	desired, err := e.desiredPublicKey(ctx, cr)
+	if err != nil {
+		return nil, false, err
+	}
+	if desired == nil {
+		return nil, true, nil
+	}
+
+	// A rotated key has a different hash from the uploaded key
+	hash, err := sshkeyclient.PublicKeyHash(key.Key)
+	if err != nil {
+		return nil, false, errors.Wrap(err, errParseUploadedKey)
+	}
+	return desired, hash != desired.Hash, nil
+}
+
+// desiredPublicKey returns the key that should be uploaded. For generated
+// keys this is the public key in the connection secret, or nil if the
+// private key is no longer there.
+func (e *external) desiredPublicKey(ctx context.Context, cr *v1beta1.SSHKey) (*sshkeyclient.PublicKey, error) {
+	if cr.Spec.ForProvider.Generate == nil {
+		return e.secretPublicKey(ctx, cr)
+	}
+
+	ref := cr.GetWriteConnectionSecretToReference()
+	if ref == nil {
+		return nil, errors.New(errNoConnectionSecret)
+	}
+	data, err := clients.GetSecretData(ctx, e.kube, xpv1.SecretReference{Name: ref.Name, Namespace: ref.Namespace}, cr.GetNamespace())
+	if err != nil {
+		if kerrors.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, errors.Wrap(err, errGetConnectionSecret)
+	}
+	if len(data[sshkeyclient.ConnectionKeyPrivateKey]) == 0 {
+		return nil, nil
+	}
+	pk, err := sshkeyclient.ParsePublicKey(string(data[sshkeyclient.ConnectionKeyPublicKey]))
+	return pk, errors.Wrap(err, errInvalidPublicKey)
+}
+
+// secretPublicKey reads and validates the public key in the referenced
+// secret
+func (e *external) secretPublicKey(ctx context.Context, cr *v1beta1.SSHKey) (*sshkeyclient.PublicKey, error) {
+	ref := cr.Spec.ForProvider.PublicKeySecretRef
+	if ref == nil {
+		return nil, errors.New(errEmptyPublicKey)
+	}
+
+	value, err := clients.GetSecretKey(ctx, e.kube, *ref, cr.GetNamespace())
+	if err != nil {
+		return nil, errors.Wrap(err, errGetPublicKeySecret)
+	}
+	if strings.TrimSpace(value) == "" {
+		return nil, errors.New(errEmptyPublicKey)
+	}
+	pk, err := sshkeyclient.ParsePublicKey(value)
+	return pk, errors.Wrap(err, errInvalidPublicKey)
+}
+
+// wantedInstances returns the instance IDs listed in the spec followed by
+// the IDs of the Instances matched by the selector
+func (e *external) wantedInstances(ctx context.Context, cr *v1beta1.SSHKey) ([]string, error) {
+	wanted := []string{}
+	for _, instanceID := range cr.Spec.ForProvider.InstanceIDs {
+		if !contains(wanted, instanceID) {
+			wanted = append(wanted, instanceID)
+		}
+	}
+	if cr.Spec.ForProvider.InstanceSelector == nil {
+		return wanted, nil
+	}
+
+	selector, err := metav1.LabelSelectorAsSelector(cr.Spec.ForProvider.InstanceSelector)
+	if err != nil {
+		return nil, errors.Wrap(err, errInstanceSelector)
+	}
+	instances := &instancev1beta1.InstanceList{}
+	if err := e.kube.List(ctx, instances, client.InNamespace(cr.GetNamespace()), client.MatchingLabelsSelector{Selector: selector}); err != nil {
+		return nil, errors.Wrap(err, errListInstances)
+	}
+	selected := []string{}
+	for i := range instances.Items {
+		// Instances that have not been created yet have no ID
+		instanceID := meta.GetExternalName(&instances.Items[i])
+		if instanceID != "" && !contains(wanted, instanceID) && !contains(selected, instanceID) {
+			selected = append(selected, instanceID)
+		}
+	}
+	sort.Strings(selected)
+	return append(wanted, selected...), nil
+}
+
+// attachedInstances returns the instances, out of instanceIDs, that the key
+// is attached to
+func (e *external) attachedInstances(ctx context.Context, instanceIDs []string, keyID string) ([]string, error) {
+	attached := []string{}
+	for _, instanceID := range instanceIDs {
+		keys, err := e.client.AttachedKeys(ctx, instanceID)
+		if err != nil {
+			return nil, errors.Wrapf(err, "%s %s", errAttachedKeys, instanceID)
+		}
+		if contains(keys, keyID) {
+			attached = append(attached, instanceID)
+		}
+	}
+	return attached, nil
+}
+
+// contains returns true if s contains v
+func contains(s []string, v string) bool {
+	for _, x := range s {
+		if x == v {
+			return true
+		}
+	}
+	return false
+}
+
+// Disconnect closes the connection to the external service.
+func (e *external) Disconnect(ctx context.Context) error {
+	return nil
+}
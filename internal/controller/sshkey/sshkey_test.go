@@ -0,0 +1,388 @@
+/*
+Copyright 2025 Ross Golder.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sshkey
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+
+	instancev1beta1 "github.com/rossigee/provider-hostinger/apis/instance/v1beta1"
+	v1beta1 "github.com/rossigee/provider-hostinger/apis/sshkey/v1beta1"
+	"github.com/rossigee/provider-hostinger/internal/clients"
+	sshkeyclient "github.com/rossigee/provider-hostinger/internal/clients/sshkey"
+)
+
+// MockSSHKeyClient is a mock implementation of sshkeyclient.Client
+type MockSSHKeyClient struct {
+	keys     map[string]*sshkeyclient.Key
+	attached map[string][]string
+	deleted  []string
+	nextID   string
+}
+
+func (m *MockSSHKeyClient) Get(ctx context.Context, keyID string) (*sshkeyclient.Key, error) {
+	if k, ok := m.keys[keyID]; ok {
+		return k, nil
+	}
+	return nil, clients.ClassifyError(http.StatusNotFound, "not found")
+}
+
+func (m *MockSSHKeyClient) Create(ctx context.Context, name, publicKey string) (*sshkeyclient.Key, error) {
+	k := &sshkeyclient.Key{ID: m.nextID, Name: name, Key: publicKey}
+	if m.keys == nil {
+		m.keys = map[string]*sshkeyclient.Key{}
+	}
+	m.keys[k.ID] = k
+	return k, nil
+}
+
+func (m *MockSSHKeyClient) Delete(ctx context.Context, keyID string) error {
+	m.deleted = append(m.deleted, keyID)
+	delete(m.keys, keyID)
+	return nil
+}
+
+func (m *MockSSHKeyClient) Attach(ctx context.Context, keyID, instanceID string) (*clients.Action, error) {
+	if m.attached == nil {
+		m.attached = map[string][]string{}
+	}
+	m.attached[instanceID] = append(m.attached[instanceID], keyID)
+	return &clients.Action{ID: 1}, nil
+}
+
+func (m *MockSSHKeyClient) AttachedKeys(ctx context.Context, instanceID string) ([]string, error) {
+	return m.attached[instanceID], nil
+}
+
+func newTestKeyPair(t *testing.T) *sshkeyclient.KeyPair {
+	kp, err := sshkeyclient.Generate("ed25519", 0, "test")
+	if err != nil {
+		t.Fatalf("Generate() error = %v", err)
+	}
+	return kp
+}
+
+func newTestSSHKey() *v1beta1.SSHKey {
+	cr := &v1beta1.SSHKey{}
+	cr.SetNamespace("default")
+	cr.SetName("laptop")
+	cr.Spec.ForProvider.Name = "Laptop"
+	cr.Spec.ForProvider.InstanceIDs = []string{"123"}
+	return cr
+}
+
+func withSecretRef(cr *v1beta1.SSHKey) *v1beta1.SSHKey {
+	cr.Spec.ForProvider.PublicKeySecretRef = &xpv1.SecretKeySelector{
+		SecretReference: xpv1.SecretReference{Name: "laptop-key"},
+		Key:             "public-key",
+	}
+	return cr
+}
+
+func withGenerate(cr *v1beta1.SSHKey) *v1beta1.SSHKey {
+	cr.Spec.ForProvider.Generate = &v1beta1.SSHKeyGenerate{Type: v1beta1.SSHKeyTypeED25519}
+	cr.Spec.WriteConnectionSecretToReference = &xpv1.SecretReference{Name: "laptop-conn", Namespace: "default"}
+	return cr
+}
+
+func newSecret(name string, data map[string]string) *corev1.Secret {
+	s := &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: name}, Data: map[string][]byte{}}
+	for k, v := range data {
+		s.Data[k] = []byte(v)
+	}
+	return s
+}
+
+func newTestExternal(mock *MockSSHKeyClient, objs ...client.Object) *external {
+	s := runtime.NewScheme()
+	_ = clientgoscheme.AddToScheme(s)
+	_ = instancev1beta1.SchemeBuilder.AddToScheme(s)
+	return &external{kube: fake.NewClientBuilder().WithScheme(s).WithObjects(objs...).Build(), client: mock}
+}
+
+func newTestInstance(name, id string, labels map[string]string) *instancev1beta1.Instance {
+	inst := &instancev1beta1.Instance{ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: name, Labels: labels}}
+	if id != "" {
+		meta.SetExternalName(inst, id)
+	}
+	return inst
+}
+
+func TestExternalObserve(t *testing.T) {
+	kp := newTestKeyPair(t)
+	mock := &MockSSHKeyClient{
+		keys:     map[string]*sshkeyclient.Key{"7": {ID: "7", Name: "Laptop", Key: kp.PublicKey}},
+		attached: map[string][]string{"123": {"7"}},
+	}
+	cr := withSecretRef(newTestSSHKey())
+	meta.SetExternalName(cr, "7")
+
+	obs, err := newTestExternal(mock, newSecret("laptop-key", map[string]string{"public-key": kp.PublicKey + "\n"})).Observe(context.Background(), cr)
+	if err != nil {
+		t.Fatalf("Observe() error = %v", err)
+	}
+	if !obs.ResourceExists || !obs.ResourceUpToDate {
+		t.Errorf("Observe() = %+v, want an attached, up to date key", obs)
+	}
+	if cr.Status.AtProvider.Fingerprint != kp.Fingerprint {
+		t.Errorf("Fingerprint = %q, want %q", cr.Status.AtProvider.Fingerprint, kp.Fingerprint)
+	}
+	if cr.Status.AtProvider.KeyType != "ssh-ed25519" || cr.Status.AtProvider.Bits != 256 || cr.Status.AtProvider.Comment != "test" {
+		t.Errorf("AtProvider = %+v, want the key type, size and comment", cr.Status.AtProvider)
+	}
+	if len(cr.Status.AtProvider.AttachedInstances) != 1 {
+		t.Errorf("AttachedInstances = %v, want [123]", cr.Status.AtProvider.AttachedInstances)
+	}
+}
+
+func TestExternalObserve_KeyChanged(t *testing.T) {
+	old, rotated := newTestKeyPair(t), newTestKeyPair(t)
+	mock := &MockSSHKeyClient{keys: map[string]*sshkeyclient.Key{"7": {ID: "7", Key: old.PublicKey}}}
+	cr := withSecretRef(newTestSSHKey())
+	meta.SetExternalName(cr, "7")
+
+	obs, err := newTestExternal(mock, newSecret("laptop-key", map[string]string{"public-key": rotated.PublicKey})).Observe(context.Background(), cr)
+	if err != nil {
+		t.Fatalf("Observe() error = %v", err)
+	}
+	if !obs.ResourceExists || obs.ResourceUpToDate {
+		t.Errorf("Observe() = %+v, want a changed key reported as existing but out of date", obs)
+	}
+}
+
+func TestExternalObserve_Deleted(t *testing.T) {
+	kp := newTestKeyPair(t)
+	mock := &MockSSHKeyClient{keys: map[string]*sshkeyclient.Key{"7": {ID: "7", Key: kp.PublicKey}}}
+	cr := withSecretRef(newTestSSHKey())
+	meta.SetExternalName(cr, "7")
+	now := metav1.Now()
+	cr.SetDeletionTimestamp(&now)
+
+	// The public key secret is already gone
+	obs, err := newTestExternal(mock).Observe(context.Background(), cr)
+	if err != nil {
+		t.Fatalf("Observe() error = %v", err)
+	}
+	if !obs.ResourceExists {
+		t.Error("Observe() should report an uploaded key as existing so that it is deleted")
+	}
+}
+
+func TestExternalReplace(t *testing.T) {
+	old, rotated := newTestKeyPair(t), newTestKeyPair(t)
+	mock := &MockSSHKeyClient{nextID: "8", keys: map[string]*sshkeyclient.Key{"7": {ID: "7", Key: old.PublicKey}}}
+	cr := withSecretRef(newTestSSHKey())
+	meta.SetExternalName(cr, "7")
+	e := newTestExternal(mock, newSecret("laptop-key", map[string]string{"public-key": rotated.PublicKey}))
+
+	if _, err := e.Update(context.Background(), cr); err != nil {
+		t.Fatalf("Update() error = %v", err)
+	}
+	if mock.keys["8"] == nil || len(mock.deleted) != 0 {
+		t.Fatalf("keys = %v, deleted = %v, want the replacement uploaded and the old key kept", mock.keys, mock.deleted)
+	}
+	if cr.Status.AtProvider.ReplacementID != "8" || cr.Status.AtProvider.ReplacedID != "7" {
+		t.Errorf("AtProvider = %+v, want the replacement and replaced key recorded", cr.Status.AtProvider)
+	}
+
+	obs, err := e.Observe(context.Background(), cr)
+	if err != nil {
+		t.Fatalf("Observe() error = %v", err)
+	}
+	if !obs.ResourceLateInitialized || meta.GetExternalName(cr) != "8" {
+		t.Fatalf("Observe() = %+v, external name = %q, want switched to the replacement", obs, meta.GetExternalName(cr))
+	}
+
+	obs, err = e.Observe(context.Background(), cr)
+	if err != nil {
+		t.Fatalf("Observe() error = %v", err)
+	}
+	if obs.ResourceUpToDate {
+		t.Error("Observe() should not be up to date until the replaced key is deleted")
+	}
+	if _, err := e.Update(context.Background(), cr); err != nil {
+		t.Fatalf("Update() error = %v", err)
+	}
+	if len(mock.deleted) != 1 || mock.deleted[0] != "7" || cr.Status.AtProvider.ReplacedID != "" {
+		t.Errorf("deleted = %v, AtProvider = %+v, want the replaced key 7 deleted", mock.deleted, cr.Status.AtProvider)
+	}
+}
+
+func TestExternalDelete_Replacement(t *testing.T) {
+	mock := &MockSSHKeyClient{keys: map[string]*sshkeyclient.Key{"7": {ID: "7"}, "8": {ID: "8"}}}
+	cr := withSecretRef(newTestSSHKey())
+	meta.SetExternalName(cr, "7")
+	cr.Status.AtProvider.ReplacementID = "8"
+	cr.Status.AtProvider.ReplacedID = "7"
+
+	if _, err := newTestExternal(mock).Delete(context.Background(), cr); err != nil {
+		t.Fatalf("Delete() error = %v", err)
+	}
+	if len(mock.keys) != 0 {
+		t.Errorf("keys = %v, want the key and its replacement deleted", mock.keys)
+	}
+}
+
+func TestExternalCreate_PrivateKey(t *testing.T) {
+	kp := newTestKeyPair(t)
+	mock := &MockSSHKeyClient{nextID: "7"}
+	cr := withSecretRef(newTestSSHKey())
+
+	_, err := newTestExternal(mock, newSecret("laptop-key", map[string]string{"public-key": string(kp.PrivateKey)})).Create(context.Background(), cr)
+	if err == nil {
+		t.Fatal("Create() error = nil, want error for a private key")
+	}
+	if len(mock.keys) != 0 {
+		t.Error("Create() must not upload a private key")
+	}
+}
+
+func TestExternalObserve_GeneratedKeyLost(t *testing.T) {
+	kp := newTestKeyPair(t)
+	mock := &MockSSHKeyClient{keys: map[string]*sshkeyclient.Key{"7": {ID: "7", Key: kp.PublicKey}}}
+	cr := withGenerate(newTestSSHKey())
+	meta.SetExternalName(cr, "7")
+
+	obs, err := newTestExternal(mock).Observe(context.Background(), cr)
+	if err != nil {
+		t.Fatalf("Observe() error = %v", err)
+	}
+	if !obs.ResourceExists || obs.ResourceUpToDate {
+		t.Errorf("Observe() = %+v, want a key whose connection secret is missing regenerated by Update", obs)
+	}
+
+	conn := newSecret("laptop-conn", map[string]string{"private-key": string(kp.PrivateKey), "public-key": kp.PublicKey})
+	obs, err = newTestExternal(mock, conn).Observe(context.Background(), cr)
+	if err != nil {
+		t.Fatalf("Observe() error = %v", err)
+	}
+	if !obs.ResourceExists {
+		t.Error("Observe() should find the generated key in the connection secret")
+	}
+}
+
+func TestExternalCreate_Generate(t *testing.T) {
+	mock := &MockSSHKeyClient{nextID: "8"}
+	cr := withGenerate(newTestSSHKey())
+
+	creation, err := newTestExternal(mock).Create(context.Background(), cr)
+	if err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+	if meta.GetExternalName(cr) != "8" {
+		t.Errorf("external name = %q, want 8", meta.GetExternalName(cr))
+	}
+
+	details := creation.ConnectionDetails
+	if len(details[sshkeyclient.ConnectionKeyPrivateKey]) == 0 {
+		t.Fatal("Create() should return the private key as a connection detail")
+	}
+	if string(details[sshkeyclient.ConnectionKeyPublicKey]) != mock.keys["8"].Key {
+		t.Error("Create() should upload the generated public key")
+	}
+	if pk, _ := sshkeyclient.ParsePublicKey(mock.keys["8"].Key); string(details[sshkeyclient.ConnectionKeyFingerprint]) != pk.Fingerprint {
+		t.Errorf("fingerprint = %q, want %q", details[sshkeyclient.ConnectionKeyFingerprint], pk.Fingerprint)
+	}
+}
+
+func TestExternalCreate_SecretRef(t *testing.T) {
+	kp := newTestKeyPair(t)
+	mock := &MockSSHKeyClient{nextID: "7"}
+	cr := withSecretRef(newTestSSHKey())
+
+	creation, err := newTestExternal(mock, newSecret("laptop-key", map[string]string{"public-key": kp.PublicKey})).Create(context.Background(), cr)
+	if err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+	if mock.keys["7"].Key != kp.PublicKey || len(creation.ConnectionDetails) != 0 {
+		t.Errorf("Create() should upload the key from the secret without connection details")
+	}
+}
+
+func TestExternalUpdate(t *testing.T) {
+	kp := newTestKeyPair(t)
+	mock := &MockSSHKeyClient{
+		keys:     map[string]*sshkeyclient.Key{"7": {ID: "7", Key: kp.PublicKey}},
+		attached: map[string][]string{"123": {"7"}},
+	}
+	cr := withSecretRef(newTestSSHKey())
+	cr.Spec.ForProvider.InstanceIDs = []string{"123", "456"}
+	meta.SetExternalName(cr, "7")
+
+	if _, err := newTestExternal(mock, newSecret("laptop-key", map[string]string{"public-key": kp.PublicKey})).Update(context.Background(), cr); err != nil {
+		t.Fatalf("Update() error = %v", err)
+	}
+	if len(mock.attached["123"]) != 1 || len(mock.attached["456"]) != 1 {
+		t.Errorf("attached = %v, want the key attached once to each instance", mock.attached)
+	}
+}
+
+func TestExternalObserve_InstanceSelector(t *testing.T) {
+	kp := newTestKeyPair(t)
+	mock := &MockSSHKeyClient{
+		keys:     map[string]*sshkeyclient.Key{"7": {ID: "7", Key: kp.PublicKey}},
+		attached: map[string][]string{"123": {"7"}, "999": {"7"}},
+	}
+	cr := withSecretRef(newTestSSHKey())
+	cr.Spec.ForProvider.InstanceSelector = &metav1.LabelSelector{MatchLabels: map[string]string{"team": "web"}}
+	cr.Status.AtProvider.AttachedInstances = []string{"123", "999"}
+	meta.SetExternalName(cr, "7")
+
+	e := newTestExternal(mock,
+		newSecret("laptop-key", map[string]string{"public-key": kp.PublicKey}),
+		newTestInstance("web-1", "111", map[string]string{"team": "web"}),
+		newTestInstance("web-2", "", map[string]string{"team": "web"}),
+		newTestInstance("db", "333", map[string]string{"team": "db"}),
+	)
+
+	obs, err := e.Observe(context.Background(), cr)
+	if err != nil {
+		t.Fatalf("Observe() error = %v", err)
+	}
+	if obs.ResourceUpToDate {
+		t.Error("Observe() should not be up to date until the selected instance is attached")
+	}
+	if got := cr.Status.AtProvider.UnmatchedInstances; len(got) != 1 || got[0] != "999" {
+		t.Errorf("UnmatchedInstances = %v, want [999]", got)
+	}
+
+	if _, err := e.Update(context.Background(), cr); err != nil {
+		t.Fatalf("Update() error = %v", err)
+	}
+	if len(mock.attached["111"]) != 1 || len(mock.attached["333"]) != 0 {
+		t.Errorf("attached = %v, want the key attached to the selected instance only", mock.attached)
+	}
+
+	obs, err = e.Observe(context.Background(), cr)
+	if err != nil {
+		t.Fatalf("Observe() error = %v", err)
+	}
+	if got := cr.Status.AtProvider.AttachedInstances; !obs.ResourceUpToDate || len(got) != 2 || got[0] != "123" || got[1] != "111" {
+		t.Errorf("Observe() = %+v, AttachedInstances = %v, want [123 111] up to date", obs, got)
+	}
+}
@@ -0,0 +1,380 @@
+/*
+Copyright 2025 Ross Golder.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package whoisprofile
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"k8s.io/client-go/util/workqueue"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/event"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+
+	v1beta1 "github.com/rossigee/provider-hostinger/apis/domain/v1beta1"
+	providerv1beta1 "github.com/rossigee/provider-hostinger/apis/v1beta1"
+	"github.com/rossigee/provider-hostinger/internal/clients"
+	domainclient "github.com/rossigee/provider-hostinger/internal/clients/domain"
+)
+
+const (
+	errNotWHOISProfile = "managed resource is not a WHOISProfile custom resource"
+	errGetPC           = "cannot get ProviderConfig"
+	errNewClient       = "cannot create new Hostinger client"
+
+	errGet                 = "failed to get WHOIS profile"
+	errCreate              = "failed to create WHOIS profile"
+	errDelete              = "failed to delete WHOIS profile"
+	errDeleteReplaced      = "failed to delete replaced WHOIS profile"
+	errListUsage           = "failed to list domains using WHOIS profile"
+	errGetContactSecret    = "cannot get contact secret"
+	errGetTLDDetailsSecret = "cannot get TLD details secret"
+	errInvalidContact      = "invalid contact secret"
+	errInUse               = "WHOIS profile is still used by domains"
+)
+
+// Setup adds a controller that reconciles WHOISProfile managed resources.
+func Setup(mgr ctrl.Manager, l logging.Logger, wl workqueue.TypedRateLimiter[any]) error {
+	name := managed.ControllerName(v1beta1.WHOISProfileGroupKind)
+
+	o := controller.Options{
+		MaxConcurrentReconciles: 5,
+	}
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(v1beta1.WHOISProfileGroupVersionKind),
+		managed.WithExternalConnecter(&connector{
+			kube:        mgr.GetClient(),
+			newClientFn: clients.NewClientFactory,
+		}),
+		managed.WithLogger(l.WithValues("controller", name)),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		managed.WithPollInterval(5*time.Minute),
+		managed.WithInitializers(),
+	)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o).
+		For(&v1beta1.WHOISProfile{}).
+		Complete(r)
+}
+
+// A connector is expected to produce typed ExternalClient for the managed
+// resource it is supposed to manage.
+type connector struct {
+	kube        client.Client
+	newClientFn func(client.Client, clients.HTTPClientConfig) *clients.ClientFactory
+}
+
+// Connect produces an ExternalClient using the credentials of the
+// ProviderConfig referenced by the WHOISProfile.
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1beta1.WHOISProfile)
+	if !ok {
+		return nil, errors.New(errNotWHOISProfile)
+	}
+
+	pc := &providerv1beta1.ProviderConfig{}
+	if err := c.kube.Get(ctx, client.ObjectKey{Namespace: cr.GetNamespace(), Name: cr.Spec.ProviderConfigReference.Name}, pc); err != nil {
+		return nil, errors.Wrap(err, errGetPC)
+	}
+
+	clientFactory := c.newClientFn(c.kube, clients.DefaultHTTPClientConfig())
+
+	hc, err := clientFactory.CreateHostingerClient(ctx, pc)
+	if err != nil {
+		return nil, errors.Wrap(err, errNewClient)
+	}
+
+	return &external{kube: c.kube, client: domainclient.NewDomainClient(hc)}, nil
+}
+
+// An ExternalClient observes, then either creates, updates, or deletes an
+// external resource to ensure it reflects the managed resource's desired state.
+type external struct {
+	kube   client.Client
+	client domainclient.WHOISClient
+}
+
+// Observe compares the profile with the contact details in its secrets.
+// Hostinger profiles cannot be changed, so a profile with different details
+// is reported as out of date and Update creates a replacement.
+func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1beta1.WHOISProfile)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotWHOISProfile)
+	}
+
+	// The external name is the ID of the Hostinger WHOIS profile
+	externalName := meta.GetExternalName(cr)
+	if externalName == "" {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	// A replacement created by Update becomes the external name; the
+	// external name cannot be changed by Update itself
+	obs := &cr.Status.AtProvider
+	if obs.ReplacementID != "" && !meta.WasDeleted(cr) {
+		if obs.ReplacementID != externalName {
+			meta.SetExternalName(cr, obs.ReplacementID)
+			return managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true, ResourceLateInitialized: true}, nil
+		}
+		obs.ReplacementID = ""
+	}
+
+	// Profiles left over from a replacement are deleted with the
+	// WHOISProfile
+	if meta.WasDeleted(cr) && (obs.ReplacementID != "" || len(obs.ReplacedIDs) > 0) {
+		return managed.ExternalObservation{ResourceExists: true}, nil
+	}
+
+	live, err := e.client.GetWHOISProfile(ctx, externalName)
+	if err != nil {
+		if clients.IsNotFound(err) {
+			return managed.ExternalObservation{ResourceExists: false}, nil
+		}
+		return managed.ExternalObservation{}, errors.Wrap(err, errGet)
+	}
+
+	// The contact details are not needed to delete the profile, and their
+	// secrets may already be gone
+	if meta.WasDeleted(cr) {
+		return managed.ExternalObservation{ResourceExists: true}, nil
+	}
+
+	desired, err := e.desiredProfile(ctx, cr)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+	if !domainclient.WHOISProfileMatches(live, desired) {
+		cr.SetConditions(xpv1.Available().WithMessage("contact details changed and the profile will be replaced"))
+		return managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false}, nil
+	}
+
+	domains, err := e.client.ListWHOISProfileUsage(ctx, externalName)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errListUsage)
+	}
+	replaced, deletable, err := e.replacedUsage(ctx, obs.ReplacedIDs)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+
+	o := domainclient.GetWHOISProfileObservation(live, domains)
+	o.ReplacementID, o.ReplacedIDs = obs.ReplacementID, obs.ReplacedIDs
+	*obs = o
+
+	condition := xpv1.Available()
+	if len(replaced) > 0 {
+		condition = condition.WithMessage("replaced profiles are kept while domains use them: " + strings.Join(replaced, "; "))
+	}
+	cr.SetConditions(condition)
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: !deletable,
+	}, nil
+}
+
+// Create creates the profile from the contact details in its secrets.
+func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1beta1.WHOISProfile)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotWHOISProfile)
+	}
+
+	desired, err := e.desiredProfile(ctx, cr)
+	if err != nil {
+		return managed.ExternalCreation{}, err
+	}
+	p, err := e.client.CreateWHOISProfile(ctx, desired)
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreate)
+	}
+
+	meta.SetExternalName(cr, p.ID)
+	return managed.ExternalCreation{}, nil
+}
+
+// Update creates a replacement for a profile with changed contact details,
+// and deletes replaced profiles once the external name points to the
+// replacement and no domain uses them. Domains keep the contact they were
+// registered with; they are not moved to the replacement.
+func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1beta1.WHOISProfile)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotWHOISProfile)
+	}
+
+	id := meta.GetExternalName(cr)
+	live, err := e.client.GetWHOISProfile(ctx, id)
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errGet)
+	}
+	desired, err := e.desiredProfile(ctx, cr)
+	if err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+	obs := &cr.Status.AtProvider
+	if !domainclient.WHOISProfileMatches(live, desired) {
+		// The replaced profile is deleted once Observe has switched the
+		// external name to the replacement
+		p, err := e.client.CreateWHOISProfile(ctx, desired)
+		if err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errCreate)
+		}
+		obs.ReplacementID = p.ID
+		obs.ReplacedIDs = append(obs.ReplacedIDs, id)
+		return managed.ExternalUpdate{}, nil
+	}
+
+	kept := []string{}
+	for _, replaced := range obs.ReplacedIDs {
+		domains, err := e.client.ListWHOISProfileUsage(ctx, replaced)
+		if err != nil && !clients.IsNotFound(err) {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errListUsage)
+		}
+		if len(domains) > 0 {
+			kept = append(kept, replaced)
+			continue
+		}
+		if err := e.client.DeleteWHOISProfile(ctx, replaced); err != nil && !clients.IsNotFound(err) {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errDeleteReplaced)
+		}
+	}
+	obs.ReplacedIDs = nil
+	if len(kept) > 0 {
+		obs.ReplacedIDs = kept
+	}
+
+	return managed.ExternalUpdate{}, nil
+}
+
+// Delete deletes the profile, together with any replacement or replaced
+// profile that is still recorded in status, once no domain uses them.
+func (e *external) Delete(ctx context.Context, mg resource.Managed) (managed.ExternalDelete, error) {
+	cr, ok := mg.(*v1beta1.WHOISProfile)
+	if !ok {
+		return managed.ExternalDelete{}, errors.New(errNotWHOISProfile)
+	}
+
+	obs := &cr.Status.AtProvider
+	if obs.ReplacementID != "" {
+		if err := e.deleteUnused(ctx, obs.ReplacementID); err != nil {
+			return managed.ExternalDelete{}, err
+		}
+		obs.ReplacementID = ""
+	}
+	for len(obs.ReplacedIDs) > 0 {
+		if err := e.deleteUnused(ctx, obs.ReplacedIDs[0]); err != nil {
+			return managed.ExternalDelete{}, err
+		}
+		obs.ReplacedIDs = obs.ReplacedIDs[1:]
+	}
+
+	return managed.ExternalDelete{}, e.deleteUnused(ctx, meta.GetExternalName(cr))
+}
+
+// deleteUnused deletes a profile unless domains use it
+func (e *external) deleteUnused(ctx context.Context, id string) error {
+	domains, err := e.client.ListWHOISProfileUsage(ctx, id)
+	if err != nil {
+		if clients.IsNotFound(err) {
+			return nil
+		}
+		return errors.Wrap(err, errListUsage)
+	}
+	if len(domains) > 0 {
+		return errors.Errorf("%s %s: %s", errInUse, id, strings.Join(domains, ", "))
+	}
+	if err := e.client.DeleteWHOISProfile(ctx, id); err != nil && !clients.IsNotFound(err) {
+		return errors.Wrap(err, errDelete)
+	}
+	return nil
+}
+
+// replacedUsage describes the replaced profiles that domains still use, and
+// reports whether any of the others can be deleted
+func (e *external) replacedUsage(ctx context.Context, ids []string) ([]string, bool, error) {
+	used := []string{}
+	deletable := false
+	for _, id := range ids {
+		domains, err := e.client.ListWHOISProfileUsage(ctx, id)
+		if err != nil && !clients.IsNotFound(err) {
+			return nil, false, errors.Wrap(err, errListUsage)
+		}
+		if len(domains) == 0 {
+			deletable = true
+			continue
+		}
+		used = append(used, id+" by "+strings.Join(domains, ", "))
+	}
+	return used, deletable, nil
+}
+
+// desiredProfile builds the profile from spec and the contact details in
+// its secrets
+func (e *external) desiredProfile(ctx context.Context, cr *v1beta1.WHOISProfile) (*domainclient.WHOISProfile, error) {
+	p := cr.Spec.ForProvider
+	entityType := string(p.EntityType)
+	if entityType == "" {
+		entityType = string(v1beta1.EntityTypeIndividual)
+	}
+
+	contact, err := clients.GetSecretData(ctx, e.kube, p.ContactSecretRef, cr.GetNamespace())
+	if err != nil {
+		return nil, errors.Wrap(err, errGetContactSecret)
+	}
+	details, err := domainclient.ContactDetails(contact, entityType)
+	if err != nil {
+		return nil, errors.Wrap(err, errInvalidContact)
+	}
+
+	tldDetails := map[string]string{}
+	if p.TLDDetailsSecretRef != nil {
+		data, err := clients.GetSecretData(ctx, e.kube, *p.TLDDetailsSecretRef, cr.GetNamespace())
+		if err != nil {
+			return nil, errors.Wrap(err, errGetTLDDetailsSecret)
+		}
+		for k, v := range data {
+			tldDetails[k] = strings.TrimSpace(string(v))
+		}
+	}
+
+	return &domainclient.WHOISProfile{
+		TLD:          p.TLD,
+		Country:      p.Country,
+		EntityType:   entityType,
+		WHOISDetails: details,
+		TLDDetails:   tldDetails,
+	}, nil
+}
+
+// Disconnect closes the connection to the external service.
+func (e *external) Disconnect(ctx context.Context) error {
+	return nil
+}
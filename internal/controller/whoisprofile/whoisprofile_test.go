@@ -0,0 +1,266 @@
+/*
+Copyright 2025 Ross Golder.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package whoisprofile
+
+import (
+	"context"
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+
+	v1beta1 "github.com/rossigee/provider-hostinger/apis/domain/v1beta1"
+	"github.com/rossigee/provider-hostinger/internal/clients"
+	domainclient "github.com/rossigee/provider-hostinger/internal/clients/domain"
+)
+
+// MockWHOISClient is a mock implementation of domainclient.WHOISClient
+type MockWHOISClient struct {
+	profiles map[string]*domainclient.WHOISProfile
+	usage    map[string][]string
+	nextID   string
+	deleted  []string
+}
+
+func (m *MockWHOISClient) GetWHOISProfile(ctx context.Context, id string) (*domainclient.WHOISProfile, error) {
+	p, ok := m.profiles[id]
+	if !ok {
+		return nil, clients.ClassifyError(http.StatusNotFound, "not found")
+	}
+	return p, nil
+}
+
+func (m *MockWHOISClient) CreateWHOISProfile(ctx context.Context, p *domainclient.WHOISProfile) (*domainclient.WHOISProfile, error) {
+	created := *p
+	created.ID = m.nextID
+	m.profiles[created.ID] = &created
+	return &created, nil
+}
+
+func (m *MockWHOISClient) DeleteWHOISProfile(ctx context.Context, id string) error {
+	m.deleted = append(m.deleted, id)
+	delete(m.profiles, id)
+	return nil
+}
+
+func (m *MockWHOISClient) ListWHOISProfileUsage(ctx context.Context, id string) ([]string, error) {
+	return m.usage[id], nil
+}
+
+func newMock() *MockWHOISClient {
+	return &MockWHOISClient{profiles: map[string]*domainclient.WHOISProfile{}, usage: map[string][]string{}, nextID: "42"}
+}
+
+func newTestWHOISProfile() *v1beta1.WHOISProfile {
+	cr := &v1beta1.WHOISProfile{}
+	cr.SetNamespace("default")
+	cr.SetName("marketing")
+	cr.Spec.ForProvider.TLD = "com"
+	cr.Spec.ForProvider.Country = "LT"
+	cr.Spec.ForProvider.ContactSecretRef = xpv1.SecretReference{Name: "marketing-contact"}
+	return cr
+}
+
+func newTestExternal(m *MockWHOISClient, city string) *external {
+	secret := &corev1.Secret{Data: map[string][]byte{
+		domainclient.ContactKeyFirstName:        []byte("Jane"),
+		domainclient.ContactKeyLastName:         []byte("Doe"),
+		domainclient.ContactKeyAddress1:         []byte("1 Main Street"),
+		domainclient.ContactKeyCity:             []byte(city),
+		domainclient.ContactKeyZip:              []byte("01100"),
+		domainclient.ContactKeyPhoneCountryCode: []byte("370"),
+		domainclient.ContactKeyPhone:            []byte("60000000"),
+		domainclient.ContactKeyEmail:            []byte("jane@example.com"),
+	}}
+	secret.SetNamespace("default")
+	secret.SetName("marketing-contact")
+	return &external{kube: fake.NewClientBuilder().WithObjects(secret).Build(), client: m}
+}
+
+func TestExternalCreateAndObserve(t *testing.T) {
+	m := newMock()
+	m.usage["42"] = []string{"example.com"}
+	e := newTestExternal(m, "Vilnius")
+	cr := newTestWHOISProfile()
+
+	if _, err := e.Create(context.Background(), cr); err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+	if meta.GetExternalName(cr) != "42" {
+		t.Errorf("external name = %q, want the profile ID", meta.GetExternalName(cr))
+	}
+	if got := m.profiles["42"]; got.EntityType != "individual" || got.WHOISDetails["city"] != "Vilnius" {
+		t.Errorf("created profile = %+v, want the contact details from the secret", got)
+	}
+
+	obs, err := e.Observe(context.Background(), cr)
+	if err != nil {
+		t.Fatalf("Observe() error = %v", err)
+	}
+	if !obs.ResourceExists || !obs.ResourceUpToDate {
+		t.Errorf("Observe() = %+v, want existing and up to date", obs)
+	}
+	if !reflect.DeepEqual(cr.Status.AtProvider.Domains, []string{"example.com"}) {
+		t.Errorf("domains = %v, want the domains using the profile", cr.Status.AtProvider.Domains)
+	}
+}
+
+func TestExternalReplace(t *testing.T) {
+	m := newMock()
+	m.profiles["41"] = &domainclient.WHOISProfile{ID: "41", TLD: "com", Country: "LT", EntityType: "individual", WHOISDetails: map[string]string{"city": "Kaunas"}}
+	e := newTestExternal(m, "Vilnius")
+	cr := newTestWHOISProfile()
+	meta.SetExternalName(cr, "41")
+
+	obs, err := e.Observe(context.Background(), cr)
+	if err != nil {
+		t.Fatalf("Observe() error = %v", err)
+	}
+	if !obs.ResourceExists || obs.ResourceUpToDate {
+		t.Fatalf("Observe() = %+v, want changed details to be out of date", obs)
+	}
+	if _, err := e.Update(context.Background(), cr); err != nil {
+		t.Fatalf("Update() error = %v", err)
+	}
+	got := cr.Status.AtProvider
+	if got.ReplacementID != "42" || !reflect.DeepEqual(got.ReplacedIDs, []string{"41"}) || len(m.deleted) != 0 {
+		t.Fatalf("status = %+v, deleted = %v, want the replacement recorded and nothing deleted yet", got, m.deleted)
+	}
+
+	// Observe switches the external name to the replacement
+	obs, err = e.Observe(context.Background(), cr)
+	if err != nil {
+		t.Fatalf("Observe() error = %v", err)
+	}
+	if !obs.ResourceLateInitialized || meta.GetExternalName(cr) != "42" {
+		t.Fatalf("Observe() = %+v, external name = %q, want the replacement", obs, meta.GetExternalName(cr))
+	}
+
+	// The unused replaced profile is then deleted
+	obs, err = e.Observe(context.Background(), cr)
+	if err != nil {
+		t.Fatalf("Observe() error = %v", err)
+	}
+	if obs.ResourceUpToDate || cr.Status.AtProvider.ReplacementID != "" {
+		t.Fatalf("Observe() = %+v, status = %+v, want the replaced profile pending deletion", obs, cr.Status.AtProvider)
+	}
+	if _, err := e.Update(context.Background(), cr); err != nil {
+		t.Fatalf("Update() error = %v", err)
+	}
+	if !reflect.DeepEqual(m.deleted, []string{"41"}) || len(cr.Status.AtProvider.ReplacedIDs) != 0 {
+		t.Errorf("deleted = %v, status = %+v, want the replaced profile deleted", m.deleted, cr.Status.AtProvider)
+	}
+}
+
+func TestExternalReplaceInUse(t *testing.T) {
+	m := newMock()
+	m.usage["41"] = []string{"example.com"}
+	e := newTestExternal(m, "Vilnius")
+	cr := newTestWHOISProfile()
+	if _, err := e.Create(context.Background(), cr); err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+	cr.Status.AtProvider.ReplacedIDs = []string{"41"}
+
+	obs, err := e.Observe(context.Background(), cr)
+	if err != nil {
+		t.Fatalf("Observe() error = %v", err)
+	}
+	if !obs.ResourceUpToDate {
+		t.Errorf("Observe() = %+v, want a replaced profile in use not to be out of date", obs)
+	}
+	if msg := cr.GetCondition(xpv1.TypeReady).Message; !strings.Contains(msg, "41 by example.com") {
+		t.Errorf("Ready message = %q, want the domains using the replaced profile", msg)
+	}
+	if _, err := e.Update(context.Background(), cr); err != nil {
+		t.Fatalf("Update() error = %v", err)
+	}
+	if len(m.deleted) != 0 || !reflect.DeepEqual(cr.Status.AtProvider.ReplacedIDs, []string{"41"}) {
+		t.Errorf("deleted = %v, status = %+v, want a replaced profile in use to be kept", m.deleted, cr.Status.AtProvider)
+	}
+}
+
+func TestExternalObserveDeleted(t *testing.T) {
+	m := newMock()
+	m.profiles["42"] = &domainclient.WHOISProfile{ID: "42"}
+	// The contact secret is already gone
+	e := &external{kube: fake.NewClientBuilder().Build(), client: m}
+	cr := newTestWHOISProfile()
+	meta.SetExternalName(cr, "42")
+	now := metav1.Now()
+	cr.SetDeletionTimestamp(&now)
+
+	obs, err := e.Observe(context.Background(), cr)
+	if err != nil {
+		t.Fatalf("Observe() error = %v", err)
+	}
+	if !obs.ResourceExists {
+		t.Errorf("Observe() = %+v, want the profile to exist until it is deleted", obs)
+	}
+}
+
+func TestExternalDeleteReplacement(t *testing.T) {
+	m := newMock()
+	e := newTestExternal(m, "Vilnius")
+	cr := newTestWHOISProfile()
+	meta.SetExternalName(cr, "42")
+	cr.Status.AtProvider.ReplacementID = "43"
+	cr.Status.AtProvider.ReplacedIDs = []string{"41"}
+
+	if _, err := e.Delete(context.Background(), cr); err != nil {
+		t.Fatalf("Delete() error = %v", err)
+	}
+	if !reflect.DeepEqual(m.deleted, []string{"43", "41", "42"}) {
+		t.Errorf("deleted = %v, want the replacement, the replaced and the profile", m.deleted)
+	}
+	if cr.Status.AtProvider.ReplacementID != "" || len(cr.Status.AtProvider.ReplacedIDs) != 0 {
+		t.Errorf("status = %+v, want the deleted profiles forgotten", cr.Status.AtProvider)
+	}
+}
+
+func TestExternalDeleteInUse(t *testing.T) {
+	m := newMock()
+	m.usage["42"] = []string{"example.com"}
+	e := newTestExternal(m, "Vilnius")
+	cr := newTestWHOISProfile()
+	meta.SetExternalName(cr, "42")
+
+	if _, err := e.Delete(context.Background(), cr); err == nil {
+		t.Error("Delete() error = nil, want a profile in use not to be deleted")
+	}
+	delete(m.usage, "42")
+	if _, err := e.Delete(context.Background(), cr); err != nil {
+		t.Fatalf("Delete() error = %v", err)
+	}
+	if !reflect.DeepEqual(m.deleted, []string{"42"}) {
+		t.Errorf("deleted = %v, want the profile", m.deleted)
+	}
+}
+
+func TestExternalInvalidContact(t *testing.T) {
+	e := newTestExternal(newMock(), "")
+	if _, err := e.Create(context.Background(), newTestWHOISProfile()); err == nil {
+		t.Error("Create() error = nil, want a contact without city to be rejected")
+	}
+}